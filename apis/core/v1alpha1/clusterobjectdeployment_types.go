@@ -13,6 +13,14 @@ type ClusterObjectDeploymentSpec struct {
 	Selector metav1.LabelSelector `json:"selector"`
 	// Template to create new ObjectSets from.
 	Template ObjectSetTemplate `json:"template"`
+	// Paused stops the creation of new revisions and
+	// pauses reconciliation of all ObjectSets of this deployment.
+	// Status is still reported while paused.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// Strategy controls when previous revisions are replaced by a new revision.
+	// +optional
+	Strategy *ObjectDeploymentStrategy `json:"strategy,omitempty"`
 }
 
 // ClusterObjectDeploymentStatus defines the observed state of a ClusterObjectDeployment.
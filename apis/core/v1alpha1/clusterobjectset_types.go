@@ -38,6 +38,8 @@ type ClusterObjectSetList struct {
 // +kubebuilder:validation:XValidation:rule="(has(self.phases) == has(oldSelf.phases)) && (!has(self.phases) || (self.phases == oldSelf.phases))", message="phases is immutable"
 // +kubebuilder:validation:XValidation:rule="(has(self.availabilityProbes) == has(oldSelf.availabilityProbes)) && (!has(self.availabilityProbes) || (self.availabilityProbes == oldSelf.availabilityProbes))", message="availabilityProbes is immutable"
 // +kubebuilder:validation:XValidation:rule="(has(self.successDelaySeconds) == has(oldSelf.successDelaySeconds)) && (!has(self.successDelaySeconds) || (self.successDelaySeconds == oldSelf.successDelaySeconds))", message="successDelaySeconds is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.hooks) == has(oldSelf.hooks)) && (!has(self.hooks) || (self.hooks == oldSelf.hooks))", message="hooks is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.adoption) == has(oldSelf.adoption)) && (!has(self.adoption) || (self.adoption == oldSelf.adoption))", message="adoption is immutable"
 //
 //nolint:lll
 type ClusterObjectSetSpec struct {
@@ -68,6 +70,12 @@ type ClusterObjectSetStatus struct {
 	RemotePhases []RemotePhaseReference `json:"remotePhases,omitempty"`
 	// References all objects controlled by this instance.
 	ControllerOf []ControlledObjectReference `json:"controllerOf,omitempty"`
+	// Hooks that ran to completion.
+	Hooks []ObjectSetHookStatus `json:"hooks,omitempty"`
+	// Objects that differ from their desired state.
+	DriftedObjects []DriftedObject `json:"driftedObjects,omitempty"`
+	// Last availability probe result of each probed object.
+	ProbedObjects []ObjectProbeStatus `json:"probedObjects,omitempty"`
 }
 
 func init() { register(&ClusterObjectSet{}, &ClusterObjectSetList{}) }
@@ -29,6 +29,7 @@ type ClusterObjectSetPhaseList struct {
 // +kubebuilder:validation:XValidation:rule="has(self.previous) == has(oldSelf.previous)", message="previous is immutable"
 // +kubebuilder:validation:XValidation:rule="has(self.availabilityProbes) == has(oldSelf.availabilityProbes)", message="availabilityProbes is immutable"
 // +kubebuilder:validation:XValidation:rule="has(self.externalObjects) == has(oldSelf.externalObjects)", message="externalObjects is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.adoption) == has(oldSelf.adoption)", message="adoption is immutable"
 //
 //nolint:lll
 type ClusterObjectSetPhaseSpec struct {
@@ -68,6 +69,11 @@ type ClusterObjectSetPhaseSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="externalObjects is immutable"
 	// +kubebuilder:MaxItems=32
 	ExternalObjects []ObjectSetObject `json:"externalObjects,omitempty"`
+
+	// Adoption allows taking over objects already present on the cluster.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="adoption is immutable"
+	Adoption *AdoptionPolicy `json:"adoption,omitempty"`
 }
 
 // ClusterObjectSetPhaseStatus defines the observed state of a ClusterObjectSetPhase.
@@ -77,6 +83,8 @@ type ClusterObjectSetPhaseStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// References all objects controlled by this instance.
 	ControllerOf []ControlledObjectReference `json:"controllerOf,omitempty"`
+	// Last availability probe result of each probed object.
+	ProbedObjects []ObjectProbeStatus `json:"probedObjects,omitempty"`
 }
 
 func init() { register(&ClusterObjectSetPhase{}, &ClusterObjectSetPhaseList{}) }
@@ -11,6 +11,21 @@ import (
 // ObjectSetRevisionAnnotation annotations holds a revision generation number to order ObjectSets.
 const ObjectSetRevisionAnnotation = "package-operator.run/revision"
 
+// ObjectSetObserveOnlyLabel disables drift correction for an object when set to "True".
+// Drift is still detected and reported in the ObjectSet status
+// and the object is still adopted, but its fields are never patched.
+const ObjectSetObserveOnlyLabel = "package-operator.run/observe-only"
+
+// ObjectSetAdoptByAnnotation names the Package allowed to adopt an object already present on the cluster,
+// if the ObjectSet has an adoption policy.
+// Packages are named "<namespace>/<name>", ClusterPackages just "<name>".
+const ObjectSetAdoptByAnnotation = "package-operator.run/adopt-by"
+
+// ObjectSetRollbackFromAnnotation names the failed ObjectSet a previous revision is rolled back from.
+// The previous revision takes over the objects controlled by the failed ObjectSet,
+// although they belong to a newer revision.
+const ObjectSetRollbackFromAnnotation = "package-operator.run/rollback-from"
+
 // ObjectSetLifecycleState specifies the lifecycle state of the ObjectSet.
 type ObjectSetLifecycleState string
 
@@ -44,6 +59,12 @@ type ObjectSetTemplateSpec struct {
 	// the underlying objects may initially satisfy the availability
 	// probes, but are ultimately unstable.
 	SuccessDelaySeconds int32 `json:"successDelaySeconds,omitempty"`
+	// Hooks are objects run to completion at specific points of the ObjectSet lifecycle,
+	// outside of the ordered phases.
+	Hooks []ObjectSetHook `json:"hooks,omitempty"`
+	// Adoption allows taking over objects already present on the cluster,
+	// e.g. to migrate manually applied objects into a Package.
+	Adoption *AdoptionPolicy `json:"adoption,omitempty"`
 }
 
 // ObjectSetTemplatePhase configures the reconcile phase of ObjectSets.
@@ -78,6 +99,20 @@ type ObjectSetObject struct {
 	CollisionProtection CollisionProtection `json:"collisionProtection,omitempty"`
 	// Maps conditions from this object into the Package Operator APIs.
 	ConditionMappings []ConditionMapping `json:"conditionMappings,omitempty"`
+	// Wave orders objects within the phase.
+	// Objects of a wave are only reconciled after all objects of lower waves
+	// have been reconciled and pass their availability probes.
+	// On teardown, waves are deleted in reverse order,
+	// objects of a wave are only deleted after all objects of higher waves are gone.
+	// Objects without a wave are in wave 0.
+	Wave int32 `json:"wave,omitempty"`
+	// Determines what happens to the object when it is no longer managed by this ObjectSet.
+	// "Delete" deletes the object, this is the default.
+	// "Orphan" releases the object instead of deleting it.
+	// "Retain" releases the object when the ObjectSet is deleted,
+	// but still deletes it when it is removed from the ObjectSet during an upgrade.
+	// +kubebuilder:validation:Enum=Delete;Orphan;Retain
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 func (o ObjectSetObject) String() string {
@@ -103,6 +138,157 @@ const (
 	CollisionProtectionNone CollisionProtection = "None"
 )
 
+// DeletionPolicy specifies what happens to an object when it is no longer managed.
+// Released objects are stripped of the owner reference, labels and annotations of Package Operator.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the object.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan releases the object and leaves it in place,
+	// when it is removed during an upgrade and when its owner is deleted.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyRetain releases the object and leaves it in place when its owner is deleted.
+	// Objects removed during an upgrade are still deleted.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// AdoptionPolicy allows adopting objects already present on the cluster, that are not controlled by anyone else,
+// independent of their collision protection.
+// Objects are adopted when they match the selector or carry the "package-operator.run/adopt-by" annotation
+// naming the Package.
+type AdoptionPolicy struct {
+	// Adopts objects matching this label selector.
+	// +example={matchLabels: {app.kubernetes.io/name: example-operator}}
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// DryRun only reports objects that would be adopted via the AdoptionDryRun condition,
+	// instead of adopting them.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// ObjectSetHook is an object run to completion at a specific point of the ObjectSet lifecycle.
+// Hooks complete when reporting a "Complete" condition and fail when reporting a "Failed" condition,
+// like batch/v1 Jobs do.
+type ObjectSetHook struct {
+	// Lifecycle event the hook runs at.
+	// +kubebuilder:validation:Enum=PreInstall;PreUpgrade;PreDelete
+	Type ObjectSetHookType `json:"type"`
+	// +kubebuilder:validation:EmbeddedResource
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +example={apiVersion: batch/v1, kind: Job, metadata: {name: example-migration}}
+	Object unstructured.Unstructured `json:"object"`
+	// Determines if the hook object is deleted after it completed.
+	// PreDelete hooks are always removed together with the ObjectSet.
+	// +kubebuilder:default=DeleteOnSuccess
+	// +kubebuilder:validation:Enum=Retain;DeleteOnSuccess;Delete
+	RetentionPolicy ObjectSetHookRetentionPolicy `json:"retentionPolicy,omitempty"`
+	// Determines how the ObjectSet reacts to a failing hook.
+	// +kubebuilder:default=Abort
+	// +kubebuilder:validation:Enum=Abort;Ignore
+	FailurePolicy ObjectSetHookFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+func (h ObjectSetHook) String() string {
+	obj := h.Object
+
+	return fmt.Sprintf("%s hook %s/%s kind:%s", h.Type, obj.GetNamespace(), obj.GetName(), obj.GetKind())
+}
+
+// ObjectSetHookType specifies the lifecycle event a hook runs at.
+type ObjectSetHookType string
+
+const (
+	// ObjectSetHookTypePreInstall runs before the phases of an ObjectSet
+	// without previous revisions are reconciled.
+	ObjectSetHookTypePreInstall ObjectSetHookType = "PreInstall"
+	// ObjectSetHookTypePreUpgrade runs before the phases of an ObjectSet
+	// replacing previous revisions are reconciled.
+	ObjectSetHookTypePreUpgrade ObjectSetHookType = "PreUpgrade"
+	// ObjectSetHookTypePreDelete runs before the phases of a deleted ObjectSet are torn down.
+	// Skipped when the namespace of the hook object is already terminating.
+	ObjectSetHookTypePreDelete ObjectSetHookType = "PreDelete"
+)
+
+// ObjectSetHookRetentionPolicy specifies if a hook object is deleted after it completed.
+type ObjectSetHookRetentionPolicy string
+
+const (
+	// ObjectSetHookRetentionPolicyRetain keeps the hook object until the ObjectSet is deleted.
+	// The next revision replaces hook objects of previous revisions with the same name.
+	ObjectSetHookRetentionPolicyRetain ObjectSetHookRetentionPolicy = "Retain"
+	// ObjectSetHookRetentionPolicyDeleteOnSuccess deletes the hook object after it succeeded.
+	// Failed hook objects are kept for inspection.
+	ObjectSetHookRetentionPolicyDeleteOnSuccess ObjectSetHookRetentionPolicy = "DeleteOnSuccess"
+	// ObjectSetHookRetentionPolicyDelete deletes the hook object after it completed,
+	// unless it failed and blocks the ObjectSet.
+	ObjectSetHookRetentionPolicyDelete ObjectSetHookRetentionPolicy = "Delete"
+)
+
+// ObjectSetHookFailurePolicy specifies how the ObjectSet reacts to a failing hook.
+type ObjectSetHookFailurePolicy string
+
+const (
+	// ObjectSetHookFailurePolicyAbort blocks the ObjectSet until the hook succeeds.
+	// Delete the failed hook object to run it again.
+	ObjectSetHookFailurePolicyAbort ObjectSetHookFailurePolicy = "Abort"
+	// ObjectSetHookFailurePolicyIgnore continues as if the hook had succeeded.
+	ObjectSetHookFailurePolicyIgnore ObjectSetHookFailurePolicy = "Ignore"
+)
+
+// ObjectSetHookStatus records a completed hook.
+type ObjectSetHookStatus struct {
+	// Lifecycle event the hook ran at.
+	Type ObjectSetHookType `json:"type"`
+	// Hook object.
+	Object ControlledObjectReference `json:"object"`
+	// Result of the hook.
+	Result ObjectSetHookResult `json:"result"`
+}
+
+// ObjectSetHookResult is the outcome of a completed hook.
+type ObjectSetHookResult string
+
+const (
+	// ObjectSetHookResultSucceeded means the hook reported the "Complete" condition.
+	ObjectSetHookResultSucceeded ObjectSetHookResult = "Succeeded"
+	// ObjectSetHookResultFailed means the hook reported the "Failed" condition
+	// and the failure was ignored.
+	ObjectSetHookResultFailed ObjectSetHookResult = "Failed"
+)
+
+// DriftedObject references an object that differs from its desired state.
+type DriftedObject struct {
+	// Drifted object.
+	Object ControlledObjectReference `json:"object"`
+	// Paths of the fields that differ from the desired state.
+	// Only fields managed by Package Operator are compared.
+	Fields []string `json:"fields"`
+	// ObserveOnly is true, when the drift is not corrected,
+	// because the object is labelled with "package-operator.run/observe-only".
+	ObserveOnly bool `json:"observeOnly,omitempty"`
+}
+
+// ObjectProbeStatus reports the last availability probe result of an object.
+type ObjectProbeStatus struct {
+	// Object Group.
+	Group string `json:"group"`
+	// Object Version.
+	Version string `json:"version"`
+	// Object Kind.
+	Kind string `json:"kind"`
+	// Object Name.
+	Name string `json:"name"`
+	// Object Namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// True if the object passes all probes.
+	// +kubebuilder:validation:Enum=True;False
+	Status metav1.ConditionStatus `json:"status"`
+	// Why the object failed its probes.
+	Message string `json:"message,omitempty"`
+	// Last time the status changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
 // ObjectSet Condition Types.
 const (
 	// Available indicates that all objects pass their availability probe.
@@ -119,6 +305,15 @@ const (
 	// InTransition condition is True when the ObjectSet is not in control of all objects defined in spec.
 	// This holds true during rollout of the first instance or while handing over objects between two ObjectSets.
 	ObjectSetInTransition = "InTransition"
+	// Drifted is True when objects were changed outside of Package Operator,
+	// since they were last reconciled.
+	ObjectSetDrifted = "Drifted"
+	// AdoptionDryRun is True when objects would be adopted,
+	// if the adoption policy was not in dry run mode.
+	ObjectSetAdoptionDryRun = "AdoptionDryRun"
+	// HooksSkipped is True when hooks could not be run,
+	// e.g. PreDelete hooks targeting a namespace that is already terminating.
+	ObjectSetHooksSkipped = "HooksSkipped"
 )
 
 // ObjectSetStatusPhase defines the status phase of an object set.
@@ -183,6 +378,9 @@ type Probe struct {
 	Condition   *ProbeConditionSpec   `json:"condition,omitempty"`
 	FieldsEqual *ProbeFieldsEqualSpec `json:"fieldsEqual,omitempty"`
 	CEL         *ProbeCELSpec         `json:"cel,omitempty"`
+	HTTP        *ProbeHTTPSpec        `json:"http,omitempty"`
+	TCP         *ProbeTCPSpec         `json:"tcp,omitempty"`
+	Count       *ProbeCountSpec       `json:"count,omitempty"`
 }
 
 // ProbeConditionSpec checks whether or not the object reports a condition with given type and status.
@@ -217,6 +415,96 @@ type ProbeCELSpec struct {
 	// Error message to output if rule evaluates to false.
 	// +example=Object must be named Hans
 	Message string `json:"message"`
+	// Other objects to make available to the rule.
+	// Each reference is exposed as a variable with the given name,
+	// which is an empty map when the object does not exist, e.g. check with has(hpa.metadata).
+	// Only objects managed by Package Operator can be referenced.
+	// Fields of objects of kinds built into Kubernetes are checked when the rule is compiled.
+	References []ProbeCELReference `json:"references,omitempty"`
+}
+
+// ProbeCELReference makes another object available to a CEL rule.
+type ProbeCELReference struct {
+	// Name of the variable the object is bound to in the CEL rule.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	// +example=hpa
+	Variable string `json:"variable"`
+	// APIVersion of the referenced object.
+	// +example=autoscaling/v2
+	APIVersion string `json:"apiVersion"`
+	// Kind of the referenced object.
+	// +example=HorizontalPodAutoscaler
+	Kind string `json:"kind"`
+	// Name of the referenced object.
+	// +example=my-deployment
+	Name string `json:"name"`
+	// Namespace of the referenced object.
+	// Defaults to the namespace of the probed object.
+	// Namespaced owners can only reference objects in their own namespace.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ProbeHTTPSpec sends a HTTP GET request to a port of the probed Service
+// and checks the response status code.
+// Results are cached for a few seconds to not overload the endpoint.
+// Objects other than Services and objects of remote phases always fail this probe.
+type ProbeHTTPSpec struct {
+	// Port of the Service to send the request to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +example=8080
+	Port int32 `json:"port"`
+	// Path to request.
+	// +kubebuilder:default="/"
+	// +example=/healthz
+	Path string `json:"path,omitempty"`
+	// Scheme to use for the request.
+	// Certificates are not verified when using HTTPS.
+	// +kubebuilder:default=HTTP
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	Scheme string `json:"scheme,omitempty"`
+	// Expected response status code.
+	// +kubebuilder:default=200
+	ExpectedStatus int32 `json:"expectedStatus,omitempty"`
+	// Seconds after which the request times out.
+	// +kubebuilder:default=1
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ProbeTCPSpec opens a TCP connection to a port of the probed Service.
+// Results are cached for a few seconds to not overload the endpoint.
+// Objects other than Services and objects of remote phases always fail this probe.
+type ProbeTCPSpec struct {
+	// Port of the Service to connect to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +example=5432
+	Port int32 `json:"port"`
+	// Seconds after which the connection attempt times out.
+	// +kubebuilder:default=1
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ProbeCountSpec counts objects of a kind matching a label selector
+// and checks that there are at least the given number of them.
+// Objects are read from the cache of Package Operator, so only objects managed by Package Operator are counted.
+type ProbeCountSpec struct {
+	// APIVersion of the objects to count.
+	// +example=v1
+	APIVersion string `json:"apiVersion"`
+	// Kind of the objects to count.
+	// +example=ConfigMap
+	Kind string `json:"kind"`
+	// Label selector the counted objects have to match.
+	Selector metav1.LabelSelector `json:"selector"`
+	// Namespace to count objects in.
+	// Defaults to the namespace of the probed object.
+	// Namespaced owners can only count objects in their own namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// Minimum number of matching objects.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	Min int32 `json:"min,omitempty"`
 }
 
 // PreviousRevisionReference references a previous revision of an ObjectSet or ClusterObjectSet.
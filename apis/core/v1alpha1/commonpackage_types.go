@@ -36,6 +36,8 @@ const (
 	// - Malformed Yaml
 	// - Issues resulting from the template process.
 	PackageInvalid = "Invalid"
+	// Paused is True while reconciliation of the Package is paused via .spec.paused.
+	PackagePaused = "Paused"
 )
 
 // PackageStatusPhase defines a status phase of a package.
@@ -62,7 +64,77 @@ type PackageSpec struct {
 	// Package configuration parameters.
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Config *runtime.RawExtension `json:"config,omitempty"`
+	// Sources to merge package configuration parameters from.
+	// Sources are looked up in the namespace of the Package.
+	// ClusterPackages look up sources in the namespace Package Operator is deployed into,
+	// unless a different namespace is set via its --config-source-namespace flag.
+	// Values are parsed as YAML or JSON, so numbers, booleans, lists and objects keep their type,
+	// values that can't be parsed are used as plain strings.
+	// Later sources take precedence over earlier ones and .spec.config takes precedence over all sources.
+	// +optional
+	ConfigFrom []PackageConfigSource `json:"configFrom,omitempty"`
 	// Desired component to deploy from multi-component packages.
 	// +optional
 	Component string `json:"component,omitempty"`
+	// References to Secrets holding registry credentials to pull the package image.
+	// Secrets are looked up in the namespace of the Package.
+	// ClusterPackages look up Secrets in the namespace Package Operator is deployed into.
+	// +optional
+	ImagePullSecrets []ImagePullSecretReference `json:"imagePullSecrets,omitempty"`
+	// Paused stops rolling out new revisions of the package and
+	// pauses reconciliation of all its ObjectSets.
+	// Status is still reported while paused.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// Strategy controls when previous revisions of the package are replaced by a new revision.
+	// +optional
+	Strategy *ObjectDeploymentStrategy `json:"strategy,omitempty"`
+	// DeletionPolicy determines what happens to objects of the package when they are no longer managed,
+	// e.g. when the package is deleted. Defaults to "Delete".
+	// Objects may override this policy via the "package-operator.run/deletion-policy" annotation.
+	// +kubebuilder:validation:Enum=Delete;Orphan;Retain
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+	// Adoption allows the package to take over objects already present on the cluster,
+	// e.g. to migrate manually applied objects into the package.
+	// +optional
+	Adoption *AdoptionPolicy `json:"adoption,omitempty"`
+}
+
+// PackageConfigSource references a Secret or ConfigMap to read configuration parameters from.
+// +kubebuilder:validation:XValidation:rule="!has(self.destination) || has(self.key)", message="destination requires key"
+type PackageConfigSource struct {
+	// Kind of the source object.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	Kind PackageConfigSourceKind `json:"kind"`
+	// Name of the source object.
+	Name string `json:"name"`
+	// Key in the data of the source object to read.
+	// All keys are merged into the configuration if unset.
+	// +optional
+	Key string `json:"key,omitempty"`
+	// Dot-separated path in the configuration to write the value of Key to.
+	// Defaults to Key. May only be set together with Key.
+	// +example=database.password
+	// +optional
+	Destination string `json:"destination,omitempty"`
+	// Optional sources do not block unpacking when the source object or key does not exist.
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// PackageConfigSourceKind is the kind of object a package configuration source references.
+type PackageConfigSourceKind string
+
+const (
+	PackageConfigSourceKindSecret    PackageConfigSourceKind = "Secret"
+	PackageConfigSourceKindConfigMap PackageConfigSourceKind = "ConfigMap"
+)
+
+// ImagePullSecretReference references a Secret of type
+// kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg.
+type ImagePullSecretReference struct {
+	// Name of the Secret.
+	// +example=my-registry-credentials
+	Name string `json:"name"`
 }
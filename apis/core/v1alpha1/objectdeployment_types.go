@@ -13,8 +13,46 @@ type ObjectDeploymentSpec struct {
 	Selector metav1.LabelSelector `json:"selector"`
 	// Template to create new ObjectSets from.
 	Template ObjectSetTemplate `json:"template"`
+	// Paused stops the creation of new revisions and
+	// pauses reconciliation of all ObjectSets of this deployment.
+	// Status is still reported while paused.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// Strategy controls when previous revisions are replaced by a new revision.
+	// +optional
+	Strategy *ObjectDeploymentStrategy `json:"strategy,omitempty"`
 }
 
+// ObjectDeploymentStrategy holds a new revision back,
+// keeping previous revisions active until it has proven itself.
+// Revisions are always held back as a whole,
+// there are no timed pauses between the phases of a revision.
+type ObjectDeploymentStrategy struct {
+	// Seconds a new revision has to stay Available before previous revisions are archived.
+	// Raises the successDelaySeconds of new ObjectSets to this value.
+	// +optional
+	ArchiveDelaySeconds int32 `json:"archiveDelaySeconds,omitempty"`
+	// Keeps previous revisions active until the new revision is promoted
+	// by setting the "package-operator.run/promoted" annotation on its ObjectSet to "True".
+	// +optional
+	ManualPromotion bool `json:"manualPromotion,omitempty"`
+	// Seconds a new revision may take to become Available,
+	// before the deployment reports Progressing=False with reason ProgressDeadlineExceeded.
+	// Measured from the creation of the revision, the last time the deployment was resumed
+	// or the last change of its Available condition, whichever is latest.
+	// Not checked while the deployment is paused.
+	// +optional
+	ProgressDeadlineSeconds int32 `json:"progressDeadlineSeconds,omitempty"`
+	// Re-activates the previous revision when a new revision failed to become Available
+	// within progressDeadlineSeconds. The failed revision is archived,
+	// after the previous revision took over the objects of both revisions.
+	// +optional
+	RollbackOnFailure bool `json:"rollbackOnFailure,omitempty"`
+}
+
+// ObjectSetPromotedAnnotation promotes a revision of an ObjectDeployment with manual promotion.
+const ObjectSetPromotedAnnotation = "package-operator.run/promoted"
+
 // ObjectSetTemplate describes the template to create new ObjectSets from.
 type ObjectSetTemplate struct {
 	// Common Object Metadata.
@@ -43,6 +81,11 @@ type ObjectDeploymentStatus struct {
 const (
 	ObjectDeploymentAvailable   = "Available"
 	ObjectDeploymentProgressing = "Progressing"
+	// Paused is True while reconciliation of the ObjectDeployment is paused via .spec.paused.
+	ObjectDeploymentPaused = "Paused"
+	// RolledBack is True when a new revision failed to become available
+	// and the previous revision has been re-activated.
+	ObjectDeploymentRolledBack = "RolledBack"
 )
 
 // ObjectDeploymentPhase specifies a phase that a deployment is in.
@@ -0,0 +1,57 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ObjectOwnership records the owners of a single object,
+// for objects that must not be mutated to carry owner references themselves.
+// ObjectOwnerships are managed by Package Operator and should not be edited by hand.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName={"objown"}
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.object.kind"
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.object.namespace"
+// +kubebuilder:printcolumn:name="Name",type="string",JSONPath=".spec.object.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type ObjectOwnership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ObjectOwnershipSpec `json:"spec,omitempty"`
+}
+
+// ObjectOwnershipSpec references the owned object and lists its owners.
+type ObjectOwnershipSpec struct {
+	// Object that is owned.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="object is immutable"
+	Object ControlledObjectReference `json:"object"`
+	// Owners of the object.
+	Owners []ObjectOwner `json:"owners,omitempty"`
+}
+
+// ObjectOwner references an owner of an object.
+type ObjectOwner struct {
+	// API version of the owner.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the owner.
+	Kind string `json:"kind"`
+	// Name of the owner.
+	Name string `json:"name"`
+	// Namespace of the owner.
+	Namespace string `json:"namespace,omitempty"`
+	// UID of the owner.
+	UID types.UID `json:"uid"`
+	// If true, this owner is the managing controller of the object.
+	Controller bool `json:"controller,omitempty"`
+}
+
+// ObjectOwnershipList contains a list of ObjectOwnerships.
+// +kubebuilder:object:root=true
+type ObjectOwnershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObjectOwnership `json:"items"`
+}
+
+func init() { register(&ObjectOwnership{}, &ObjectOwnershipList{}) }
@@ -28,6 +28,7 @@ type ObjectSetPhaseList struct {
 // +kubebuilder:validation:XValidation:rule="has(self.previous) == has(oldSelf.previous)", message="previous is immutable"
 // +kubebuilder:validation:XValidation:rule="has(self.availabilityProbes) == has(oldSelf.availabilityProbes)", message="availabilityProbes is immutable"
 // +kubebuilder:validation:XValidation:rule="has(self.externalObjects) == has(oldSelf.externalObjects)", message="externalObjects is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.adoption) == has(oldSelf.adoption)", message="adoption is immutable"
 //
 //nolint:lll
 type ObjectSetPhaseSpec struct {
@@ -67,6 +68,11 @@ type ObjectSetPhaseSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="externalObjects is immutable"
 	// +kubebuilder:MaxItems=32
 	ExternalObjects []ObjectSetObject `json:"externalObjects,omitempty"`
+
+	// Adoption allows taking over objects already present on the cluster.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="adoption is immutable"
+	Adoption *AdoptionPolicy `json:"adoption,omitempty"`
 }
 
 // ObjectSetPhaseStatus defines the observed state of a ObjectSetPhase.
@@ -76,6 +82,8 @@ type ObjectSetPhaseStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// References all objects controlled by this instance.
 	ControllerOf []ControlledObjectReference `json:"controllerOf,omitempty"`
+	// Last availability probe result of each probed object.
+	ProbedObjects []ObjectProbeStatus `json:"probedObjects,omitempty"`
 }
 
 func init() { register(&ObjectSetPhase{}, &ObjectSetPhaseList{}) }
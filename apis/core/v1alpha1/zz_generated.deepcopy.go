@@ -9,6 +9,26 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdoptionPolicy) DeepCopyInto(out *AdoptionPolicy) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdoptionPolicy.
+func (in *AdoptionPolicy) DeepCopy() *AdoptionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AdoptionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterObjectDeployment) DeepCopyInto(out *ClusterObjectDeployment) {
 	*out = *in
@@ -78,6 +98,11 @@ func (in *ClusterObjectDeploymentSpec) DeepCopyInto(out *ClusterObjectDeployment
 	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Template.DeepCopyInto(&out.Template)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(ObjectDeploymentStrategy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObjectDeploymentSpec.
@@ -264,6 +289,11 @@ func (in *ClusterObjectSetPhaseSpec) DeepCopyInto(out *ClusterObjectSetPhaseSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(AdoptionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObjectSetPhaseSpec.
@@ -291,6 +321,13 @@ func (in *ClusterObjectSetPhaseStatus) DeepCopyInto(out *ClusterObjectSetPhaseSt
 		*out = make([]ControlledObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProbedObjects != nil {
+		in, out := &in.ProbedObjects, &out.ProbedObjects
+		*out = make([]ObjectProbeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObjectSetPhaseStatus.
@@ -344,6 +381,25 @@ func (in *ClusterObjectSetStatus) DeepCopyInto(out *ClusterObjectSetStatus) {
 		*out = make([]ControlledObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]ObjectSetHookStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftedObjects != nil {
+		in, out := &in.DriftedObjects, &out.DriftedObjects
+		*out = make([]DriftedObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProbedObjects != nil {
+		in, out := &in.ProbedObjects, &out.ProbedObjects
+		*out = make([]ObjectProbeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObjectSetStatus.
@@ -568,6 +624,42 @@ func (in *ControlledObjectReference) DeepCopy() *ControlledObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedObject) DeepCopyInto(out *DriftedObject) {
+	*out = *in
+	out.Object = in.Object
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedObject.
+func (in *DriftedObject) DeepCopy() *DriftedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullSecretReference) DeepCopyInto(out *ImagePullSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecretReference.
+func (in *ImagePullSecretReference) DeepCopy() *ImagePullSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectDeployment) DeepCopyInto(out *ObjectDeployment) {
 	*out = *in
@@ -637,6 +729,11 @@ func (in *ObjectDeploymentSpec) DeepCopyInto(out *ObjectDeploymentSpec) {
 	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Template.DeepCopyInto(&out.Template)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(ObjectDeploymentStrategy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeploymentSpec.
@@ -676,6 +773,131 @@ func (in *ObjectDeploymentStatus) DeepCopy() *ObjectDeploymentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectDeploymentStrategy) DeepCopyInto(out *ObjectDeploymentStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeploymentStrategy.
+func (in *ObjectDeploymentStrategy) DeepCopy() *ObjectDeploymentStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectDeploymentStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectOwner) DeepCopyInto(out *ObjectOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectOwner.
+func (in *ObjectOwner) DeepCopy() *ObjectOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectOwnership) DeepCopyInto(out *ObjectOwnership) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectOwnership.
+func (in *ObjectOwnership) DeepCopy() *ObjectOwnership {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectOwnership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectOwnership) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectOwnershipList) DeepCopyInto(out *ObjectOwnershipList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectOwnership, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectOwnershipList.
+func (in *ObjectOwnershipList) DeepCopy() *ObjectOwnershipList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectOwnershipList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectOwnershipList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectOwnershipSpec) DeepCopyInto(out *ObjectOwnershipSpec) {
+	*out = *in
+	out.Object = in.Object
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]ObjectOwner, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectOwnershipSpec.
+func (in *ObjectOwnershipSpec) DeepCopy() *ObjectOwnershipSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectOwnershipSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectProbeStatus) DeepCopyInto(out *ObjectProbeStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectProbeStatus.
+func (in *ObjectProbeStatus) DeepCopy() *ObjectProbeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectProbeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectSet) DeepCopyInto(out *ObjectSet) {
 	*out = *in
@@ -703,6 +925,38 @@ func (in *ObjectSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetHook) DeepCopyInto(out *ObjectSetHook) {
+	*out = *in
+	in.Object.DeepCopyInto(&out.Object)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetHook.
+func (in *ObjectSetHook) DeepCopy() *ObjectSetHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetHookStatus) DeepCopyInto(out *ObjectSetHookStatus) {
+	*out = *in
+	out.Object = in.Object
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetHookStatus.
+func (in *ObjectSetHookStatus) DeepCopy() *ObjectSetHookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetHookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectSetList) DeepCopyInto(out *ObjectSetList) {
 	*out = *in
@@ -844,6 +1098,11 @@ func (in *ObjectSetPhaseSpec) DeepCopyInto(out *ObjectSetPhaseSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(AdoptionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseSpec.
@@ -871,6 +1130,13 @@ func (in *ObjectSetPhaseStatus) DeepCopyInto(out *ObjectSetPhaseStatus) {
 		*out = make([]ControlledObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProbedObjects != nil {
+		in, out := &in.ProbedObjects, &out.ProbedObjects
+		*out = make([]ObjectProbeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseStatus.
@@ -947,6 +1213,25 @@ func (in *ObjectSetStatus) DeepCopyInto(out *ObjectSetStatus) {
 		*out = make([]ControlledObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]ObjectSetHookStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftedObjects != nil {
+		in, out := &in.DriftedObjects, &out.DriftedObjects
+		*out = make([]DriftedObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProbedObjects != nil {
+		in, out := &in.ProbedObjects, &out.ProbedObjects
+		*out = make([]ObjectProbeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetStatus.
@@ -1027,6 +1312,18 @@ func (in *ObjectSetTemplateSpec) DeepCopyInto(out *ObjectSetTemplateSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]ObjectSetHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(AdoptionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetTemplateSpec.
@@ -1268,6 +1565,21 @@ func (in *Package) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageConfigSource) DeepCopyInto(out *PackageConfigSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageConfigSource.
+func (in *PackageConfigSource) DeepCopy() *PackageConfigSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageConfigSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageList) DeepCopyInto(out *PackageList) {
 	*out = *in
@@ -1323,6 +1635,26 @@ func (in *PackageSpec) DeepCopyInto(out *PackageSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConfigFrom != nil {
+		in, out := &in.ConfigFrom, &out.ConfigFrom
+		*out = make([]PackageConfigSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]ImagePullSecretReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(ObjectDeploymentStrategy)
+		**out = **in
+	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(AdoptionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageSpec.
@@ -1388,8 +1720,23 @@ func (in *Probe) DeepCopyInto(out *Probe) {
 	if in.CEL != nil {
 		in, out := &in.CEL, &out.CEL
 		*out = new(ProbeCELSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ProbeHTTPSpec)
+		**out = **in
+	}
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(ProbeTCPSpec)
 		**out = **in
 	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(ProbeCountSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
@@ -1402,9 +1749,29 @@ func (in *Probe) DeepCopy() *Probe {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeCELReference) DeepCopyInto(out *ProbeCELReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeCELReference.
+func (in *ProbeCELReference) DeepCopy() *ProbeCELReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeCELReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeCELSpec) DeepCopyInto(out *ProbeCELSpec) {
 	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]ProbeCELReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeCELSpec.
@@ -1432,6 +1799,22 @@ func (in *ProbeConditionSpec) DeepCopy() *ProbeConditionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeCountSpec) DeepCopyInto(out *ProbeCountSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeCountSpec.
+func (in *ProbeCountSpec) DeepCopy() *ProbeCountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeCountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeFieldsEqualSpec) DeepCopyInto(out *ProbeFieldsEqualSpec) {
 	*out = *in
@@ -1447,6 +1830,21 @@ func (in *ProbeFieldsEqualSpec) DeepCopy() *ProbeFieldsEqualSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeHTTPSpec) DeepCopyInto(out *ProbeHTTPSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeHTTPSpec.
+func (in *ProbeHTTPSpec) DeepCopy() *ProbeHTTPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeHTTPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeSelector) DeepCopyInto(out *ProbeSelector) {
 	*out = *in
@@ -1472,6 +1870,21 @@ func (in *ProbeSelector) DeepCopy() *ProbeSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTCPSpec) DeepCopyInto(out *ProbeTCPSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTCPSpec.
+func (in *ProbeTCPSpec) DeepCopy() *ProbeTCPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTCPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemotePhaseReference) DeepCopyInto(out *RemotePhaseReference) {
 	*out = *in
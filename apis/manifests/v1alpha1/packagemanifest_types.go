@@ -25,6 +25,23 @@ const (
 	// PackageCollisionProtectionAnnotation prevents Package Operator from working
 	// on objects already under management by a different operator.
 	PackageCollisionProtectionAnnotation = "package-operator.run/collision-protection"
+	// PackageWaveAnnotation assigns objects to a wave within their phase.
+	// Waves are integers, objects of lower waves are reconciled first.
+	PackageWaveAnnotation = "package-operator.run/wave"
+	// PackageHookAnnotation turns the object into a lifecycle hook,
+	// that is run to completion outside of the phases.
+	// Valid values are "PreInstall", "PreUpgrade" and "PreDelete".
+	PackageHookAnnotation = "package-operator.run/hook"
+	// PackageHookRetentionPolicyAnnotation determines if a hook object is deleted after completion.
+	// Valid values are "Retain", "DeleteOnSuccess" and "Delete".
+	PackageHookRetentionPolicyAnnotation = "package-operator.run/hook-retention-policy"
+	// PackageHookFailurePolicyAnnotation determines how a failing hook is handled.
+	// Valid values are "Abort" and "Ignore".
+	PackageHookFailurePolicyAnnotation = "package-operator.run/hook-failure-policy"
+	// PackageDeletionPolicyAnnotation determines what happens to the object when it is no longer managed.
+	// Valid values are "Delete", "Orphan" and "Retain".
+	// Overrides the deletion policy of the Package.
+	PackageDeletionPolicyAnnotation = "package-operator.run/deletion-policy"
 )
 
 const (
@@ -34,8 +51,15 @@ const (
 	PackageSourceImageAnnotation = "package-operator.run/package-source-image"
 	// PackageConfigAnnotation contains the configuration for this object.
 	PackageConfigAnnotation = "package-operator.run/package-config"
+	// PackageSpecAnnotation contains the JSON encoded (Cluster)Package spec fields this object was rendered from:
+	// image, config, configFrom, component, deletionPolicy and adoption.
+	PackageSpecAnnotation = "package-operator.run/package-spec"
 	// PackageInstanceLabel contains the name of the Package instance.
 	PackageInstanceLabel = "package-operator.run/instance"
+	// PackageDependenciesAnnotation contains a comma separated list of "<name>=<image>" entries,
+	// naming the (Cluster)Packages that are installed with the given digest pinned image
+	// and that have to be available for this package to become available.
+	PackageDependenciesAnnotation = "package-operator.run/dependencies"
 )
 
 // PackageManifest defines the manifest of a package.
@@ -252,6 +276,37 @@ type PackageManifestTestCaseTemplate struct {
 	Name string `json:"name"`
 	// Template data to use in the test case.
 	Context TemplateContext `json:"context,omitempty"`
+	// Errors the test case is expected to fail with.
+	// Each expected error has to match at least one error reported by the test case.
+	// Fixtures are not generated or compared for test cases expecting errors.
+	ExpectErrors []PackageManifestTestCaseExpectedError `json:"expectErrors,omitempty"`
+	// CEL expressions asserting properties of the rendered objects.
+	// Assertions are checked in addition to the fixtures of the test case.
+	Assertions []PackageManifestTestCaseAssertion `json:"assertions,omitempty"`
+}
+
+// PackageManifestTestCaseExpectedError describes an error a test case is expected to fail with.
+// All specified fields have to match the same error.
+type PackageManifestTestCaseExpectedError struct {
+	// Reason of the package violation.
+	// +example=Invalid YAML
+	Reason string `json:"reason,omitempty"`
+	// Path of the configuration field failing validation.
+	// +example=spec.replicas
+	Field string `json:"field,omitempty"`
+	// Text contained in the error message.
+	Message string `json:"message,omitempty"`
+}
+
+// PackageManifestTestCaseAssertion is a CEL expression evaluated against the rendered objects of a test case.
+type PackageManifestTestCaseAssertion struct {
+	// Name describing the assertion.
+	Name string `json:"name"`
+	// CEL expression that has to evaluate to true.
+	// The variable "objects" holds the list of all rendered objects, after CEL filtering,
+	// and "config" holds the validated and defaulted configuration of the test case.
+	// +example=objects.exists(o, o.kind == "Deployment" && o.spec.replicas == 3)
+	Expression string `json:"expression"`
 }
 
 // PackageManifestTestKubeconform configures kubeconform testing.
@@ -560,10 +560,50 @@ func (in *PackageManifestTest) DeepCopy() *PackageManifestTest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageManifestTestCaseAssertion) DeepCopyInto(out *PackageManifestTestCaseAssertion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestTestCaseAssertion.
+func (in *PackageManifestTestCaseAssertion) DeepCopy() *PackageManifestTestCaseAssertion {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageManifestTestCaseAssertion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageManifestTestCaseExpectedError) DeepCopyInto(out *PackageManifestTestCaseExpectedError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestTestCaseExpectedError.
+func (in *PackageManifestTestCaseExpectedError) DeepCopy() *PackageManifestTestCaseExpectedError {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageManifestTestCaseExpectedError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageManifestTestCaseTemplate) DeepCopyInto(out *PackageManifestTestCaseTemplate) {
 	*out = *in
 	in.Context.DeepCopyInto(&out.Context)
+	if in.ExpectErrors != nil {
+		in, out := &in.ExpectErrors, &out.ExpectErrors
+		*out = make([]PackageManifestTestCaseExpectedError, len(*in))
+		copy(*out, *in)
+	}
+	if in.Assertions != nil {
+		in, out := &in.Assertions, &out.Assertions
+		*out = make([]PackageManifestTestCaseAssertion, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestTestCaseTemplate.
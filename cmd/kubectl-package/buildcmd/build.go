@@ -22,7 +22,7 @@ type Builder interface {
 
 func NewCmd(builderFactory BuilderFactory) *cobra.Command {
 	const (
-		buildUse   = "build source_path [--tag tag]... [--output output_path] [--push]"
+		buildUse   = "build source_path [--tag tag]... [--output output_path] [--push [--sign-key key_path]]"
 		buildShort = "build an PKO package image using manifests at the given path"
 		buildLong  = "builds and optionally pushes an OCI image in the Package Operator" +
 			" package format from the specified build context directory."
@@ -48,6 +48,9 @@ func NewCmd(builderFactory BuilderFactory) *cobra.Command {
 		if (opts.OutputPath != "" || opts.Push) && len(opts.Tags) == 0 {
 			return fmt.Errorf("%w: output or push is requested but no tags are set", internalcmd.ErrInvalidArgs)
 		}
+		if opts.SigningKeyPath != "" && !opts.Push {
+			return fmt.Errorf("%w: signing is requested but push is not", internalcmd.ErrInvalidArgs)
+		}
 		for _, ref := range opts.Tags {
 			if _, err = name.ParseReference(ref); err != nil {
 				return fmt.Errorf("invalid tag specified as parameter %s: %w", ref, err)
@@ -59,6 +62,7 @@ func NewCmd(builderFactory BuilderFactory) *cobra.Command {
 			internalcmd.WithInsecure(opts.Insecure),
 			internalcmd.WithOutputPath(opts.OutputPath),
 			internalcmd.WithPush(opts.Push),
+			internalcmd.WithSigningKey(opts.SigningKeyPath),
 			internalcmd.WithTags(opts.Tags),
 		); err != nil {
 			return fmt.Errorf("building from source: %w", err)
@@ -74,10 +78,11 @@ func NewCmd(builderFactory BuilderFactory) *cobra.Command {
 }
 
 type options struct {
-	Insecure   bool
-	OutputPath string
-	Push       bool
-	Tags       []string
+	Insecure       bool
+	OutputPath     string
+	Push           bool
+	SigningKeyPath string
+	Tags           []string
 }
 
 func (o *options) AddFlags(flags *pflag.FlagSet) {
@@ -100,6 +105,17 @@ func (o *options) AddFlags(flags *pflag.FlagSet) {
 		o.Push,
 		"Push the created image tags. Defaults to false",
 	)
+	flags.StringVar(
+		&o.SigningKeyPath,
+		"sign-key",
+		"",
+		strings.Join([]string{
+			"Path to a PEM encoded ECDSA, RSA or Ed25519 private key.",
+			"Pushed images are signed with this key in the cosign signature format.",
+			"Requires --push.",
+			"Defaults to none.",
+		}, " "),
+	)
 	flags.StringVarP(
 		&o.OutputPath,
 		"output",
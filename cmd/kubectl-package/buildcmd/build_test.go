@@ -92,6 +92,21 @@ func TestBuildPushWOTags(t *testing.T) {
 	require.Error(t, cmd.Execute())
 }
 
+func TestBuildSignWOPush(t *testing.T) {
+	t.Parallel()
+	factory := &builderFactoryMock{}
+	factory.On("Builder").Return(internalcmd.NewBuild())
+
+	cmd := NewCmd(factory)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{".", "--tag", "chicken:oldest", "--sign-key", "cosign.key"})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrInvalidArgs)
+}
+
 func TestBuildOutputWOTags(t *testing.T) {
 	t.Parallel()
 
@@ -31,7 +31,7 @@ func TestClusterTreeCmd(t *testing.T) {
 	const expectedPackageOutput = `Package /test
 namespace/test
 └── Phase phase-1
-    └── /v1, Kind=ConfigMap /cm-4
+    └── /v1, Kind=ConfigMap /cm-4 (Unavailable: not found)
 `
 	cm4 := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -153,6 +153,10 @@ namespace/test
 
 					Status: corev1alpha1.ObjectSetStatus{
 						Phase: corev1alpha1.ObjectSetStatusPhaseAvailable,
+						ProbedObjects: []corev1alpha1.ObjectProbeStatus{{
+							Version: "v1", Kind: "ConfigMap", Name: "cm-4", Namespace: "test",
+							Status: metav1.ConditionFalse, Message: "not found",
+						}},
 					},
 				},
 			},
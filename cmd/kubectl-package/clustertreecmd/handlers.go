@@ -5,8 +5,11 @@ import (
 
 	"github.com/disiqueira/gotree"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	internalcmd "package-operator.run/internal/cmd"
 )
 
@@ -24,16 +27,18 @@ func handlePackage(clientL *internalcmd.Client, nsPackage *internalcmd.Package,
 
 		for _, obj := range phase.Objects {
 			treePhase.Add(
-				fmt.Sprintf("%s %s",
+				fmt.Sprintf("%s %s%s",
 					obj.Object.GroupVersionKind(),
-					client.ObjectKeyFromObject(&obj.Object)))
+					client.ObjectKeyFromObject(&obj.Object),
+					probeStatus(result.Status.ProbedObjects, &obj.Object, nsPackage.Namespace())))
 		}
 
 		for _, obj := range phase.ExternalObjects {
 			treePhase.Add(
-				fmt.Sprintf("%s %s (EXTERNAL)",
+				fmt.Sprintf("%s %s (EXTERNAL)%s",
 					obj.Object.GroupVersionKind(),
-					client.ObjectKeyFromObject(&obj.Object)))
+					client.ObjectKeyFromObject(&obj.Object),
+					probeStatus(result.Status.ProbedObjects, &obj.Object, nsPackage.Namespace())))
 		}
 	}
 	return tree.Print(), nil
@@ -56,17 +61,42 @@ func handleClusterPackage(clientL *internalcmd.Client, clsPackage *internalcmd.P
 
 		for _, obj := range phase.Objects {
 			treePhase.Add(
-				fmt.Sprintf("%s %s",
+				fmt.Sprintf("%s %s%s",
 					obj.Object.GroupVersionKind(),
-					client.ObjectKeyFromObject(&obj.Object)))
+					client.ObjectKeyFromObject(&obj.Object),
+					probeStatus(result.Status.ProbedObjects, &obj.Object, "")))
 		}
 
 		for _, obj := range phase.ExternalObjects {
 			treePhase.Add(
-				fmt.Sprintf("%s %s (EXTERNAL)",
+				fmt.Sprintf("%s %s (EXTERNAL)%s",
 					obj.Object.GroupVersionKind(),
-					client.ObjectKeyFromObject(&obj.Object)))
+					client.ObjectKeyFromObject(&obj.Object),
+					probeStatus(result.Status.ProbedObjects, &obj.Object, "")))
 		}
 	}
 	return tree.Print(), nil
 }
+
+// Returns the last probe result of obj, if reported.
+// defaultNamespace is used for objects without namespace.
+func probeStatus(
+	probed []corev1alpha1.ObjectProbeStatus, obj *unstructured.Unstructured, defaultNamespace string,
+) string {
+	gvk := obj.GroupVersionKind()
+	namespace := obj.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = defaultNamespace
+	}
+	for _, status := range probed {
+		if status.Group != gvk.Group || status.Kind != gvk.Kind ||
+			status.Name != obj.GetName() || status.Namespace != namespace {
+			continue
+		}
+		if status.Status == metav1.ConditionTrue {
+			return " (Available)"
+		}
+		return fmt.Sprintf(" (Unavailable: %s)", status.Message)
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package configcmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type Configuration interface {
+	Schema(ctx context.Context, src string, opts ...internalcmd.ConfigurationSourceOption) ([]byte, error)
+	Validate(
+		ctx context.Context, src string, config map[string]any, opts ...internalcmd.ConfigurationSourceOption,
+	) (field.ErrorList, error)
+	Example(ctx context.Context, src string, opts ...internalcmd.ConfigurationSourceOption) (string, error)
+}
+
+func NewCmd(configuration Configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "inspect and validate package configuration",
+	}
+
+	cmd.AddCommand(
+		newSchemaCmd(configuration),
+		newValidateCmd(configuration),
+		newExampleCmd(configuration),
+	)
+
+	return cmd
+}
+
+type sourceOptions struct {
+	Insecure  bool
+	Component string
+}
+
+func (o *sourceOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Insecure,
+		"insecure",
+		o.Insecure,
+		"Allows pulling images without TLS or using TLS with unverified certificates.",
+	)
+	flags.StringVar(
+		&o.Component,
+		"component",
+		o.Component,
+		"select which component to use",
+	)
+}
+
+func (o *sourceOptions) Options() []internalcmd.ConfigurationSourceOption {
+	return []internalcmd.ConfigurationSourceOption{
+		internalcmd.WithInsecure(o.Insecure),
+		internalcmd.WithComponent(o.Component),
+	}
+}
@@ -0,0 +1,82 @@
+package configcmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestConfigCmd_Schema(t *testing.T) {
+	t.Parallel()
+
+	stdout, err := executeConfigCmd(t, "schema", "testdata")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"$schema": "http://json-schema.org/draft-07/schema#"`)
+	assert.Contains(t, stdout, `"greeting"`)
+}
+
+func TestConfigCmd_Example(t *testing.T) {
+	t.Parallel()
+
+	stdout, err := executeConfigCmd(t, "example", "testdata")
+	require.NoError(t, err)
+	assert.Equal(t, `# Greeting to put into the ConfigMap.
+# type: string, required
+greeting: ""
+# type: integer
+replicas: 1
+`, stdout)
+}
+
+func TestConfigCmd_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		values := filepath.Join(t.TempDir(), "values.yaml")
+		require.NoError(t, os.WriteFile(values, []byte("greeting: hello\n"), 0o600))
+
+		stdout, err := executeConfigCmd(t, "validate", "testdata", "-f", values)
+		require.NoError(t, err)
+		assert.Equal(t, "Config validated successfully!\n", stdout)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		values := filepath.Join(t.TempDir(), "values.yaml")
+		require.NoError(t, os.WriteFile(values, []byte("replicas: many\n"), 0o600))
+
+		_, err := executeConfigCmd(t, "validate", "testdata", "-f", values)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "spec.config.greeting: Required value")
+		assert.Contains(t, err.Error(), "spec.config.replicas: Invalid value")
+	})
+
+	t.Run("missing filename", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := executeConfigCmd(t, "validate", "testdata")
+		require.ErrorIs(t, err, internalcmd.ErrInvalidArgs)
+	})
+}
+
+func executeConfigCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := NewCmd(internalcmd.NewConfiguration())
+	stdout := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+	return stdout.String(), err
+}
@@ -0,0 +1,38 @@
+package configcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newExampleCmd(configuration Configuration) *cobra.Command {
+	const (
+		cmdUse   = "example source_path|image"
+		cmdShort = "prints an example config file for a package"
+		cmdLong  = "prints a YAML config file for a package, containing all fields of the config schema " +
+			"set to their default values and commented with their descriptions"
+	)
+
+	var opts sourceOptions
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		example, err := configuration.Example(cmd.Context(), args[0], opts.Options()...)
+		if err != nil {
+			return fmt.Errorf("generating config example: %w", err)
+		}
+
+		_, err = fmt.Fprint(cmd.OutOrStdout(), example)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package configcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newSchemaCmd(configuration Configuration) *cobra.Command {
+	const (
+		cmdUse   = "schema source_path|image"
+		cmdShort = "prints the config schema of a package as JSON Schema"
+	)
+
+	var opts sourceOptions
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+	}
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		schema, err := configuration.Schema(cmd.Context(), args[0], opts.Options()...)
+		if err != nil {
+			return fmt.Errorf("getting config schema: %w", err)
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(schema))
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package configcmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func newValidateCmd(configuration Configuration) *cobra.Command {
+	const (
+		cmdUse   = "validate source_path|image -f values.yaml"
+		cmdShort = "validates a config file against the config schema of a package"
+		cmdLong  = "prunes, defaults and validates a config file against the config schema of a package, " +
+			"like Package Operator does when installing the package"
+		successMessage = "Config validated successfully!\n"
+	)
+
+	var (
+		opts     sourceOptions
+		filename string
+	)
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+	opts.AddFlags(cmd.Flags())
+	cmd.Flags().StringVarP(&filename, "filename", "f", "", "config file to validate")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if filename == "" {
+			return fmt.Errorf("%w: --filename must be set", internalcmd.ErrInvalidArgs)
+		}
+
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("reading config file: %w", err)
+		}
+		config := map[string]any{}
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			return fmt.Errorf("unmarshal config from file %s: %w", filename, err)
+		}
+		if config == nil {
+			// Empty file.
+			config = map[string]any{}
+		}
+
+		ferrs, err := configuration.Validate(cmd.Context(), args[0], config, opts.Options()...)
+		if err != nil {
+			return fmt.Errorf("validating config: %w", err)
+		}
+		if len(ferrs) > 0 {
+			return ferrs.ToAggregate()
+		}
+
+		_, err = fmt.Fprint(cmd.OutOrStdout(), successMessage)
+		return err
+	}
+
+	return cmd
+}
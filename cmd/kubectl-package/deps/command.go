@@ -1,23 +1,29 @@
 package deps
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/dig"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
 
 	"package-operator.run/cmd/kubectl-package/buildcmd"
 	clustertreecmd "package-operator.run/cmd/kubectl-package/clustertreecmd"
+	"package-operator.run/cmd/kubectl-package/configcmd"
+	"package-operator.run/cmd/kubectl-package/diffcmd"
 	"package-operator.run/cmd/kubectl-package/kickstartcmd"
 	"package-operator.run/cmd/kubectl-package/repocmd"
 	"package-operator.run/cmd/kubectl-package/rolloutcmd"
 	"package-operator.run/cmd/kubectl-package/rootcmd"
+	"package-operator.run/cmd/kubectl-package/testcmd"
 	"package-operator.run/cmd/kubectl-package/treecmd"
 	"package-operator.run/cmd/kubectl-package/updatecmd"
 	"package-operator.run/cmd/kubectl-package/validatecmd"
 	"package-operator.run/cmd/kubectl-package/versioncmd"
 	internalcmd "package-operator.run/internal/cmd"
+	"package-operator.run/internal/environment"
 )
 
 func ProvideIOStreams() rootcmd.IOStreams {
@@ -101,6 +107,30 @@ func ProvideValidator(scheme *runtime.Scheme) validatecmd.Validator {
 	return internalcmd.NewValidate(scheme)
 }
 
+func ProvideTestCmd(tester testcmd.Tester) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: testcmd.NewCmd(tester),
+	}
+}
+
+func ProvideTester(f LogFactory) testcmd.Tester {
+	return internalcmd.NewTest(
+		internalcmd.WithLog{
+			Log: f.Logger(),
+		},
+	)
+}
+
+func ProvideConfigCmd(configuration configcmd.Configuration) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: configcmd.NewCmd(configuration),
+	}
+}
+
+func ProvideConfiguration() configcmd.Configuration {
+	return internalcmd.NewConfiguration()
+}
+
 func ProvideBuildCmd(builderFactory buildcmd.BuilderFactory) RootSubCommandResult {
 	return RootSubCommandResult{
 		SubCommand: buildcmd.NewCmd(
@@ -163,6 +193,36 @@ func ProvideRolloutHistoryCmd(clientFactory internalcmd.ClientFactory) RolloutSu
 	}
 }
 
+func ProvideRolloutPauseCmd(clientFactory internalcmd.ClientFactory) RolloutSubCommandResult {
+	return RolloutSubCommandResult{
+		SubCommand: rolloutcmd.NewPauseCmd(clientFactory),
+	}
+}
+
+func ProvideRolloutPromoteCmd(clientFactory internalcmd.ClientFactory) RolloutSubCommandResult {
+	return RolloutSubCommandResult{
+		SubCommand: rolloutcmd.NewPromoteCmd(clientFactory),
+	}
+}
+
+func ProvideRolloutResumeCmd(clientFactory internalcmd.ClientFactory) RolloutSubCommandResult {
+	return RolloutSubCommandResult{
+		SubCommand: rolloutcmd.NewResumeCmd(clientFactory),
+	}
+}
+
+func ProvideRolloutStatusCmd(clientFactory internalcmd.ClientFactory) RolloutSubCommandResult {
+	return RolloutSubCommandResult{
+		SubCommand: rolloutcmd.NewStatusCmd(clientFactory),
+	}
+}
+
+func ProvideRolloutUndoCmd(clientFactory internalcmd.ClientFactory) RolloutSubCommandResult {
+	return RolloutSubCommandResult{
+		SubCommand: rolloutcmd.NewUndoCmd(clientFactory),
+	}
+}
+
 func ProvideClientFactory(kcliFactory internalcmd.KubeClientFactory) internalcmd.ClientFactory {
 	return internalcmd.NewDefaultClientFactory(kcliFactory)
 }
@@ -170,3 +230,55 @@ func ProvideClientFactory(kcliFactory internalcmd.KubeClientFactory) internalcmd
 func ProvideKickstarter() kickstartcmd.Kickstarter {
 	return internalcmd.NewKickstarter(os.Stdin)
 }
+
+func ProvideDiffCmd(differFactory diffcmd.DifferFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: diffcmd.NewCmd(differFactory),
+	}
+}
+
+func ProvideDifferFactory(
+	scheme *runtime.Scheme, f LogFactory,
+	kcliFactory internalcmd.KubeClientFactory, cfgFactory internalcmd.RestConfigFactory,
+) diffcmd.DifferFactory {
+	return &defaultDifferFactory{
+		cfgFactory:  cfgFactory,
+		kcliFactory: kcliFactory,
+		logFactory:  f,
+		scheme:      scheme,
+	}
+}
+
+type defaultDifferFactory struct {
+	cfgFactory  internalcmd.RestConfigFactory
+	kcliFactory internalcmd.KubeClientFactory
+	logFactory  LogFactory
+	scheme      *runtime.Scheme
+}
+
+func (f *defaultDifferFactory) Differ() (diffcmd.Differ, error) {
+	cfg, err := f.cfgFactory.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	kcli, err := f.kcliFactory.GetKubeClient()
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating discovery client: %w", err)
+	}
+
+	return internalcmd.NewDiff(
+		f.scheme, kcli,
+		internalcmd.WithLog{
+			Log: f.logFactory.Logger(),
+		},
+		internalcmd.WithEnvironmentProber{
+			Prober: environment.NewManager(kcli, discoveryClient, kcli.RESTMapper()),
+		},
+	), nil
+}
@@ -25,6 +25,8 @@ func constructors() []any {
 		ProvideArgs,
 		ProvideTreeCmd,
 		ProvideClusterTreeCmd,
+		ProvideDiffCmd,
+		ProvideDifferFactory,
 		ProvideUpdateCmd,
 		ProvideValidateCmd,
 		ProvideBuildCmd,
@@ -36,10 +38,19 @@ func constructors() []any {
 		ProvideUpdater,
 		ProvideBuilderFactory,
 		ProvideValidator,
+		ProvideTestCmd,
+		ProvideTester,
+		ProvideConfigCmd,
+		ProvideConfiguration,
 		ProvideRendererFactory,
 		ProvideRolloutCmd,
 		ProvideClientFactory,
 		ProvideRolloutHistoryCmd,
+		ProvideRolloutPauseCmd,
+		ProvideRolloutPromoteCmd,
+		ProvideRolloutResumeCmd,
+		ProvideRolloutStatusCmd,
+		ProvideRolloutUndoCmd,
 		ProvideRepoCmd,
 		ProvideKickstartCmd,
 		ProvideKickstarter,
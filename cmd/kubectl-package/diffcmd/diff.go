@@ -0,0 +1,139 @@
+package diffcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type DifferFactory interface {
+	Differ() (Differ, error)
+}
+
+type Differ interface {
+	DiffPackage(
+		ctx context.Context, src, name string, opts ...internalcmd.DiffPackageOption,
+	) (*internalcmd.PackageDiff, error)
+}
+
+func NewCmd(differFactory DifferFactory) *cobra.Command {
+	const (
+		cmdUse   = "diff source_path|image --name name [--namespace namespace]"
+		cmdShort = "shows what a new package version would change on the cluster"
+		cmdLong  = "renders a package source directory or image with the config and environment of the live " +
+			"(Cluster)Package and dry-runs server-side apply of every object, printing a unified diff " +
+			"per changed object and the objects that would be added, orphaned or deleted"
+	)
+
+	var opts options
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if opts.Name == "" {
+			return fmt.Errorf("%w: --name must be set", internalcmd.ErrInvalidArgs)
+		}
+
+		differ, err := differFactory.Differ()
+		if err != nil {
+			return err
+		}
+
+		diff, err := differ.DiffPackage(
+			cmd.Context(), args[0], opts.Name,
+			internalcmd.WithNamespace(opts.Namespace),
+			internalcmd.WithInsecure(opts.Insecure),
+			internalcmd.WithConfigSourceNamespace(opts.ConfigSourceNamespace),
+		)
+		if err != nil {
+			return fmt.Errorf("diffing package: %w", err)
+		}
+
+		return printDiff(cmd.OutOrStdout(), diff)
+	}
+
+	return cmd
+}
+
+func printDiff(out io.Writer, diff *internalcmd.PackageDiff) error {
+	if diff.Empty() {
+		_, err := fmt.Fprintln(out, "No changes.")
+		return err
+	}
+
+	for _, objDiff := range diff.Changed {
+		if _, err := fmt.Fprint(out, objDiff.Diff); err != nil {
+			return err
+		}
+	}
+
+	for _, section := range []struct {
+		title string
+		refs  []internalcmd.DiffObjectReference
+	}{
+		{title: "Added", refs: diff.Added},
+		{title: "Orphaned", refs: diff.Orphaned},
+		{title: "Deleted", refs: diff.Deleted},
+	} {
+		if len(section.refs) == 0 {
+			continue
+		}
+
+		if _, err := fmt.Fprintf(out, "%s:\n", section.title); err != nil {
+			return err
+		}
+		for _, ref := range section.refs {
+			if _, err := fmt.Fprintf(out, "  %s\n", ref); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type options struct {
+	Insecure              bool
+	Name                  string
+	Namespace             string
+	ConfigSourceNamespace string
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Insecure,
+		"insecure",
+		o.Insecure,
+		"Allows pulling images without TLS or using TLS with unverified certificates.",
+	)
+	flags.StringVar(
+		&o.Name,
+		"name",
+		o.Name,
+		"name of the live (Cluster)Package to diff against",
+	)
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"namespace of the live Package, diffs against a ClusterPackage if empty",
+	)
+	flags.StringVar(
+		&o.ConfigSourceNamespace,
+		"config-source-namespace",
+		"package-operator-system",
+		"namespace config sources of ClusterPackages are looked up in, the namespace Package Operator runs in",
+	)
+}
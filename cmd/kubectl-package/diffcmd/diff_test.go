@@ -0,0 +1,93 @@
+package diffcmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	cm := func(name string) internalcmd.DiffObjectReference {
+		return internalcmd.DiffObjectReference{
+			GroupKind: schema.GroupKind{Kind: "ConfigMap"},
+			ObjectKey: client.ObjectKey{Name: name, Namespace: "test"},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		Diff     *internalcmd.PackageDiff
+		Expected string
+	}{
+		"no changes": {
+			Diff:     &internalcmd.PackageDiff{},
+			Expected: "No changes.\n",
+		},
+		"changes": {
+			Diff: &internalcmd.PackageDiff{
+				Changed: []internalcmd.ObjectDiff{{Object: cm("cm"), Diff: "--- live\n+++ new\n"}},
+				Added:   []internalcmd.DiffObjectReference{cm("new")},
+				Deleted: []internalcmd.DiffObjectReference{cm("old")},
+			},
+			Expected: "--- live\n+++ new\n" +
+				"Added:\n  ConfigMap test/new\n" +
+				"Deleted:\n  ConfigMap test/old\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			differ := &differMock{}
+			differ.On("DiffPackage", mock.Anything, "quay.io/test/pkg:v2", "test", mock.Anything).
+				Return(tc.Diff, nil)
+
+			cmd := NewCmd(&differFactoryMock{differ: differ})
+			stdout := &bytes.Buffer{}
+			cmd.SetOut(stdout)
+			cmd.SetArgs([]string{"quay.io/test/pkg:v2", "--name", "test", "-n", "test"})
+
+			require.NoError(t, cmd.Execute())
+			assert.Equal(t, tc.Expected, stdout.String())
+		})
+	}
+}
+
+func TestDiff_missingName(t *testing.T) {
+	t.Parallel()
+
+	cmd := NewCmd(&differFactoryMock{differ: &differMock{}})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"quay.io/test/pkg:v2"})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrInvalidArgs)
+}
+
+type differFactoryMock struct {
+	differ Differ
+}
+
+func (m *differFactoryMock) Differ() (Differ, error) {
+	return m.differ, nil
+}
+
+type differMock struct {
+	mock.Mock
+}
+
+func (m *differMock) DiffPackage(
+	ctx context.Context, src, name string, opts ...internalcmd.DiffPackageOption,
+) (*internalcmd.PackageDiff, error) {
+	args := m.Called(ctx, src, name, opts)
+
+	return args.Get(0).(*internalcmd.PackageDiff), args.Error(1)
+}
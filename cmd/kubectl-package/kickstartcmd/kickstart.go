@@ -12,6 +12,7 @@ type Kickstarter interface {
 	Kickstart(
 		ctx context.Context, pkgName string,
 		inputs []string, olmBundle string,
+		helmChart, helmKubeVersion string, helmAPIVersions []string,
 		paramOpts []string,
 	) (msg string, err error)
 }
@@ -21,7 +22,8 @@ func NewCmd(kickstarter Kickstarter) *cobra.Command {
 		cmdUse   = "kickstart pkg_name (experimental)"
 		cmdShort = "Starts a new package with the given name."
 		cmdLong  = "Starts a new package, containing objects referenced via -f " +
-			"or from an OLM Bundle referenced via -b, " +
+			"from an OLM Bundle referenced via -b " +
+			"or from a Helm chart referenced via --helm-chart, " +
 			"with the given name in a new folder <pkg_name>."
 	)
 
@@ -37,7 +39,8 @@ func NewCmd(kickstarter Kickstarter) *cobra.Command {
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		msg, err := kickstarter.Kickstart(cmd.Context(), args[0],
-			opts.Inputs, opts.OLMBundle, opts.ParamOpts)
+			opts.Inputs, opts.OLMBundle, opts.HelmChart, opts.HelmKubeVersion, opts.HelmAPIVersions,
+			opts.ParamOpts)
 		if err != nil {
 			return fmt.Errorf("kickstarting package: %w", err)
 		}
@@ -53,7 +56,13 @@ type options struct {
 	Inputs []string
 	// OLM Bundle image reference.
 	OLMBundle string
-	ParamOpts []string
+	// Helm chart folder or archive.
+	HelmChart string
+	// Kubernetes version the Helm chart is rendered for.
+	HelmKubeVersion string
+	// Additional API versions available to the Helm chart.
+	HelmAPIVersions []string
+	ParamOpts       []string
 }
 
 func (o *options) AddFlags(flags *pflag.FlagSet) {
@@ -62,6 +71,12 @@ func (o *options) AddFlags(flags *pflag.FlagSet) {
 			`Supports glob and "-" to read from stdin. Can be supplied multiple times.`
 		olmBundleUse = "OLM Bundle OCI to import. e.g. quay.io/xx/xxx:tag. " +
 			"Overrides the output package name with the bundle's name."
+		helmChartUse = "Helm chart folder or .tgz archive to import. " +
+			"The chart is rendered with its default values, which become the package config."
+		helmKubeVersionUse = "Kubernetes version exposed to the Helm chart via .Capabilities.KubeVersion. " +
+			"Defaults to the version Helm was built against."
+		helmAPIVersionsUse = "Additional API versions exposed to the Helm chart via .Capabilities.APIVersions, " +
+			"e.g. monitoring.coreos.com/v1. Can be supplied multiple times."
 		parametrizeUse = "Parametrize flags: e.g. replicas."
 	)
 
@@ -86,4 +101,22 @@ func (o *options) AddFlags(flags *pflag.FlagSet) {
 		"",
 		olmBundleUse,
 	)
+	flags.StringVar(
+		&o.HelmChart,
+		"helm-chart",
+		"",
+		helmChartUse,
+	)
+	flags.StringVar(
+		&o.HelmKubeVersion,
+		"helm-kube-version",
+		"",
+		helmKubeVersionUse,
+	)
+	flags.StringSliceVar(
+		&o.HelmAPIVersions,
+		"helm-api-versions",
+		nil,
+		helmAPIVersionsUse,
+	)
 }
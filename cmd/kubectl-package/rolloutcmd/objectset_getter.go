@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	internalcmd "package-operator.run/internal/cmd"
 )
 
@@ -19,21 +21,41 @@ type objectSetGetter struct {
 	client *internalcmd.Client
 }
 
+// rolloutResource is implemented by all resources managing revisions of ObjectSets.
+type rolloutResource interface {
+	Generation() int64
+	Conditions() []metav1.Condition
+	CurrentRevision() int64
+	ObjectSets(context.Context) (internalcmd.ObjectSetList, error)
+	Rollback(context.Context, internalcmd.ObjectSet) error
+	SetPaused(ctx context.Context, paused bool) error
+	Promote(context.Context, internalcmd.ObjectSet) error
+}
+
 func (g *objectSetGetter) GetObjectSets(ctx context.Context, rsrc, name, ns string) (internalcmd.ObjectSetList, error) {
-	var err error
-	var getter interface {
-		ObjectSets(context.Context) (internalcmd.ObjectSetList, error)
+	getter, err := g.GetResource(ctx, rsrc, name, ns)
+	if err != nil {
+		return nil, err
 	}
 
+	return getter.ObjectSets(ctx)
+}
+
+func (g *objectSetGetter) GetResource(ctx context.Context, rsrc, name, ns string) (rolloutResource, error) {
+	var (
+		res rolloutResource
+		err error
+	)
+
 	switch strings.ToLower(rsrc) {
 	case "clusterpackage":
-		getter, err = g.client.GetPackage(ctx, name)
+		res, err = g.client.GetPackage(ctx, name)
 	case "package":
-		getter, err = g.client.GetPackage(ctx, name, internalcmd.WithNamespace(ns))
+		res, err = g.client.GetPackage(ctx, name, internalcmd.WithNamespace(ns))
 	case "clusterobjectdeployment":
-		getter, err = g.client.GetObjectDeployment(ctx, name)
+		res, err = g.client.GetObjectDeployment(ctx, name)
 	case "objectdeployment":
-		getter, err = g.client.GetObjectDeployment(ctx, name, internalcmd.WithNamespace(ns))
+		res, err = g.client.GetObjectDeployment(ctx, name, internalcmd.WithNamespace(ns))
 	default:
 		return nil, errInvalidResourceType
 	}
@@ -42,7 +64,7 @@ func (g *objectSetGetter) GetObjectSets(ctx context.Context, rsrc, name, ns stri
 		return nil, fmt.Errorf("getting resource %s/%s: %w", rsrc, name, err)
 	}
 
-	return getter.ObjectSets(ctx)
+	return res, nil
 }
 
 var errInvalidResourceType = errors.New("invalid resource type")
@@ -0,0 +1,93 @@
+package rolloutcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func NewPauseCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "pause"
+		cmdShort = "pause reconciliation of a package or object deployment"
+		cmdLong  = "pause reconciliation of a package or object deployment and all of its revisions, " +
+			"status is still reported while paused"
+	)
+
+	return newSetPausedCmd(clientFactory, cmdUse, cmdShort, cmdLong, true)
+}
+
+func NewResumeCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "resume"
+		cmdShort = "resume reconciliation of a paused package or object deployment"
+		cmdLong  = "resume reconciliation of a paused package or object deployment and all of its revisions"
+	)
+
+	return newSetPausedCmd(clientFactory, cmdUse, cmdShort, cmdLong, false)
+}
+
+func newSetPausedCmd(
+	clientFactory internalcmd.ClientFactory, use, short, long string, paused bool,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long:  long,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+
+	var opts pauseOptions
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, rawArgs []string) error {
+		args, err := getArgs(rawArgs)
+		if err != nil {
+			return err
+		}
+
+		client, err := clientFactory.Client()
+		if err != nil {
+			return err
+		}
+
+		getter := newObjectSetGetter(client)
+		res, err := getter.GetResource(cmd.Context(), args.Resource, args.Name, opts.Namespace)
+		if err != nil {
+			return err
+		}
+
+		if err := res.SetPaused(cmd.Context(), paused); err != nil {
+			return err
+		}
+
+		state := "resumed"
+		if paused {
+			state = "paused"
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s/%s %s\n", strings.ToLower(args.Resource), args.Name, state)
+
+		return err
+	}
+
+	return cmd
+}
+
+type pauseOptions struct {
+	Namespace string
+}
+
+func (o *pauseOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"If present, the namespace scope for this CLI request",
+	)
+}
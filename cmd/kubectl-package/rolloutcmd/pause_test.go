@@ -0,0 +1,76 @@
+package rolloutcmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestPauseResumeCmd(t *testing.T) {
+	t.Parallel()
+
+	pkg := &corev1alpha1.ClusterPackage{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+	}
+	c := newTestClient(t, pkg)
+	factory := internalcmd.NewDefaultClientFactory(&kubeClientFactoryMock{Client: c})
+
+	out, err := runCmd(NewPauseCmd(factory), "clusterpackage/test")
+	require.NoError(t, err)
+	assert.Equal(t, "clusterpackage/test paused\n", out)
+
+	actual := &corev1alpha1.ClusterPackage{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(pkg), actual))
+	assert.True(t, actual.Spec.Paused)
+
+	out, err = runCmd(NewResumeCmd(factory), "clusterpackage", "test")
+	require.NoError(t, err)
+	assert.Equal(t, "clusterpackage/test resumed\n", out)
+
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(pkg), actual))
+	assert.False(t, actual.Spec.Paused)
+}
+
+func TestPauseCmd_ObjectDeploymentManagedByPackage(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, &corev1alpha1.ObjectDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test", Namespace: "test",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: corev1alpha1.GroupVersion.String(),
+				Kind:       "Package",
+				Name:       "test",
+				UID:        "1234",
+				Controller: ptr.To(true),
+			}},
+		},
+	})
+
+	_, err := runCmd(NewPauseCmd(internalcmd.NewDefaultClientFactory(
+		&kubeClientFactoryMock{Client: c},
+	)), "objectdeployment/test", "-n", "test")
+	require.ErrorIs(t, err, internalcmd.ErrManagedByPackage)
+}
+
+func runCmd(cmd *cobra.Command, args ...string) (string, error) {
+	cmd.SetArgs(args)
+
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.Execute()
+
+	return out.String(), err
+}
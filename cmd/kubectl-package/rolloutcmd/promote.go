@@ -0,0 +1,84 @@
+package rolloutcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func NewPromoteCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "promote"
+		cmdShort = "promote the current revision of a package or object deployment"
+		cmdLong  = "promote the current revision of a package or object deployment with a manual promotion strategy, " +
+			"allowing it to replace all previous revisions"
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+
+	var opts promoteOptions
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, rawArgs []string) error {
+		args, err := getArgs(rawArgs)
+		if err != nil {
+			return err
+		}
+
+		client, err := clientFactory.Client()
+		if err != nil {
+			return err
+		}
+
+		getter := newObjectSetGetter(client)
+		res, err := getter.GetResource(cmd.Context(), args.Resource, args.Name, opts.Namespace)
+		if err != nil {
+			return err
+		}
+
+		list, err := res.ObjectSets(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		current, found := list.FindRevision(res.CurrentRevision())
+		if !found {
+			return errRevisionsNotFound
+		}
+
+		if err := res.Promote(cmd.Context(), current); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s/%s revision %d promoted\n",
+			strings.ToLower(args.Resource), args.Name, current.Revision())
+
+		return err
+	}
+
+	return cmd
+}
+
+type promoteOptions struct {
+	Namespace string
+}
+
+func (o *promoteOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"If present, the namespace scope for this CLI request",
+	)
+}
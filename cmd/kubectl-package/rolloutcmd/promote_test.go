@@ -0,0 +1,40 @@
+package rolloutcmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestPromoteCmd(t *testing.T) {
+	t.Parallel()
+
+	pkg := &corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Status:     corev1alpha1.PackageStatus{Revision: 2},
+	}
+	c := newTestClient(t, pkg,
+		newTestObjectSet("test-1", 1, nil),
+		newTestObjectSet("test-2", 2, nil),
+	)
+
+	out, err := runCmd(NewPromoteCmd(internalcmd.NewDefaultClientFactory(
+		&kubeClientFactoryMock{Client: c},
+	)), "package/test", "-n", "test")
+	require.NoError(t, err)
+	assert.Equal(t, "package/test revision 2 promoted\n", out)
+
+	for name, promoted := range map[string]bool{"test-1": false, "test-2": true} {
+		os := &corev1alpha1.ObjectSet{}
+		require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "test"}, os))
+		_, found := os.Annotations[corev1alpha1.ObjectSetPromotedAnnotation]
+		assert.Equal(t, promoted, found, name)
+	}
+}
@@ -14,8 +14,9 @@ type Params struct {
 func NewRolloutCmd(params Params) *cobra.Command {
 	const (
 		cmdUse   = "rollout"
-		cmdShort = "view package rollout status or history"
-		cmdLong  = "view package rollout status or history including detailed revision information"
+		cmdShort = "view or manage package rollouts"
+		cmdLong  = "view package rollout status or history including detailed revision information " +
+			"and roll back, pause or resume rollouts"
 	)
 
 	cmd := &cobra.Command{
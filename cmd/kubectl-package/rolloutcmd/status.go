@@ -0,0 +1,295 @@
+package rolloutcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+const statusPollInterval = 2 * time.Second
+
+func NewStatusCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "status"
+		cmdShort = "show the status of the rollout"
+		cmdLong  = "show the status of the latest rollout of a package or object deployment " +
+			"and by default watch it until the latest revision is available"
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+
+	var opts statusOptions
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, rawArgs []string) error {
+		args, err := getArgs(rawArgs)
+		if err != nil {
+			return err
+		}
+
+		client, err := clientFactory.Client()
+		if err != nil {
+			return err
+		}
+
+		getter := newObjectSetGetter(client)
+		resourceName := strings.ToLower(args.Resource) + "/" + args.Name
+
+		var lastStatus string
+		check := func(ctx context.Context) (bool, error) {
+			res, err := getter.GetResource(ctx, args.Resource, args.Name, opts.Namespace)
+			if err != nil {
+				return false, err
+			}
+
+			status, done, err := getRolloutStatus(ctx, res, resourceName)
+			if err != nil {
+				return false, err
+			}
+
+			// Only print changes to not flood the terminal while watching.
+			if status != lastStatus {
+				if _, err := fmt.Fprint(cmd.OutOrStdout(), status); err != nil {
+					return false, err
+				}
+				lastStatus = status
+			}
+
+			return done, nil
+		}
+
+		if !opts.Watch {
+			_, err := check(cmd.Context())
+
+			return err
+		}
+
+		ctx := cmd.Context()
+		if opts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			defer cancel()
+		}
+
+		err = wait.PollUntilContextCancel(ctx, statusPollInterval, true, check)
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%w: %s after %s", errRolloutTimeout, resourceName, opts.Timeout)
+		}
+
+		return err
+	}
+
+	return cmd
+}
+
+var (
+	errRolloutFailed  = errors.New("rollout failed")
+	errRolloutTimeout = errors.New("timed out waiting for rollout")
+)
+
+// Condition reasons reported by the package-operator controllers.
+const (
+	// Progressing reason of ObjectDeployments and Packages
+	// when the latest revision did not become available in time.
+	reasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+	// Progressing reason of ObjectDeployments and Packages
+	// when the latest revision waits for a manual promotion.
+	reasonAwaitingPromotion = "AwaitingPromotion"
+	// Available reason of Packages whose dependencies are pinned to conflicting images.
+	reasonDependencyConflict = "DependencyConflict"
+	// Available reason of ObjectSets with a failed hook.
+	reasonHookFailed = "HookFailed"
+)
+
+// getRolloutStatus reports the progress of the latest revision of the given resource
+// and whether it has been rolled out completely.
+func getRolloutStatus(ctx context.Context, res rolloutResource, resourceName string) (string, bool, error) {
+	conds := res.Conditions()
+	if invalid := meta.FindStatusCondition(conds, corev1alpha1.PackageInvalid); invalid != nil &&
+		invalid.Status == metav1.ConditionTrue {
+		return "", false, fmt.Errorf("%w: %s: %s", errRolloutFailed, resourceName, invalid.Message)
+	}
+
+	avail := meta.FindStatusCondition(conds, corev1alpha1.PackageAvailable)
+	if avail == nil || avail.ObservedGeneration != res.Generation() {
+		return fmt.Sprintf("Waiting for %s rollout to start...\n", resourceName), false, nil
+	}
+	if avail.Reason == reasonDependencyConflict {
+		return "", false, fmt.Errorf("%w: %s: %s", errRolloutFailed, resourceName, avail.Message)
+	}
+
+	progressing := meta.FindStatusCondition(conds, corev1alpha1.PackageProgressing)
+	if progressing != nil && progressing.Reason == reasonProgressDeadlineExceeded {
+		return "", false, fmt.Errorf("%w: %s: %s", errRolloutFailed, resourceName, progressing.Message)
+	}
+
+	list, err := res.ObjectSets(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	latest, found := list.FindRevision(res.CurrentRevision())
+	if !found {
+		msg := fmt.Sprintf("Waiting for %s revision %d to be created...\n", resourceName, res.CurrentRevision())
+
+		return msg, false, nil
+	}
+
+	latestAvail := latest.AvailableCondition()
+	if latestAvail != nil && latestAvail.Reason == reasonHookFailed {
+		return "", false, fmt.Errorf("%w: %s revision %d: %s",
+			errRolloutFailed, resourceName, latest.Revision(), latestAvail.Message)
+	}
+
+	if latestAvail != nil && latestAvail.Status == metav1.ConditionTrue &&
+		(progressing == nil || progressing.Status != metav1.ConditionTrue) {
+		return fmt.Sprintf("%s revision %d successfully rolled out\n", resourceName, latest.Revision()), true, nil
+	}
+
+	var b strings.Builder
+	if progressing != nil && progressing.Reason == reasonAwaitingPromotion {
+		fmt.Fprintf(&b, "Waiting for %s revision %d to be promoted...\n", resourceName, latest.Revision())
+
+		return b.String(), false, nil
+	}
+
+	fmt.Fprintf(&b, "Waiting for %s revision %d to become available...\n", resourceName, latest.Revision())
+	writePhaseProgress(&b, latest)
+
+	return b.String(), false, nil
+}
+
+// writePhaseProgress prints the state of each phase of the ObjectSet,
+// derived from the probe results of the objects in each phase.
+// Phases are reconciled in order, so all phases before the first
+// phase with unavailable objects have already been rolled out.
+func writePhaseProgress(b *strings.Builder, os internalcmd.ObjectSet) {
+	unavailable := map[objectKey]corev1alpha1.ObjectProbeStatus{}
+	for _, obj := range os.ProbedObjects() {
+		if obj.Status != metav1.ConditionTrue {
+			unavailable[objectKey{
+				GroupKind: schema.GroupKind{Group: obj.Group, Kind: obj.Kind},
+				ObjectKey: client.ObjectKey{Name: obj.Name, Namespace: obj.Namespace},
+			}] = obj
+		}
+	}
+
+	// Without unavailable objects in a phase, e.g. while a hook is running,
+	// the condition message explains what the ObjectSet is waiting for.
+	failedPhase := -1
+	phases := os.TemplateSpec().Phases
+	phaseUnavailable := make([][]corev1alpha1.ObjectProbeStatus, len(phases))
+	for i, phase := range phases {
+		for _, obj := range phase.Objects {
+			key := objectKey{
+				GroupKind: obj.Object.GroupVersionKind().GroupKind(),
+				ObjectKey: client.ObjectKey{Name: obj.Object.GetName(), Namespace: obj.Object.GetNamespace()},
+			}
+			if len(key.Namespace) == 0 {
+				key.Namespace = os.Namespace()
+			}
+			if probe, ok := unavailable[key]; ok {
+				phaseUnavailable[i] = append(phaseUnavailable[i], probe)
+				delete(unavailable, key)
+			}
+		}
+		if len(phaseUnavailable[i]) > 0 && failedPhase < 0 {
+			failedPhase = i
+		}
+	}
+	if cond := os.AvailableCondition(); failedPhase < 0 && cond != nil && len(cond.Message) > 0 {
+		fmt.Fprintf(b, "  %s\n", cond.Message)
+	}
+
+	for i, phase := range phases {
+		switch {
+		case failedPhase < 0:
+			fmt.Fprintf(b, "  phase %s: in progress\n", phase.Name)
+		case i < failedPhase:
+			fmt.Fprintf(b, "  phase %s: complete\n", phase.Name)
+		case i == failedPhase:
+			writeUnavailableObjects(b, "  phase "+phase.Name+": waiting for probes:", phaseUnavailable[i])
+		default:
+			fmt.Fprintf(b, "  phase %s: pending\n", phase.Name)
+		}
+	}
+
+	// Objects of phases that are stored in ObjectSlices can't be assigned to a phase.
+	if len(unavailable) > 0 {
+		rest := make([]corev1alpha1.ObjectProbeStatus, 0, len(unavailable))
+		for _, obj := range os.ProbedObjects() {
+			if obj.Status != metav1.ConditionTrue {
+				if _, ok := unavailable[objectKey{
+					GroupKind: schema.GroupKind{Group: obj.Group, Kind: obj.Kind},
+					ObjectKey: client.ObjectKey{Name: obj.Name, Namespace: obj.Namespace},
+				}]; ok {
+					rest = append(rest, obj)
+				}
+			}
+		}
+		writeUnavailableObjects(b, "  waiting for probes:", rest)
+	}
+}
+
+type objectKey struct {
+	schema.GroupKind
+	client.ObjectKey
+}
+
+func writeUnavailableObjects(b *strings.Builder, header string, objs []corev1alpha1.ObjectProbeStatus) {
+	fmt.Fprintln(b, header)
+	for _, obj := range objs {
+		fmt.Fprintf(b, "    %s %s: %s\n",
+			schema.GroupKind{Group: obj.Group, Kind: obj.Kind},
+			client.ObjectKey{Name: obj.Name, Namespace: obj.Namespace}, obj.Message)
+	}
+}
+
+type statusOptions struct {
+	Namespace string
+	Watch     bool
+	Timeout   time.Duration
+}
+
+func (o *statusOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"If present, the namespace scope for this CLI request",
+	)
+	flags.BoolVarP(
+		&o.Watch,
+		"watch",
+		"w",
+		true,
+		"Watch the status of the rollout until it's done",
+	)
+	flags.DurationVar(
+		&o.Timeout,
+		"timeout",
+		o.Timeout,
+		"The length of time to wait before ending watch, zero means never. "+
+			"Any other values should contain a corresponding time unit (e.g. 1s, 2m, 3h)",
+	)
+}
@@ -0,0 +1,215 @@
+package rolloutcmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestStatusCmd(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Args          []string
+		PkgConditions []metav1.Condition
+		OSConditions  []metav1.Condition
+		OSProbed      []corev1alpha1.ObjectProbeStatus
+		Output        string
+		ExpectedError error
+	}{
+		"available": {
+			Args: []string{"package/test", "-n", "test"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+			},
+			OSConditions: []metav1.Condition{
+				{Type: corev1alpha1.ObjectSetAvailable, Status: metav1.ConditionTrue},
+			},
+			Output: "package/test revision 2 successfully rolled out\n",
+		},
+		"not observed": {
+			Args: []string{"package/test", "-n", "test", "--watch=false"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionTrue},
+			},
+			Output: "Waiting for package/test rollout to start...\n",
+		},
+		"probe failure": {
+			Args: []string{"package/test", "-n", "test", "--watch=false"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionFalse, ObservedGeneration: 1},
+				{Type: corev1alpha1.PackageProgressing, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+			},
+			OSConditions: []metav1.Condition{{
+				Type:    corev1alpha1.ObjectSetAvailable,
+				Status:  metav1.ConditionFalse,
+				Reason:  "ProbeFailure",
+				Message: `Phase "deploy" failed: apps Deployment test/nginx: Available condition not True`,
+			}},
+			Output: strings.Join([]string{
+				"Waiting for package/test revision 2 to become available...",
+				`  Phase "deploy" failed: apps Deployment test/nginx: Available condition not True`,
+				"  phase crds: in progress",
+				"  phase deploy: in progress",
+				"  phase hooks: in progress",
+				"",
+			}, "\n"),
+		},
+		"probe failure with probed objects": {
+			Args: []string{"package/test", "-n", "test", "--watch=false"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionFalse, ObservedGeneration: 1},
+				{Type: corev1alpha1.PackageProgressing, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+			},
+			OSConditions: []metav1.Condition{{
+				Type:    corev1alpha1.ObjectSetAvailable,
+				Status:  metav1.ConditionFalse,
+				Reason:  "ProbeFailure",
+				Message: `Phase "deploy" failed: apps Deployment test/nginx: Available condition not True, ...`,
+			}},
+			OSProbed: []corev1alpha1.ObjectProbeStatus{
+				{Version: "v1", Kind: "ConfigMap", Name: "config", Namespace: "test", Status: metav1.ConditionTrue},
+				{
+					Group: "apps", Version: "v1", Kind: "Deployment", Name: "nginx", Namespace: "test",
+					Status: metav1.ConditionFalse, Message: "Available condition not True",
+				},
+				{
+					Group: "apps", Version: "v1", Kind: "Deployment", Name: "redis", Namespace: "test",
+					Status: metav1.ConditionFalse, Message: "not found",
+				},
+			},
+			Output: strings.Join([]string{
+				"Waiting for package/test revision 2 to become available...",
+				"  phase crds: complete",
+				"  phase deploy: waiting for probes:",
+				"    Deployment.apps test/nginx: Available condition not True",
+				"    Deployment.apps test/redis: not found",
+				"  phase hooks: pending",
+				"",
+			}, "\n"),
+		},
+		"awaiting promotion": {
+			Args: []string{"package/test", "-n", "test", "--watch=false"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+				{
+					Type: corev1alpha1.PackageProgressing, Status: metav1.ConditionTrue,
+					Reason: "AwaitingPromotion", ObservedGeneration: 1,
+				},
+			},
+			OSConditions: []metav1.Condition{
+				{Type: corev1alpha1.ObjectSetAvailable, Status: metav1.ConditionTrue},
+			},
+			Output: "Waiting for package/test revision 2 to be promoted...\n",
+		},
+		"progress deadline exceeded": {
+			Args: []string{"package/test", "-n", "test"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionFalse, ObservedGeneration: 1},
+				{
+					Type: corev1alpha1.PackageProgressing, Status: metav1.ConditionFalse,
+					Reason: "ProgressDeadlineExceeded", ObservedGeneration: 1,
+				},
+			},
+			ExpectedError: errRolloutFailed,
+		},
+		"hook failed": {
+			Args: []string{"package/test", "-n", "test"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionFalse, ObservedGeneration: 1},
+				{Type: corev1alpha1.PackageProgressing, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+			},
+			OSConditions: []metav1.Condition{
+				{Type: corev1alpha1.ObjectSetAvailable, Status: metav1.ConditionFalse, Reason: "HookFailed"},
+			},
+			ExpectedError: errRolloutFailed,
+		},
+		"invalid": {
+			Args: []string{"package/test", "-n", "test"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageInvalid, Status: metav1.ConditionTrue, Message: "bad manifest"},
+			},
+			ExpectedError: errRolloutFailed,
+		},
+		"timeout": {
+			Args: []string{"package/test", "-n", "test", "--timeout", "100ms"},
+			PkgConditions: []metav1.Condition{
+				{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionFalse, ObservedGeneration: 1},
+			},
+			ExpectedError: errRolloutTimeout,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pkg := &corev1alpha1.Package{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", Generation: 1},
+				Status: corev1alpha1.PackageStatus{
+					Revision:   2,
+					Conditions: tc.PkgConditions,
+				},
+			}
+			os := newTestObjectSet("test-2", 2, nil)
+			os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{
+				{Name: "crds", Objects: []corev1alpha1.ObjectSetObject{
+					newTestPhaseObject("v1", "ConfigMap", "config"),
+				}},
+				{Name: "deploy", Objects: []corev1alpha1.ObjectSetObject{
+					newTestPhaseObject("apps/v1", "Deployment", "nginx"),
+					newTestPhaseObject("apps/v1", "Deployment", "redis"),
+				}},
+				{Name: "hooks"},
+			}
+			os.Status.Conditions = tc.OSConditions
+			os.Status.ProbedObjects = tc.OSProbed
+			c := newTestClient(t, pkg, newTestObjectSet("test-1", 1, nil), os)
+
+			out, err := runStatusCmd(c, tc.Args...)
+			if tc.ExpectedError != nil {
+				require.ErrorIs(t, err, tc.ExpectedError)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.Output, out)
+		})
+	}
+}
+
+func runStatusCmd(c client.Client, args ...string) (string, error) {
+	return runCmd(NewStatusCmd(internalcmd.NewDefaultClientFactory(
+		&kubeClientFactoryMock{
+			Client: c,
+		},
+	)), args...)
+}
+
+func newTestPhaseObject(apiVersion, kind, name string) corev1alpha1.ObjectSetObject {
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+
+	return corev1alpha1.ObjectSetObject{Object: obj}
+}
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	scheme, err := internalcmd.NewScheme()
+	require.NoError(t, err)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		Build()
+}
@@ -0,0 +1,124 @@
+package rolloutcmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func NewUndoCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "undo"
+		cmdShort = "roll back to a previous rollout revision"
+		cmdLong  = "roll back a package or object deployment to a previous rollout revision, " +
+			"defaults to the revision before the current one"
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+
+	var opts undoOptions
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, rawArgs []string) error {
+		args, err := getArgs(rawArgs)
+		if err != nil {
+			return err
+		}
+
+		client, err := clientFactory.Client()
+		if err != nil {
+			return err
+		}
+
+		getter := newObjectSetGetter(client)
+		res, err := getter.GetResource(cmd.Context(), args.Resource, args.Name, opts.Namespace)
+		if err != nil {
+			return err
+		}
+
+		list, err := res.ObjectSets(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		target, err := findUndoTarget(list, res.CurrentRevision(), opts.ToRevision)
+		if err != nil {
+			return err
+		}
+
+		if err := res.Rollback(cmd.Context(), target); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s/%s rolled back to revision %d\n",
+			strings.ToLower(args.Resource), args.Name, target.Revision())
+
+		return err
+	}
+
+	return cmd
+}
+
+var (
+	errNoPreviousRevision = errors.New("no previous revision to roll back to")
+	errAlreadyAtRevision  = errors.New("already at the requested revision")
+)
+
+// findUndoTarget returns the requested revision or,
+// if toRevision is 0, the latest revision before the current one.
+func findUndoTarget(list internalcmd.ObjectSetList, current, toRevision int64) (internalcmd.ObjectSet, error) {
+	if toRevision > 0 {
+		if toRevision == current {
+			return internalcmd.ObjectSet{}, fmt.Errorf("%w: %d", errAlreadyAtRevision, toRevision)
+		}
+
+		os, found := list.FindRevision(toRevision)
+		if !found {
+			return internalcmd.ObjectSet{}, errRevisionsNotFound
+		}
+
+		return os, nil
+	}
+
+	list.Sort()
+
+	for i := len(list) - 1; i >= 0; i-- {
+		if list[i].Revision() < current {
+			return list[i], nil
+		}
+	}
+
+	return internalcmd.ObjectSet{}, errNoPreviousRevision
+}
+
+type undoOptions struct {
+	Namespace  string
+	ToRevision int64
+}
+
+func (o *undoOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"If present, the namespace scope for this CLI request",
+	)
+	flags.Int64Var(
+		&o.ToRevision,
+		"to-revision",
+		o.ToRevision,
+		"The revision to roll back to. Default to 0 (previous revision)",
+	)
+}
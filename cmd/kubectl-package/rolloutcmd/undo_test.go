@@ -0,0 +1,213 @@
+package rolloutcmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestUndoCmd_Package(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Args               []string
+		Output             string
+		ExpectedImage      string
+		ExpectedConfig     *runtime.RawExtension
+		ExpectedConfigFrom []corev1alpha1.PackageConfigSource
+		ExpectedComponent  string
+		ShouldFail         bool
+	}{
+		"previous revision": {
+			Args:           []string{"package/test", "-n", "test"},
+			Output:         "package/test rolled back to revision 2\n",
+			ExpectedImage:  "quay.io/test/pkg:v2",
+			ExpectedConfig: &runtime.RawExtension{Raw: []byte(`{"replicas":2}`)},
+			ExpectedConfigFrom: []corev1alpha1.PackageConfigSource{
+				{Kind: corev1alpha1.PackageConfigSourceKindConfigMap, Name: "config-v2"},
+			},
+			ExpectedComponent: "backend",
+		},
+		"to revision": {
+			Args:          []string{"package/test", "-n", "test", "--to-revision", "1"},
+			Output:        "package/test rolled back to revision 1\n",
+			ExpectedImage: "quay.io/test/pkg:v1",
+		},
+		"current revision": {
+			Args:       []string{"package/test", "-n", "test", "--to-revision", "3"},
+			ShouldFail: true,
+		},
+		"unknown revision": {
+			Args:       []string{"package/test", "-n", "test", "--to-revision", "5"},
+			ShouldFail: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pkg := &corev1alpha1.Package{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+				Spec: corev1alpha1.PackageSpec{
+					Image:  "quay.io/test/pkg:v3",
+					Config: &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)},
+					ConfigFrom: []corev1alpha1.PackageConfigSource{
+						{Kind: corev1alpha1.PackageConfigSourceKindConfigMap, Name: "config-v3"},
+					},
+					Component: "frontend",
+				},
+				Status: corev1alpha1.PackageStatus{Revision: 3},
+			}
+			c := newUndoTestClient(t, pkg,
+				newTestObjectSet("test-1", 1, map[string]string{
+					manifestsv1alpha1.PackageSourceImageAnnotation: "quay.io/test/pkg:v1",
+					manifestsv1alpha1.PackageConfigAnnotation:      "null",
+				}),
+				newTestObjectSet("test-2", 2, map[string]string{
+					manifestsv1alpha1.PackageSourceImageAnnotation: "quay.io/test/pkg:v2",
+					manifestsv1alpha1.PackageConfigAnnotation:      `{"replicas":2}`,
+					manifestsv1alpha1.PackageSpecAnnotation: `{"image":"quay.io/test/pkg:v2","config":{"replicas":2},` +
+						`"configFrom":[{"kind":"ConfigMap","name":"config-v2"}],"component":"backend"}`,
+				}),
+				newTestObjectSet("test-3", 3, map[string]string{
+					manifestsv1alpha1.PackageSourceImageAnnotation: "quay.io/test/pkg:v3",
+					manifestsv1alpha1.PackageConfigAnnotation:      `{"replicas":3}`,
+				}),
+			)
+
+			out, err := runUndoCmd(c, tc.Args...)
+			if tc.ShouldFail {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.Output, out)
+
+			actual := &corev1alpha1.Package{}
+			require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(pkg), actual))
+			assert.Equal(t, tc.ExpectedImage, actual.Spec.Image)
+			assert.Equal(t, tc.ExpectedConfig, actual.Spec.Config)
+			assert.Equal(t, tc.ExpectedConfigFrom, actual.Spec.ConfigFrom)
+			assert.Equal(t, tc.ExpectedComponent, actual.Spec.Component)
+		})
+	}
+}
+
+func TestUndoCmd_ObjectDeployment(t *testing.T) {
+	t.Parallel()
+
+	od := &corev1alpha1.ObjectDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test", Namespace: "test",
+			Labels: map[string]string{manifestsv1alpha1.PackageInstanceLabel: "test"},
+		},
+		Spec: corev1alpha1.ObjectDeploymentSpec{
+			Template: corev1alpha1.ObjectSetTemplate{
+				Spec: corev1alpha1.ObjectSetTemplateSpec{
+					Phases: []corev1alpha1.ObjectSetTemplatePhase{{Name: "v2"}},
+				},
+			},
+		},
+		Status: corev1alpha1.ObjectDeploymentStatus{Revision: 2},
+	}
+	rev1 := newTestObjectSet("test-1", 1, nil)
+	rev1.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{{Name: "v1"}}
+	c := newUndoTestClient(t, od, rev1, newTestObjectSet("test-2", 2, nil))
+
+	out, err := runUndoCmd(c, "objectdeployment", "test", "-n", "test")
+	require.NoError(t, err)
+	assert.Equal(t, "objectdeployment/test rolled back to revision 1\n", out)
+
+	actual := &corev1alpha1.ObjectDeployment{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(od), actual))
+	assert.Equal(t, rev1.Spec.ObjectSetTemplateSpec, actual.Spec.Template.Spec)
+}
+
+func TestUndoCmd_ObjectDeploymentManagedByPackage(t *testing.T) {
+	t.Parallel()
+
+	od := &corev1alpha1.ObjectDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test", Namespace: "test",
+			Labels: map[string]string{manifestsv1alpha1.PackageInstanceLabel: "test"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: corev1alpha1.GroupVersion.String(),
+				Kind:       "Package",
+				Name:       "test",
+				UID:        "1234",
+				Controller: ptr.To(true),
+			}},
+		},
+		Status: corev1alpha1.ObjectDeploymentStatus{Revision: 2},
+	}
+	c := newUndoTestClient(t, od,
+		newTestObjectSet("test-1", 1, nil),
+		newTestObjectSet("test-2", 2, nil),
+	)
+
+	_, err := runUndoCmd(c, "objectdeployment/test", "-n", "test")
+	require.ErrorIs(t, err, internalcmd.ErrManagedByPackage)
+}
+
+func TestUndoCmd_NoPreviousRevision(t *testing.T) {
+	t.Parallel()
+
+	c := newUndoTestClient(t, &corev1alpha1.ClusterPackage{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Status:     corev1alpha1.PackageStatus{Revision: 1},
+	}, &corev1alpha1.ClusterObjectSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "test-1",
+			Labels: map[string]string{manifestsv1alpha1.PackageInstanceLabel: "test"},
+		},
+		Status: corev1alpha1.ClusterObjectSetStatus{Revision: 1},
+	})
+
+	_, err := runUndoCmd(c, "clusterpackage/test")
+	require.ErrorIs(t, err, errNoPreviousRevision)
+}
+
+func newTestObjectSet(name string, revision int64, annotations map[string]string) *corev1alpha1.ObjectSet {
+	return &corev1alpha1.ObjectSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "test",
+			Annotations: annotations,
+			Labels: map[string]string{
+				manifestsv1alpha1.PackageInstanceLabel: "test",
+			},
+		},
+		Status: corev1alpha1.ObjectSetStatus{Revision: revision},
+	}
+}
+
+func newUndoTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	scheme, err := internalcmd.NewScheme()
+	require.NoError(t, err)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		Build()
+}
+
+func runUndoCmd(c client.Client, args ...string) (string, error) {
+	return runCmd(NewUndoCmd(internalcmd.NewDefaultClientFactory(
+		&kubeClientFactoryMock{
+			Client: c,
+		},
+	)), args...)
+}
@@ -0,0 +1,126 @@
+package testcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type Tester interface {
+	TestPackage(
+		ctx context.Context, path string, opts ...internalcmd.TestPackageOption,
+	) (*internalcmd.TestReport, error)
+}
+
+func NewCmd(tester Tester) *cobra.Command {
+	const (
+		testUse   = "test source_path"
+		testShort = "run the template tests of a package."
+		testLong  = "runs every template test case and kubeconform check of the package source " +
+			"and reports the result of each, instead of stopping at the first failure like validate does."
+	)
+
+	cmd := &cobra.Command{
+		Use:   testUse,
+		Short: testShort,
+		Long:  testLong,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		src := args[0]
+		if src == "" {
+			return fmt.Errorf("%w: 'source_path' must not be empty", internalcmd.ErrInvalidArgs)
+		}
+
+		report, err := tester.TestPackage(cmd.Context(), src,
+			internalcmd.WithUpdateFixtures(opts.UpdateFixtures))
+		if err != nil {
+			return fmt.Errorf("testing package: %w", err)
+		}
+
+		out := cmd.OutOrStdout()
+		if err := report.WriteText(out, !opts.NoColor && isTerminal(out)); err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+
+		if opts.JUnitPath != "" {
+			if err := writeJUnitReport(opts.JUnitPath, report); err != nil {
+				return err
+			}
+		}
+
+		if failed := report.Failed(); failed > 0 {
+			return fmt.Errorf("%w: %d of %d", internalcmd.ErrTestsFailed, failed, len(report.Results))
+		}
+		return nil
+	}
+
+	return cmd
+}
+
+func writeJUnitReport(path string, report *internalcmd.TestReport) (rErr error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating JUnit report: %w", err)
+	}
+	defer func() {
+		if cErr := f.Close(); rErr == nil && cErr != nil {
+			rErr = cErr
+		}
+	}()
+
+	if err := report.WriteJUnit(f); err != nil {
+		return fmt.Errorf("writing JUnit report: %w", err)
+	}
+	return nil
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+type options struct {
+	UpdateFixtures bool
+	JUnitPath      string
+	NoColor        bool
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.UpdateFixtures,
+		"update-fixtures",
+		o.UpdateFixtures,
+		"regenerate the .test-fixtures of all template test cases from the current templates",
+	)
+	flags.StringVar(
+		&o.JUnitPath,
+		"junit",
+		o.JUnitPath,
+		"write a JUnit XML report to the given file",
+	)
+	flags.BoolVar(
+		&o.NoColor,
+		"no-color",
+		o.NoColor,
+		"disable colored output",
+	)
+}
@@ -0,0 +1,103 @@
+package testcmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+const testManifest = `apiVersion: manifests.package-operator.run/v1alpha1
+kind: PackageManifest
+metadata:
+  name: test-stub
+spec:
+  scopes:
+  - Namespaced
+  phases:
+  - name: deploy
+test:
+  template:
+  - name: default
+    context:
+      package:
+        metadata:
+          name: test
+          namespace: test-ns
+`
+
+const testConfigMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{.package.metadata.name}}
+  annotations:
+    package-operator.run/phase: deploy
+data:
+  key: %s
+`
+
+func writeTestPackage(t *testing.T, dir, value string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.yaml"), []byte(testManifest), os.ModePerm))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "configmap.yaml.gotmpl"),
+		[]byte(fmt.Sprintf(testConfigMap, value)), os.ModePerm))
+}
+
+func runTestCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := NewCmd(internalcmd.NewTest())
+	stdout := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return stdout.String(), err
+}
+
+func TestTest(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	junitPath := filepath.Join(t.TempDir(), "junit.xml")
+	writeTestPackage(t, dir, "a")
+
+	// Missing fixtures are generated.
+	out, err := runTestCmd(t, dir, "--junit", junitPath)
+	require.NoError(t, err)
+	assert.Contains(t, out, "PASS template/default")
+	assert.FileExists(t, filepath.Join(dir, ".test-fixtures", "default", "configmap.yaml"))
+	junit, err := os.ReadFile(junitPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(junit), `<testsuite name="test-stub" tests="1" failures="0"`)
+
+	writeTestPackage(t, dir, "b")
+	out, err = runTestCmd(t, dir)
+	require.ErrorIs(t, err, internalcmd.ErrTestsFailed)
+	assert.Contains(t, out, "FAIL template/default")
+	assert.Contains(t, out, "-  key: a")
+	assert.Contains(t, out, "+  key: b")
+	assert.Contains(t, out, "0 passed, 1 failed")
+
+	out, err = runTestCmd(t, dir, "--update-fixtures")
+	require.NoError(t, err)
+	assert.Contains(t, out, "PASS template/default")
+
+	_, err = runTestCmd(t, dir)
+	require.NoError(t, err)
+}
+
+func TestTest_InvalidPath(t *testing.T) {
+	t.Parallel()
+
+	_, err := runTestCmd(t, "does-not-exist")
+	require.Error(t, err)
+}
@@ -39,9 +39,12 @@ func NewBootstrapper(
 	opts components.Options,
 ) (*Bootstrapper, error) {
 	c := uncachedClient
+	pullImage := func(ctx context.Context, image string) (*packages.RawPackage, error) {
+		return registry.Pull(ctx, image)
+	}
 	init := newInitializer(
 		c, scheme, &packageObjectLoad{},
-		registry.Pull, opts.Namespace, opts.SelfBootstrap, opts.SelfBootstrapConfig,
+		pullImage, opts.Namespace, opts.SelfBootstrap, opts.SelfBootstrapConfig,
 	)
 	fixer := newFixer(c, log, opts.Namespace)
 
@@ -7,6 +7,7 @@ import (
 	configv1 "github.com/openshift/api/config/v1"
 	"go.uber.org/dig"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -39,6 +40,7 @@ func NewComponents() (*dig.Container, error) {
 		ProvideMetricsRecorder, ProvideDynamicCache,
 		ProvideUncachedClient, ProvideOptions, ProvideLogger,
 		ProvideRegistry, ProvideDiscoveryClient, ProvideEnvironmentManager,
+		ProvideOwnerStrategy,
 
 		// -----------
 		// Controllers
@@ -114,6 +116,14 @@ func ProvideManager(
 						constants.DynamicCacheLabel: "True",
 					}),
 				},
+				// The ServiceAccount holding manager-wide image pull secrets
+				// is read on every Secret event and every Package reconcile.
+				// It lives next to Package Operator, so only cache that namespace.
+				&corev1.ServiceAccount{}: {
+					Namespaces: map[string]cache.Config{
+						opts.Namespace: {},
+					},
+				},
 			},
 		},
 	})
@@ -3,7 +3,10 @@ package components
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/ownerhandling"
 )
 
 func TestNewComponents(t *testing.T) {
@@ -49,3 +52,17 @@ func TestProvideManager(t *testing.T) {
 	_, err := ProvideManager(nil, nil, Options{})
 	require.EqualError(t, err, "must specify Config")
 }
+
+func TestProvideOwnerStrategy(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := ProvideScheme()
+	require.NoError(t, err)
+
+	s, err := ProvideOwnerStrategy(nil, scheme, ProvideLogger(), Options{OwnerStrategy: ownerStrategyNative})
+	require.NoError(t, err)
+	assert.IsType(t, &ownerhandling.OwnerStrategyNative{}, s)
+
+	_, err = ProvideOwnerStrategy(nil, scheme, ProvideLogger(), Options{OwnerStrategy: "banana"})
+	require.ErrorIs(t, err, errUnknownOwnerStrategy)
+}
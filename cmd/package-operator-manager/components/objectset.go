@@ -7,6 +7,7 @@ import (
 	"package-operator.run/internal/controllers/objectsets"
 	"package-operator.run/internal/dynamiccache"
 	"package-operator.run/internal/metrics"
+	"package-operator.run/internal/ownerhandling"
 )
 
 // Type alias for dependency injector to differentiate
@@ -21,13 +22,14 @@ func ProvideObjectSetController(
 	dc *dynamiccache.Cache,
 	uncachedClient UncachedClient,
 	recorder *metrics.Recorder,
+	ownerStrategy ownerhandling.OwnerStrategy,
 ) ObjectSetController {
 	return ObjectSetController{
 		objectsets.NewObjectSetController(
 			mgr.GetClient(),
 			log.WithName("controllers").WithName("ObjectSet"),
 			mgr.GetScheme(), dc, uncachedClient, recorder,
-			mgr.GetRESTMapper(),
+			mgr.GetRESTMapper(), ownerStrategy,
 		),
 	}
 }
@@ -37,13 +39,14 @@ func ProvideClusterObjectSetController(
 	dc *dynamiccache.Cache,
 	uncachedClient UncachedClient,
 	recorder *metrics.Recorder,
+	ownerStrategy ownerhandling.OwnerStrategy,
 ) ClusterObjectSetController {
 	return ClusterObjectSetController{
 		objectsets.NewClusterObjectSetController(
 			mgr.GetClient(),
 			log.WithName("controllers").WithName("ObjectSet"),
 			mgr.GetScheme(), dc, uncachedClient, recorder,
-			mgr.GetRESTMapper(),
+			mgr.GetRESTMapper(), ownerStrategy,
 		),
 	}
 }
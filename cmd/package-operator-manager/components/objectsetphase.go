@@ -6,6 +6,7 @@ import (
 
 	"package-operator.run/internal/controllers/objectsetphases"
 	"package-operator.run/internal/dynamiccache"
+	"package-operator.run/internal/ownerhandling"
 )
 
 // Type alias for dependency injector to differentiate
@@ -21,13 +22,14 @@ func ProvideObjectSetPhaseController(
 	mgr ctrl.Manager, log logr.Logger,
 	dc *dynamiccache.Cache,
 	uncachedClient UncachedClient,
+	ownerStrategy ownerhandling.OwnerStrategy,
 ) ObjectSetPhaseController {
 	return ObjectSetPhaseController{
 		objectsetphases.NewSameClusterObjectSetPhaseController(
 			log.WithName("controllers").WithName("ObjectSetPhase"),
 			mgr.GetScheme(), dc, uncachedClient,
 			defaultObjectSetPhaseClass, mgr.GetClient(),
-			mgr.GetRESTMapper(),
+			mgr.GetRESTMapper(), ownerStrategy,
 		),
 	}
 }
@@ -36,13 +38,14 @@ func ProvideClusterObjectSetPhaseController(
 	mgr ctrl.Manager, log logr.Logger,
 	dc *dynamiccache.Cache,
 	uncachedClient UncachedClient,
+	ownerStrategy ownerhandling.OwnerStrategy,
 ) ClusterObjectSetPhaseController {
 	return ClusterObjectSetPhaseController{
 		objectsetphases.NewSameClusterClusterObjectSetPhaseController(
 			log.WithName("controllers").WithName("ClusterObjectSetPhase"),
 			mgr.GetScheme(), dc, uncachedClient,
 			defaultObjectSetPhaseClass, mgr.GetClient(),
-			mgr.GetRESTMapper(),
+			mgr.GetRESTMapper(), ownerStrategy,
 		),
 	}
 }
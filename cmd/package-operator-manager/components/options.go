@@ -10,6 +10,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Flags.
@@ -31,6 +32,20 @@ const (
 	packageOperatorPackageImage = "Image pointing to a package operator package. " +
 		"This image is currently used with the HyperShift integration to spin up the remote-phase-manager " +
 		"and hosted-cluster-manager for every HostedCluster"
+	imagePullSecretFlagDescription = "Name of a Secret in the Package Operator namespace holding registry credentials " +
+		"used for all package image pulls."
+	imagePullServiceAccountFlagDescription = "Name of a ServiceAccount in the Package Operator namespace, " +
+		"whose imagePullSecrets are used for all package image pulls."
+	configSourceNamespaceFlagDescription = "Namespace Secrets and ConfigMaps referenced in .spec.configFrom " +
+		"of ClusterPackages are looked up in. Defaults to the Package Operator namespace."
+	packageCacheDirFlagDescription = "Directory to cache pulled package images in. " +
+		"Caching is disabled when empty."
+	packageCacheMaxSizeFlagDescription = "Maximum size of the package image cache, e.g. 512Mi. " +
+		"Least recently used images are evicted first."
+	imageVerificationPolicyFlagDescription = "Path to a YAML file with the signature verification policy " +
+		"package images are checked against before unpacking. Verification is disabled when empty."
+	ownerStrategyFlagDescription = "Strategy recording ownership of objects managed by ObjectSets and ObjectSetPhases, " +
+		"one of: native (ownerReferences), ownership (ObjectOwnership objects, leaving owner metadata off the objects)."
 	packageHashModifier             = "An additional value used for the generation of a package's unpackedHash."
 	subCmpntAffinityFlagDescription = "Pod affinity settings used in PKO deployed subcomponents, " +
 		"like remote-phase-manager."
@@ -49,6 +64,13 @@ type Options struct {
 	EnableLeaderElection        bool
 	ProbeAddr                   string
 	RegistryHostOverrides       string
+	ImagePullSecret             string
+	ImagePullServiceAccount     string
+	ConfigSourceNamespace       string
+	PackageCacheDir             string
+	PackageCacheMaxSize         resource.Quantity
+	ImageVerificationPolicy     string
+	OwnerStrategy               string
 	PackageHashModifier         *int32
 	PackageOperatorPackageImage string
 
@@ -106,6 +128,30 @@ func ProvideOptions() (opts Options, err error) {
 		&opts.RegistryHostOverrides, "registry-host-overrides",
 		os.Getenv("PKO_REGISTRY_HOST_OVERRIDES"),
 		registryHostOverrides)
+	flag.StringVar(
+		&opts.ImagePullSecret, "image-pull-secret",
+		os.Getenv("PKO_IMAGE_PULL_SECRET"),
+		imagePullSecretFlagDescription)
+	flag.StringVar(
+		&opts.ImagePullServiceAccount, "image-pull-service-account",
+		os.Getenv("PKO_IMAGE_PULL_SERVICE_ACCOUNT"),
+		imagePullServiceAccountFlagDescription)
+	flag.StringVar(
+		&opts.ConfigSourceNamespace, "config-source-namespace",
+		os.Getenv("PKO_CONFIG_SOURCE_NAMESPACE"),
+		configSourceNamespaceFlagDescription)
+	flag.StringVar(
+		&opts.PackageCacheDir, "package-cache-dir",
+		os.Getenv("PKO_PACKAGE_CACHE_DIR"),
+		packageCacheDirFlagDescription)
+	flag.StringVar(
+		&opts.ImageVerificationPolicy, "image-verification-policy",
+		os.Getenv("PKO_IMAGE_VERIFICATION_POLICY"),
+		imageVerificationPolicyFlagDescription)
+	flag.StringVar(
+		&opts.OwnerStrategy, "owner-strategy",
+		envOrDefault("PKO_OWNER_STRATEGY", ownerStrategyNative),
+		ownerStrategyFlagDescription)
 
 	flag.DurationVar(
 		&opts.ObjectTemplateResourceRetryInterval,
@@ -119,6 +165,12 @@ func ProvideOptions() (opts Options, err error) {
 	var (
 		subComponentAffinityJSON    string
 		subComponentTolerationsJSON string
+		packageCacheMaxSize         string
+	)
+	flag.StringVar(
+		&packageCacheMaxSize, "package-cache-max-size",
+		envOrDefault("PKO_PACKAGE_CACHE_MAX_SIZE", "1Gi"),
+		packageCacheMaxSizeFlagDescription,
 	)
 	flag.StringVar(
 		&subComponentAffinityJSON, "sub-component-affinity",
@@ -151,6 +203,11 @@ func ProvideOptions() (opts Options, err error) {
 		packageHashModifier)
 	flag.Parse()
 
+	opts.PackageCacheMaxSize, err = resource.ParseQuantity(packageCacheMaxSize)
+	if err != nil {
+		return Options{}, fmt.Errorf("parsing package cache max size: %w", err)
+	}
+
 	if *tmpPackageHashModifier != 0 {
 		packageHashModifierInt32 := int32(*tmpPackageHashModifier)
 		opts.PackageHashModifier = &packageHashModifierInt32
@@ -163,6 +220,14 @@ func ProvideOptions() (opts Options, err error) {
 	return opts, nil
 }
 
+// Returns the value of an environment variable or the given default if it is unset.
+func envOrDefault(env, def string) string {
+	if v, ok := os.LookupEnv(env); ok {
+		return v
+	}
+	return def
+}
+
 // Parses an environment variable string value to integer value.
 // Returns 0 in case the environment variable is unset.
 func envToInt(env string) (int, error) {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 //nolint:paralleltest
@@ -32,6 +33,8 @@ func TestProvideOptions(t *testing.T) {
 		EnableLeaderElection: true,
 		MetricsAddr:          ":8080",
 		ProbeAddr:            ":8081",
+		PackageCacheMaxSize:  resource.MustParse("1Gi"),
+		OwnerStrategy:        ownerStrategyNative,
 		SubComponentTolerations: []corev1.Toleration{
 			{
 				Key:    "node-role.kubernetes.io/infra",
@@ -0,0 +1,56 @@
+package components
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/ownerhandling"
+)
+
+// Values of the --owner-strategy flag.
+const (
+	ownerStrategyNative    = "native"
+	ownerStrategyOwnership = "ownership"
+)
+
+var errUnknownOwnerStrategy = errors.New("unknown owner strategy")
+
+// Returns the strategy recording ownership of objects managed by ObjectSets and ObjectSetPhases.
+func ProvideOwnerStrategy(
+	mgr ctrl.Manager, scheme *runtime.Scheme,
+	log logr.Logger, opts Options,
+) (ownerhandling.OwnerStrategy, error) {
+	switch opts.OwnerStrategy {
+	case ownerStrategyNative:
+		return ownerhandling.NewNative(scheme), nil
+
+	case ownerStrategyOwnership:
+		// Registers the informer with the manager cache,
+		// so controllers are only started after it has synced.
+		informer, err := mgr.GetCache().GetInformer(context.Background(), &corev1alpha1.ObjectOwnership{})
+		if err != nil {
+			return nil, fmt.Errorf("getting ObjectOwnership informer: %w", err)
+		}
+		strategy, err := ownerhandling.NewOwnership(
+			log.WithName("ownerhandling").WithName("ObjectOwnership"),
+			scheme, mgr.GetClient(), mgr.GetAPIReader(), informer)
+		if err != nil {
+			return nil, err
+		}
+		// Removes owners that no longer exist from ObjectOwnerships.
+		if err := mgr.Add(strategy); err != nil {
+			return nil, fmt.Errorf("adding ObjectOwnership garbage collection: %w", err)
+		}
+		return strategy, nil
+
+	default:
+		return nil, fmt.Errorf("%w %q, must be one of: %s, %s",
+			errUnknownOwnerStrategy, opts.OwnerStrategy, ownerStrategyNative, ownerStrategyOwnership)
+	}
+}
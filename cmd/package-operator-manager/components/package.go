@@ -1,6 +1,7 @@
 package components
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -22,9 +23,34 @@ type (
 	}
 )
 
-func ProvideRegistry(log logr.Logger, opts Options) *packages.Registry {
+func ProvideRegistry(log logr.Logger, opts Options) (*packages.Registry, error) {
+	var registryOpts []packages.RegistryOption
+	if len(opts.PackageCacheDir) > 0 {
+		cache, err := packages.NewCache(opts.PackageCacheDir, opts.PackageCacheMaxSize.Value())
+		if err != nil {
+			return nil, fmt.Errorf("setting up package cache: %w", err)
+		}
+		log.WithName("Registry").Info("package cache active",
+			"dir", opts.PackageCacheDir, "maxSize", opts.PackageCacheMaxSize.String())
+		registryOpts = append(registryOpts, packages.WithCache{Cache: cache})
+	}
+	if len(opts.ImageVerificationPolicy) > 0 {
+		policy, err := packages.LoadVerificationPolicy(opts.ImageVerificationPolicy)
+		if err != nil {
+			return nil, err
+		}
+		verifier, err := packages.NewVerifier(*policy)
+		if err != nil {
+			return nil, fmt.Errorf("setting up image verification: %w", err)
+		}
+		log.WithName("Registry").Info("image verification active", "policy", opts.ImageVerificationPolicy)
+		registryOpts = append(registryOpts, packages.WithVerifier{Verifier: verifier})
+	}
+
 	return packages.NewRegistry(
-		prepareRegistryHostOverrides(log, opts.RegistryHostOverrides))
+		prepareRegistryHostOverrides(log, opts.RegistryHostOverrides),
+		registryOpts...,
+	), nil
 }
 
 func prepareRegistryHostOverrides(log logr.Logger, flag string) map[string]string {
@@ -45,6 +71,22 @@ func prepareRegistryHostOverrides(log logr.Logger, flag string) map[string]strin
 	return out
 }
 
+func imagePullSecretConfig(opts Options) controllerspackages.ImagePullSecretConfig {
+	return controllerspackages.ImagePullSecretConfig{
+		Namespace:      opts.Namespace,
+		DefaultSecret:  opts.ImagePullSecret,
+		ServiceAccount: opts.ImagePullServiceAccount,
+	}
+}
+
+func configSourceConfig(opts Options) controllerspackages.ConfigSourceConfig {
+	namespace := opts.ConfigSourceNamespace
+	if len(namespace) == 0 {
+		namespace = opts.Namespace
+	}
+	return controllerspackages.ConfigSourceConfig{Namespace: namespace}
+}
+
 func ProvidePackageController(
 	mgr ctrl.Manager, log logr.Logger, uncachedClient UncachedClient,
 	registry *packages.Registry,
@@ -58,6 +100,7 @@ func ProvidePackageController(
 			log.WithName("controllers").WithName("Package"),
 			mgr.GetScheme(),
 			registry, recorder, opts.PackageHashModifier,
+			imagePullSecretConfig(opts), configSourceConfig(opts),
 		),
 	}
 }
@@ -75,6 +118,7 @@ func ProvideClusterPackageController(
 			log.WithName("controllers").WithName("ClusterPackage"),
 			mgr.GetScheme(),
 			registry, recorder, opts.PackageHashModifier,
+			imagePullSecretConfig(opts), configSourceConfig(opts),
 		),
 	}
 }
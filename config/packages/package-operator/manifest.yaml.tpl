@@ -36,6 +36,24 @@ spec:
           format: int32
         registryHostOverrides:
           type: string
+        imagePullSecret:
+          description: Name of a Secret in the Package Operator namespace
+            holding registry credentials used for all package image pulls.
+          type: string
+        imagePullServiceAccount:
+          description: Name of a ServiceAccount in the Package Operator namespace,
+            whose imagePullSecrets are used for all package image pulls.
+          type: string
+        configSourceNamespace:
+          description: Namespace Secrets and ConfigMaps referenced in .spec.configFrom
+            of ClusterPackages are looked up in. Defaults to the Package Operator namespace.
+          type: string
+        packageCacheMaxSize:
+          description: Enables caching of pulled package images in an emptyDir volume.
+            Maximum size of the cache as integer quantity, e.g. 512Mi.
+            The volume is sized with additional headroom.
+          type: string
+          pattern: ^[0-9]+(Ki|Mi|Gi|Ti)?$
         objectTemplateResourceRetryInterval:
           type: string
         objectTemplateOptionalResourceRetryInterval:
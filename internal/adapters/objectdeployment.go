@@ -27,6 +27,10 @@ type ObjectDeploymentAccessor interface {
 	SetSelector(labels map[string]string)
 	SetStatusRevision(r int64)
 	GetStatusRevision() int64
+	SetPaused(paused bool)
+	IsPaused() bool
+	SetStrategy(strategy *corev1alpha1.ObjectDeploymentStrategy)
+	GetStrategy() *corev1alpha1.ObjectDeploymentStrategy
 }
 
 type ObjectDeploymentFactory func(
@@ -139,6 +143,22 @@ func (a *ObjectDeployment) GetStatusRevision() int64 {
 	return a.Status.Revision
 }
 
+func (a *ObjectDeployment) SetPaused(paused bool) {
+	a.Spec.Paused = paused
+}
+
+func (a *ObjectDeployment) IsPaused() bool {
+	return a.Spec.Paused
+}
+
+func (a *ObjectDeployment) SetStrategy(strategy *corev1alpha1.ObjectDeploymentStrategy) {
+	a.Spec.Strategy = strategy
+}
+
+func (a *ObjectDeployment) GetStrategy() *corev1alpha1.ObjectDeploymentStrategy {
+	return a.Spec.Strategy
+}
+
 type ClusterObjectDeployment struct {
 	corev1alpha1.ClusterObjectDeployment
 }
@@ -214,6 +234,22 @@ func (a *ClusterObjectDeployment) GetStatusRevision() int64 {
 	return a.Status.Revision
 }
 
+func (a *ClusterObjectDeployment) SetPaused(paused bool) {
+	a.Spec.Paused = paused
+}
+
+func (a *ClusterObjectDeployment) IsPaused() bool {
+	return a.Spec.Paused
+}
+
+func (a *ClusterObjectDeployment) SetStrategy(strategy *corev1alpha1.ObjectDeploymentStrategy) {
+	a.Spec.Strategy = strategy
+}
+
+func (a *ClusterObjectDeployment) GetStrategy() *corev1alpha1.ObjectDeploymentStrategy {
+	return a.Spec.Strategy
+}
+
 func objectDeploymentPhase(conditions []metav1.Condition) corev1alpha1.ObjectDeploymentPhase {
 	availableCond := meta.FindStatusCondition(conditions, corev1alpha1.ObjectDeploymentAvailable)
 
@@ -52,6 +52,10 @@ func TestObjectDeployment(t *testing.T) {
 	var statusRevision int64 = 2
 	deploy.SetStatusRevision(statusRevision)
 	assert.Equal(t, statusRevision, deploy.GetStatusRevision())
+
+	assert.False(t, deploy.IsPaused())
+	deploy.SetPaused(true)
+	assert.True(t, deploy.IsPaused())
 }
 
 func TestClusterObjectDeployment(t *testing.T) {
@@ -96,6 +100,10 @@ func TestClusterObjectDeployment(t *testing.T) {
 	var statusRevision int64 = 2
 	deploy.SetStatusRevision(statusRevision)
 	assert.Equal(t, statusRevision, deploy.GetStatusRevision())
+
+	assert.False(t, deploy.IsPaused())
+	deploy.SetPaused(true)
+	assert.True(t, deploy.IsPaused())
 }
 
 func Test_objectDeploymentPhase(t *testing.T) {
@@ -24,6 +24,12 @@ type GenericPackageAccessor interface {
 	SetStatusRevision(rev int64)
 	GetStatusRevision() int64
 	GetComponent() string
+	GetImagePullSecrets() []corev1alpha1.ImagePullSecretReference
+	GetConfigFrom() []corev1alpha1.PackageConfigSource
+	IsPaused() bool
+	GetStrategy() *corev1alpha1.ObjectDeploymentStrategy
+	GetDeletionPolicy() corev1alpha1.DeletionPolicy
+	GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy
 }
 
 type GenericPackageFactory func(scheme *runtime.Scheme) GenericPackageAccessor
@@ -72,6 +78,14 @@ func (a *GenericPackage) GetComponent() string {
 	return a.Spec.Component
 }
 
+func (a *GenericPackage) GetImagePullSecrets() []corev1alpha1.ImagePullSecretReference {
+	return a.Spec.ImagePullSecrets
+}
+
+func (a *GenericPackage) GetConfigFrom() []corev1alpha1.PackageConfigSource {
+	return a.Spec.ConfigFrom
+}
+
 func (a *GenericPackage) GetConditions() *[]metav1.Condition {
 	return &a.Status.Conditions
 }
@@ -85,7 +99,26 @@ func (a *GenericPackage) GetImage() string {
 }
 
 func (a *GenericPackage) GetSpecHash(packageHashModifier *int32) string {
-	return utils.ComputeSHA256Hash(a.Spec, packageHashModifier)
+	// Pausing does not change the package contents and must not trigger a new unpack.
+	spec := a.Spec
+	spec.Paused = false
+	return utils.ComputeSHA256Hash(spec, packageHashModifier)
+}
+
+func (a *GenericPackage) IsPaused() bool {
+	return a.Spec.Paused
+}
+
+func (a *GenericPackage) GetStrategy() *corev1alpha1.ObjectDeploymentStrategy {
+	return a.Spec.Strategy
+}
+
+func (a *GenericPackage) GetDeletionPolicy() corev1alpha1.DeletionPolicy {
+	return a.Spec.DeletionPolicy
+}
+
+func (a *GenericPackage) GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy {
+	return a.Spec.Adoption
 }
 
 func (a *GenericPackage) SetUnpackedHash(hash string) {
@@ -130,6 +163,14 @@ func (a *GenericClusterPackage) GetComponent() string {
 	return a.Spec.Component
 }
 
+func (a *GenericClusterPackage) GetImagePullSecrets() []corev1alpha1.ImagePullSecretReference {
+	return a.Spec.ImagePullSecrets
+}
+
+func (a *GenericClusterPackage) GetConfigFrom() []corev1alpha1.PackageConfigSource {
+	return a.Spec.ConfigFrom
+}
+
 func (a *GenericClusterPackage) GetConditions() *[]metav1.Condition {
 	return &a.Status.Conditions
 }
@@ -143,7 +184,26 @@ func (a *GenericClusterPackage) GetImage() string {
 }
 
 func (a *GenericClusterPackage) GetSpecHash(packageHashModifier *int32) string {
-	return utils.ComputeSHA256Hash(a.Spec, packageHashModifier)
+	// Pausing does not change the package contents and must not trigger a new unpack.
+	spec := a.Spec
+	spec.Paused = false
+	return utils.ComputeSHA256Hash(spec, packageHashModifier)
+}
+
+func (a *GenericClusterPackage) IsPaused() bool {
+	return a.Spec.Paused
+}
+
+func (a *GenericClusterPackage) GetStrategy() *corev1alpha1.ObjectDeploymentStrategy {
+	return a.Spec.Strategy
+}
+
+func (a *GenericClusterPackage) GetDeletionPolicy() corev1alpha1.DeletionPolicy {
+	return a.Spec.DeletionPolicy
+}
+
+func (a *GenericClusterPackage) GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy {
+	return a.Spec.Adoption
 }
 
 func (a *GenericClusterPackage) SetStatusRevision(rev int64) {
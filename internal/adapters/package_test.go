@@ -35,6 +35,22 @@ func TestGenericPackage(t *testing.T) {
 	p.Spec.Component = "test_component"
 	assert.Equal(t, p.Spec.Component, pkg.GetComponent())
 
+	assert.Empty(t, pkg.GetImagePullSecrets())
+	p.Spec.ImagePullSecrets = []corev1alpha1.ImagePullSecretReference{{Name: "test"}}
+	assert.Equal(t, p.Spec.ImagePullSecrets, pkg.GetImagePullSecrets())
+
+	assert.Empty(t, pkg.GetConfigFrom())
+	p.Spec.ConfigFrom = []corev1alpha1.PackageConfigSource{
+		{Kind: corev1alpha1.PackageConfigSourceKindSecret, Name: "test"},
+	}
+	assert.Equal(t, p.Spec.ConfigFrom, pkg.GetConfigFrom())
+
+	specHash := pkg.GetSpecHash(nil)
+	assert.False(t, pkg.IsPaused())
+	p.Spec.Paused = true
+	assert.True(t, pkg.IsPaused())
+	assert.Equal(t, specHash, pkg.GetSpecHash(nil))
+
 	assert.Empty(t, pkg.GetConditions())
 	p.Status.Conditions = []metav1.Condition{
 		{
@@ -79,6 +95,22 @@ func TestGenericClusterPackage(t *testing.T) {
 	p.Spec.Component = "test_component"
 	assert.Equal(t, p.Spec.Component, pkg.GetComponent())
 
+	assert.Empty(t, pkg.GetImagePullSecrets())
+	p.Spec.ImagePullSecrets = []corev1alpha1.ImagePullSecretReference{{Name: "test"}}
+	assert.Equal(t, p.Spec.ImagePullSecrets, pkg.GetImagePullSecrets())
+
+	assert.Empty(t, pkg.GetConfigFrom())
+	p.Spec.ConfigFrom = []corev1alpha1.PackageConfigSource{
+		{Kind: corev1alpha1.PackageConfigSourceKindSecret, Name: "test"},
+	}
+	assert.Equal(t, p.Spec.ConfigFrom, pkg.GetConfigFrom())
+
+	specHash := pkg.GetSpecHash(nil)
+	assert.False(t, pkg.IsPaused())
+	p.Spec.Paused = true
+	assert.True(t, pkg.IsPaused())
+	assert.Equal(t, specHash, pkg.GetSpecHash(nil))
+
 	assert.Empty(t, pkg.GetConditions())
 	p.Status.Conditions = []metav1.Condition{
 		{
@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+type GenericPackageListAccessor interface {
+	ClientObjectList() client.ObjectList
+	GetItems() []GenericPackageAccessor
+}
+
+type GenericPackageListFactory func(
+	scheme *runtime.Scheme) GenericPackageListAccessor
+
+var (
+	packageListGVK        = corev1alpha1.GroupVersion.WithKind("PackageList")
+	clusterPackageListGVK = corev1alpha1.GroupVersion.WithKind("ClusterPackageList")
+)
+
+func NewGenericPackageList(scheme *runtime.Scheme) GenericPackageListAccessor {
+	obj, err := scheme.New(packageListGVK)
+	if err != nil {
+		panic(err)
+	}
+
+	return &GenericPackageList{
+		PackageList: *obj.(*corev1alpha1.PackageList),
+	}
+}
+
+func NewGenericClusterPackageList(scheme *runtime.Scheme) GenericPackageListAccessor {
+	obj, err := scheme.New(clusterPackageListGVK)
+	if err != nil {
+		panic(err)
+	}
+
+	return &GenericClusterPackageList{
+		ClusterPackageList: *obj.(*corev1alpha1.ClusterPackageList),
+	}
+}
+
+var (
+	_ GenericPackageListAccessor = (*GenericPackageList)(nil)
+	_ GenericPackageListAccessor = (*GenericClusterPackageList)(nil)
+)
+
+type GenericPackageList struct {
+	corev1alpha1.PackageList
+}
+
+func (a *GenericPackageList) ClientObjectList() client.ObjectList {
+	return &a.PackageList
+}
+
+func (a *GenericPackageList) GetItems() []GenericPackageAccessor {
+	out := make([]GenericPackageAccessor, len(a.Items))
+	for i := range a.Items {
+		out[i] = &GenericPackage{
+			Package: a.Items[i],
+		}
+	}
+	return out
+}
+
+type GenericClusterPackageList struct {
+	corev1alpha1.ClusterPackageList
+}
+
+func (a *GenericClusterPackageList) ClientObjectList() client.ObjectList {
+	return &a.ClusterPackageList
+}
+
+func (a *GenericClusterPackageList) GetItems() []GenericPackageAccessor {
+	out := make([]GenericPackageAccessor, len(a.Items))
+	for i := range a.Items {
+		out[i] = &GenericClusterPackage{
+			ClusterPackage: a.Items[i],
+		}
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestGenericPackageList(t *testing.T) {
+	t.Parallel()
+
+	pkgList := NewGenericPackageList(testScheme).(*GenericPackageList)
+	assert.IsType(t, &corev1alpha1.PackageList{}, pkgList.ClientObjectList())
+
+	pkgList.Items = []corev1alpha1.Package{
+		{
+			ObjectMeta: metav1.ObjectMeta{},
+		},
+	}
+	items := pkgList.GetItems()
+	if assert.Len(t, items, 1) {
+		assert.IsType(t, &GenericPackage{}, items[0])
+	}
+}
+
+func TestGenericClusterPackageList(t *testing.T) {
+	t.Parallel()
+
+	pkgList := NewGenericClusterPackageList(testScheme).(*GenericClusterPackageList)
+	assert.IsType(t, &corev1alpha1.ClusterPackageList{}, pkgList.ClientObjectList())
+
+	pkgList.Items = []corev1alpha1.ClusterPackage{
+		{
+			ObjectMeta: metav1.ObjectMeta{},
+		},
+	}
+	items := pkgList.GetItems()
+	if assert.Len(t, items, 1) {
+		assert.IsType(t, &GenericClusterPackage{}, items[0])
+	}
+}
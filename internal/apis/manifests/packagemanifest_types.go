@@ -10,17 +10,24 @@ import (
 )
 
 const (
-	PackagePhaseAnnotation          = manifestsv1alpha1.PackagePhaseAnnotation
-	PackageConditionMapAnnotation   = manifestsv1alpha1.PackageConditionMapAnnotation
-	PackageExternalObjectAnnotation = manifestsv1alpha1.PackageExternalObjectAnnotation
-	PackageCELConditionAnnotation   = manifestsv1alpha1.PackageCELConditionAnnotation
+	PackagePhaseAnnotation               = manifestsv1alpha1.PackagePhaseAnnotation
+	PackageConditionMapAnnotation        = manifestsv1alpha1.PackageConditionMapAnnotation
+	PackageExternalObjectAnnotation      = manifestsv1alpha1.PackageExternalObjectAnnotation
+	PackageCELConditionAnnotation        = manifestsv1alpha1.PackageCELConditionAnnotation
+	PackageWaveAnnotation                = manifestsv1alpha1.PackageWaveAnnotation
+	PackageHookAnnotation                = manifestsv1alpha1.PackageHookAnnotation
+	PackageHookRetentionPolicyAnnotation = manifestsv1alpha1.PackageHookRetentionPolicyAnnotation
+	PackageHookFailurePolicyAnnotation   = manifestsv1alpha1.PackageHookFailurePolicyAnnotation
+	PackageDeletionPolicyAnnotation      = manifestsv1alpha1.PackageDeletionPolicyAnnotation
 )
 
 const (
-	PackageLabel                 = manifestsv1alpha1.PackageLabel
-	PackageSourceImageAnnotation = manifestsv1alpha1.PackageSourceImageAnnotation
-	PackageConfigAnnotation      = manifestsv1alpha1.PackageConfigAnnotation
-	PackageInstanceLabel         = manifestsv1alpha1.PackageInstanceLabel
+	PackageLabel                  = manifestsv1alpha1.PackageLabel
+	PackageSourceImageAnnotation  = manifestsv1alpha1.PackageSourceImageAnnotation
+	PackageConfigAnnotation       = manifestsv1alpha1.PackageConfigAnnotation
+	PackageSpecAnnotation         = manifestsv1alpha1.PackageSpecAnnotation
+	PackageInstanceLabel          = manifestsv1alpha1.PackageInstanceLabel
+	PackageDependenciesAnnotation = manifestsv1alpha1.PackageDependenciesAnnotation
 )
 
 // +kubebuilder:object:root=true
@@ -207,6 +214,34 @@ type PackageManifestTestCaseTemplate struct {
 	Name string
 	// Template data to use in the test case.
 	Context TemplateContext
+	// Errors the test case is expected to fail with.
+	// Each expected error has to match at least one error reported by the test case.
+	// Fixtures are not generated or compared for test cases expecting errors.
+	ExpectErrors []PackageManifestTestCaseExpectedError
+	// CEL expressions asserting properties of the rendered objects.
+	// Assertions are checked in addition to the fixtures of the test case.
+	Assertions []PackageManifestTestCaseAssertion
+}
+
+// PackageManifestTestCaseExpectedError describes an error a test case is expected to fail with.
+// All specified fields have to match the same error.
+type PackageManifestTestCaseExpectedError struct {
+	// Reason of the package violation.
+	Reason string
+	// Path of the configuration field failing validation.
+	Field string
+	// Text contained in the error message.
+	Message string
+}
+
+// PackageManifestTestCaseAssertion is a CEL expression evaluated against the rendered objects of a test case.
+type PackageManifestTestCaseAssertion struct {
+	// Name describing the assertion.
+	Name string
+	// CEL expression that has to evaluate to true.
+	// The variable "objects" holds the list of all rendered objects, after CEL filtering,
+	// and "config" holds the validated and defaulted configuration of the test case.
+	Expression string
 }
 
 type PackageManifestTestKubeconform struct {
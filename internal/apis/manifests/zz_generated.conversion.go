@@ -283,6 +283,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PackageManifestTestCaseAssertion)(nil), (*v1alpha1.PackageManifestTestCaseAssertion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageManifestTestCaseAssertion_To_v1alpha1_PackageManifestTestCaseAssertion(a.(*PackageManifestTestCaseAssertion), b.(*v1alpha1.PackageManifestTestCaseAssertion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageManifestTestCaseAssertion)(nil), (*PackageManifestTestCaseAssertion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageManifestTestCaseAssertion_To_manifests_PackageManifestTestCaseAssertion(a.(*v1alpha1.PackageManifestTestCaseAssertion), b.(*PackageManifestTestCaseAssertion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PackageManifestTestCaseExpectedError)(nil), (*v1alpha1.PackageManifestTestCaseExpectedError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageManifestTestCaseExpectedError_To_v1alpha1_PackageManifestTestCaseExpectedError(a.(*PackageManifestTestCaseExpectedError), b.(*v1alpha1.PackageManifestTestCaseExpectedError), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageManifestTestCaseExpectedError)(nil), (*PackageManifestTestCaseExpectedError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageManifestTestCaseExpectedError_To_manifests_PackageManifestTestCaseExpectedError(a.(*v1alpha1.PackageManifestTestCaseExpectedError), b.(*PackageManifestTestCaseExpectedError), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PackageManifestTestCaseTemplate)(nil), (*v1alpha1.PackageManifestTestCaseTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifests_PackageManifestTestCaseTemplate_To_v1alpha1_PackageManifestTestCaseTemplate(a.(*PackageManifestTestCaseTemplate), b.(*v1alpha1.PackageManifestTestCaseTemplate), scope)
 	}); err != nil {
@@ -1017,11 +1037,59 @@ func Convert_v1alpha1_PackageManifestTest_To_manifests_PackageManifestTest(in *v
 	return autoConvert_v1alpha1_PackageManifestTest_To_manifests_PackageManifestTest(in, out, s)
 }
 
+func autoConvert_manifests_PackageManifestTestCaseAssertion_To_v1alpha1_PackageManifestTestCaseAssertion(in *PackageManifestTestCaseAssertion, out *v1alpha1.PackageManifestTestCaseAssertion, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Expression = in.Expression
+	return nil
+}
+
+// Convert_manifests_PackageManifestTestCaseAssertion_To_v1alpha1_PackageManifestTestCaseAssertion is an autogenerated conversion function.
+func Convert_manifests_PackageManifestTestCaseAssertion_To_v1alpha1_PackageManifestTestCaseAssertion(in *PackageManifestTestCaseAssertion, out *v1alpha1.PackageManifestTestCaseAssertion, s conversion.Scope) error {
+	return autoConvert_manifests_PackageManifestTestCaseAssertion_To_v1alpha1_PackageManifestTestCaseAssertion(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageManifestTestCaseAssertion_To_manifests_PackageManifestTestCaseAssertion(in *v1alpha1.PackageManifestTestCaseAssertion, out *PackageManifestTestCaseAssertion, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Expression = in.Expression
+	return nil
+}
+
+// Convert_v1alpha1_PackageManifestTestCaseAssertion_To_manifests_PackageManifestTestCaseAssertion is an autogenerated conversion function.
+func Convert_v1alpha1_PackageManifestTestCaseAssertion_To_manifests_PackageManifestTestCaseAssertion(in *v1alpha1.PackageManifestTestCaseAssertion, out *PackageManifestTestCaseAssertion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageManifestTestCaseAssertion_To_manifests_PackageManifestTestCaseAssertion(in, out, s)
+}
+
+func autoConvert_manifests_PackageManifestTestCaseExpectedError_To_v1alpha1_PackageManifestTestCaseExpectedError(in *PackageManifestTestCaseExpectedError, out *v1alpha1.PackageManifestTestCaseExpectedError, s conversion.Scope) error {
+	out.Reason = in.Reason
+	out.Field = in.Field
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_manifests_PackageManifestTestCaseExpectedError_To_v1alpha1_PackageManifestTestCaseExpectedError is an autogenerated conversion function.
+func Convert_manifests_PackageManifestTestCaseExpectedError_To_v1alpha1_PackageManifestTestCaseExpectedError(in *PackageManifestTestCaseExpectedError, out *v1alpha1.PackageManifestTestCaseExpectedError, s conversion.Scope) error {
+	return autoConvert_manifests_PackageManifestTestCaseExpectedError_To_v1alpha1_PackageManifestTestCaseExpectedError(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageManifestTestCaseExpectedError_To_manifests_PackageManifestTestCaseExpectedError(in *v1alpha1.PackageManifestTestCaseExpectedError, out *PackageManifestTestCaseExpectedError, s conversion.Scope) error {
+	out.Reason = in.Reason
+	out.Field = in.Field
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_PackageManifestTestCaseExpectedError_To_manifests_PackageManifestTestCaseExpectedError is an autogenerated conversion function.
+func Convert_v1alpha1_PackageManifestTestCaseExpectedError_To_manifests_PackageManifestTestCaseExpectedError(in *v1alpha1.PackageManifestTestCaseExpectedError, out *PackageManifestTestCaseExpectedError, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageManifestTestCaseExpectedError_To_manifests_PackageManifestTestCaseExpectedError(in, out, s)
+}
+
 func autoConvert_manifests_PackageManifestTestCaseTemplate_To_v1alpha1_PackageManifestTestCaseTemplate(in *PackageManifestTestCaseTemplate, out *v1alpha1.PackageManifestTestCaseTemplate, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_manifests_TemplateContext_To_v1alpha1_TemplateContext(&in.Context, &out.Context, s); err != nil {
 		return err
 	}
+	out.ExpectErrors = *(*[]v1alpha1.PackageManifestTestCaseExpectedError)(unsafe.Pointer(&in.ExpectErrors))
+	out.Assertions = *(*[]v1alpha1.PackageManifestTestCaseAssertion)(unsafe.Pointer(&in.Assertions))
 	return nil
 }
 
@@ -1035,6 +1103,8 @@ func autoConvert_v1alpha1_PackageManifestTestCaseTemplate_To_manifests_PackageMa
 	if err := Convert_v1alpha1_TemplateContext_To_manifests_TemplateContext(&in.Context, &out.Context, s); err != nil {
 		return err
 	}
+	out.ExpectErrors = *(*[]PackageManifestTestCaseExpectedError)(unsafe.Pointer(&in.ExpectErrors))
+	out.Assertions = *(*[]PackageManifestTestCaseAssertion)(unsafe.Pointer(&in.Assertions))
 	return nil
 }
 
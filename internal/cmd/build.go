@@ -3,9 +3,11 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
 
 	"package-operator.run/internal/packages"
 )
@@ -109,6 +111,57 @@ func (b *Build) BuildFromSource(ctx context.Context, srcPath string, opts ...Bui
 		}
 	}
 
+	if len(cfg.SigningKeyPath) > 0 {
+		if err := b.sign(ctx, cfg.SigningKeyPath, cfg.Tags, rawPkg, craneOpts...); err != nil {
+			return fmt.Errorf("signing image: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// sign pushes a cosign signature for the image of the given package
+// into every repository referenced by tags.
+func (b *Build) sign(
+	ctx context.Context, keyPath string, tags []string,
+	rawPkg *packages.RawPackage, craneOpts ...crane.Option,
+) error {
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return fmt.Errorf("reading signing key: %w", err)
+	}
+	signer, err := packages.LoadSigningKey(keyPEM)
+	if err != nil {
+		return err
+	}
+
+	image, err := packages.ToOCI(rawPkg)
+	if err != nil {
+		return err
+	}
+	digest, err := image.Digest()
+	if err != nil {
+		return err
+	}
+
+	signed := map[string]struct{}{}
+	for _, tag := range tags {
+		ref, err := name.ParseReference(tag)
+		if err != nil {
+			return err
+		}
+		// Signatures are stored per repository, not per tag.
+		if _, ok := signed[ref.Context().String()]; ok {
+			continue
+		}
+		signed[ref.Context().String()] = struct{}{}
+
+		digestRef := ref.Context().Digest(digest.String())
+		b.cfg.Log.Info("signing image", "image", digestRef.String())
+		if err := packages.SignImage(ctx, digestRef, signer, craneOpts...); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -117,6 +170,8 @@ type BuildFromSourceConfig struct {
 	OutputPath string
 	Tags       []string
 	Push       bool
+	// Path to a PEM encoded private key to sign pushed images with.
+	SigningKeyPath string
 }
 
 func (c *BuildFromSourceConfig) Option(opts ...BuildFromSourceOption) {
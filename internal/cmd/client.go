@@ -11,11 +11,21 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/apis/manifests"
+)
+
+var (
+	// ErrNoPackageSource is returned when a revision does not record the package image it was created from.
+	ErrNoPackageSource = errors.New("revision has no package source image")
+	// ErrManagedByPackage is returned when trying to change an ObjectDeployment managed by a Package.
+	ErrManagedByPackage = errors.New("objectdeployment is managed by a package, change the package instead")
 )
 
 func NewClient(client client.Client) *Client {
@@ -194,6 +204,118 @@ func (p *Package) CurrentRevision() int64 {
 	return p.obj.(*corev1alpha1.Package).Status.Revision
 }
 
+func (p *Package) Generation() int64 {
+	return p.obj.GetGeneration()
+}
+
+func (p *Package) Conditions() []metav1.Condition {
+	if cpkg, ok := p.obj.(*corev1alpha1.ClusterPackage); ok {
+		return cpkg.Status.Conditions
+	}
+
+	return p.obj.(*corev1alpha1.Package).Status.Conditions
+}
+
+// TemplateContext returns the context the Package is rendered with.
+func (p *Package) TemplateContext() manifests.TemplateContext {
+	return p.accessor().TemplateContext()
+}
+
+// Component returns the component of a multi-component package that is deployed.
+func (p *Package) Component() string {
+	return p.accessor().GetComponent()
+}
+
+func (p *Package) accessor() adapters.GenericPackageAccessor {
+	if cpkg, ok := p.obj.(*corev1alpha1.ClusterPackage); ok {
+		return &adapters.GenericClusterPackage{ClusterPackage: *cpkg}
+	}
+
+	return &adapters.GenericPackage{Package: *p.obj.(*corev1alpha1.Package)}
+}
+
+// Rollback restores the spec the given revision was rendered from
+// as the desired state of the Package.
+// Operational settings like the pause state and rollout strategy are kept.
+func (p *Package) Rollback(ctx context.Context, rev ObjectSet) error {
+	spec, err := revisionPackageSpec(rev)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(p.obj.DeepCopyObject().(client.Object))
+	switch pkg := p.obj.(type) {
+	case *corev1alpha1.ClusterPackage:
+		restorePackageSpec(&pkg.Spec, spec)
+	case *corev1alpha1.Package:
+		restorePackageSpec(&pkg.Spec, spec)
+	}
+
+	if err := p.client.Patch(ctx, p.obj, patch); err != nil {
+		return fmt.Errorf("patching package: %w", err)
+	}
+
+	return nil
+}
+
+// revisionPackageSpec returns the Package spec recorded on the given revision.
+// Revisions created before the full spec was recorded only carry image and config.
+func revisionPackageSpec(rev ObjectSet) (corev1alpha1.PackageSpec, error) {
+	annotations := rev.obj.GetAnnotations()
+	if raw, ok := annotations[manifestsv1alpha1.PackageSpecAnnotation]; ok {
+		var spec corev1alpha1.PackageSpec
+		if err := json.Unmarshal([]byte(raw), &spec); err != nil {
+			return spec, fmt.Errorf("decoding package spec of revision %d: %w", rev.Revision(), err)
+		}
+
+		return spec, nil
+	}
+
+	image, ok := annotations[manifestsv1alpha1.PackageSourceImageAnnotation]
+	if !ok {
+		return corev1alpha1.PackageSpec{}, fmt.Errorf("%w: revision %d", ErrNoPackageSource, rev.Revision())
+	}
+
+	spec := corev1alpha1.PackageSpec{Image: image}
+	if raw := annotations[manifestsv1alpha1.PackageConfigAnnotation]; raw != "" && raw != "null" {
+		spec.Config = &runtime.RawExtension{Raw: []byte(raw)}
+	}
+
+	return spec, nil
+}
+
+func restorePackageSpec(dst *corev1alpha1.PackageSpec, src corev1alpha1.PackageSpec) {
+	dst.Image = src.Image
+	dst.Config = src.Config
+	dst.ConfigFrom = src.ConfigFrom
+	dst.Component = src.Component
+	dst.DeletionPolicy = src.DeletionPolicy
+	dst.Adoption = src.Adoption
+}
+
+// SetPaused pauses or resumes reconciliation of the Package.
+func (p *Package) SetPaused(ctx context.Context, paused bool) error {
+	patch := client.MergeFrom(p.obj.DeepCopyObject().(client.Object))
+	switch pkg := p.obj.(type) {
+	case *corev1alpha1.ClusterPackage:
+		pkg.Spec.Paused = paused
+	case *corev1alpha1.Package:
+		pkg.Spec.Paused = paused
+	}
+
+	if err := p.client.Patch(ctx, p.obj, patch); err != nil {
+		return fmt.Errorf("patching package: %w", err)
+	}
+
+	return nil
+}
+
+// Promote allows the given revision to replace all previous revisions
+// of a Package with a manual promotion strategy.
+func (p *Package) Promote(ctx context.Context, rev ObjectSet) error {
+	return promoteObjectSet(ctx, p.client, rev)
+}
+
 func (p *Package) ObjectSets(ctx context.Context) (ObjectSetList, error) {
 	opts := []findObjectSetsOption{
 		withSelector{
@@ -231,6 +353,93 @@ func (d *ObjectDeployment) CurrentRevision() int64 {
 	return d.obj.(*corev1alpha1.ObjectDeployment).Status.Revision
 }
 
+func (d *ObjectDeployment) Generation() int64 {
+	return d.obj.GetGeneration()
+}
+
+func (d *ObjectDeployment) Conditions() []metav1.Condition {
+	if cod, ok := d.obj.(*corev1alpha1.ClusterObjectDeployment); ok {
+		return cod.Status.Conditions
+	}
+
+	return d.obj.(*corev1alpha1.ObjectDeployment).Status.Conditions
+}
+
+// Rollback restores the template spec of the given revision
+// as the desired state of the ObjectDeployment.
+func (d *ObjectDeployment) Rollback(ctx context.Context, rev ObjectSet) error {
+	if err := d.ensureNotManagedByPackage(); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(d.obj.DeepCopyObject().(client.Object))
+	switch od := d.obj.(type) {
+	case *corev1alpha1.ClusterObjectDeployment:
+		od.Spec.Template.Spec = rev.TemplateSpec()
+	case *corev1alpha1.ObjectDeployment:
+		od.Spec.Template.Spec = rev.TemplateSpec()
+	}
+
+	if err := d.client.Patch(ctx, d.obj, patch); err != nil {
+		return fmt.Errorf("patching objectdeployment: %w", err)
+	}
+
+	return nil
+}
+
+// SetPaused pauses or resumes reconciliation of the ObjectDeployment.
+func (d *ObjectDeployment) SetPaused(ctx context.Context, paused bool) error {
+	if err := d.ensureNotManagedByPackage(); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(d.obj.DeepCopyObject().(client.Object))
+	switch od := d.obj.(type) {
+	case *corev1alpha1.ClusterObjectDeployment:
+		od.Spec.Paused = paused
+	case *corev1alpha1.ObjectDeployment:
+		od.Spec.Paused = paused
+	}
+
+	if err := d.client.Patch(ctx, d.obj, patch); err != nil {
+		return fmt.Errorf("patching objectdeployment: %w", err)
+	}
+
+	return nil
+}
+
+// Promote allows the given revision to replace all previous revisions
+// of an ObjectDeployment with a manual promotion strategy.
+func (d *ObjectDeployment) Promote(ctx context.Context, rev ObjectSet) error {
+	return promoteObjectSet(ctx, d.client, rev)
+}
+
+func promoteObjectSet(ctx context.Context, c client.Client, rev ObjectSet) error {
+	patch := client.MergeFrom(rev.obj.DeepCopyObject().(client.Object))
+	annotations := rev.obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[corev1alpha1.ObjectSetPromotedAnnotation] = "True"
+	rev.obj.SetAnnotations(annotations)
+
+	if err := c.Patch(ctx, rev.obj, patch); err != nil {
+		return fmt.Errorf("patching objectset: %w", err)
+	}
+
+	return nil
+}
+
+// Changes to ObjectDeployments managed by a Package would be reverted by Package Operator.
+func (d *ObjectDeployment) ensureNotManagedByPackage() error {
+	if owner := metav1.GetControllerOf(d.obj); owner != nil &&
+		(owner.Kind == "Package" || owner.Kind == "ClusterPackage") {
+		return fmt.Errorf("%w: %s/%s", ErrManagedByPackage, strings.ToLower(owner.Kind), owner.Name)
+	}
+
+	return nil
+}
+
 func (d *ObjectDeployment) ObjectSets(ctx context.Context) (ObjectSetList, error) {
 	opts := []findObjectSetsOption{
 		withSelector{
@@ -336,6 +545,10 @@ func (s *ObjectSet) HasSucceeded() bool {
 	return meta.IsStatusConditionTrue(s.getConditions(), corev1alpha1.ObjectSetSucceeded)
 }
 
+func (s *ObjectSet) AvailableCondition() *metav1.Condition {
+	return meta.FindStatusCondition(s.getConditions(), corev1alpha1.ObjectSetAvailable)
+}
+
 func (s *ObjectSet) getConditions() []metav1.Condition {
 	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
 		return cos.Status.Conditions
@@ -352,6 +565,32 @@ func (s *ObjectSet) Revision() int64 {
 	return s.obj.(*corev1alpha1.ObjectSet).Status.Revision
 }
 
+func (s *ObjectSet) TemplateSpec() corev1alpha1.ObjectSetTemplateSpec {
+	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
+		return *cos.Spec.ObjectSetTemplateSpec.DeepCopy()
+	}
+
+	return *s.obj.(*corev1alpha1.ObjectSet).Spec.ObjectSetTemplateSpec.DeepCopy()
+}
+
+// ControllerOf returns references to all objects controlled by this revision.
+func (s *ObjectSet) ControllerOf() []corev1alpha1.ControlledObjectReference {
+	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
+		return cos.Status.ControllerOf
+	}
+
+	return s.obj.(*corev1alpha1.ObjectSet).Status.ControllerOf
+}
+
+// ProbedObjects returns the last probe result of each probed object.
+func (s *ObjectSet) ProbedObjects() []corev1alpha1.ObjectProbeStatus {
+	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
+		return cos.Status.ProbedObjects
+	}
+
+	return s.obj.(*corev1alpha1.ObjectSet).Status.ProbedObjects
+}
+
 func (s *ObjectSet) ChangeCause() string {
 	const changeCauseKey = "kubernetes.io/change-cause"
 
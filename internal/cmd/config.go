@@ -0,0 +1,356 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"package-operator.run/internal/packages"
+)
+
+// ErrNoConfigSchema is returned when a package does not declare a config schema.
+var ErrNoConfigSchema = errors.New("package has no config schema")
+
+func NewConfiguration(opts ...ConfigurationOption) *Configuration {
+	var cfg ConfigurationConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Configuration{
+		cfg: cfg,
+	}
+}
+
+// Configuration inspects and validates the configuration of packages.
+type Configuration struct {
+	cfg ConfigurationConfig
+}
+
+type ConfigurationConfig struct {
+	Log  logr.Logger
+	Pull PullFn
+}
+
+func (c *ConfigurationConfig) Option(opts ...ConfigurationOption) {
+	for _, opt := range opts {
+		opt.ConfigureConfiguration(c)
+	}
+}
+
+func (c *ConfigurationConfig) Default() {
+	if c.Log.GetSink() == nil {
+		c.Log = logr.Discard()
+	}
+	if c.Pull == nil {
+		c.Pull = packages.FromRegistry
+	}
+}
+
+type ConfigurationOption interface {
+	ConfigureConfiguration(*ConfigurationConfig)
+}
+
+const jsonSchemaDialect = "http://json-schema.org/draft-07/schema#"
+
+// Schema returns the config schema of the package at src as JSON Schema.
+// src may be a package source directory or an image reference.
+func (c *Configuration) Schema(
+	ctx context.Context, src string, opts ...ConfigurationSourceOption,
+) ([]byte, error) {
+	schema, err := c.loadSchema(ctx, src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	obj := map[string]any{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	toJSONSchema(obj)
+	obj["$schema"] = jsonSchemaDialect
+
+	return json.MarshalIndent(obj, "", "  ")
+}
+
+// Validate prunes, defaults and validates the given config like Package Operator does when
+// installing the package at src. The given config map is modified in place.
+func (c *Configuration) Validate(
+	ctx context.Context, src string, config map[string]any, opts ...ConfigurationSourceOption,
+) (field.ErrorList, error) {
+	var cfg ConfigurationSourceConfig
+
+	cfg.Option(opts...)
+
+	pkg, err := c.loadPackage(ctx, src, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ferrs, err := packages.AdmitPackageConfiguration(ctx, config, pkg.Manifest, field.NewPath("spec", "config"))
+	if err != nil {
+		return nil, fmt.Errorf("validate Package configuration: %w", err)
+	}
+	return ferrs, nil
+}
+
+// Example returns a YAML config file for the package at src, showing all known fields
+// with their defaults and commented with their descriptions.
+func (c *Configuration) Example(
+	ctx context.Context, src string, opts ...ConfigurationSourceOption,
+) (string, error) {
+	schema, err := c.loadSchema(ctx, src, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if err := writeConfigExample(&b, schema, nil, 0); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (c *Configuration) loadSchema(
+	ctx context.Context, src string, opts ...ConfigurationSourceOption,
+) (*apiextensionsv1.JSONSchemaProps, error) {
+	var cfg ConfigurationSourceConfig
+
+	cfg.Option(opts...)
+
+	pkg, err := c.loadPackage(ctx, src, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if pkg.Manifest.Spec.Config.OpenAPIV3Schema == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoConfigSchema, pkg.Manifest.Name)
+	}
+
+	schema := &apiextensionsv1.JSONSchemaProps{}
+	if err := apiextensionsv1.Convert_apiextensions_JSONSchemaProps_To_v1_JSONSchemaProps(
+		pkg.Manifest.Spec.Config.OpenAPIV3Schema, schema, nil); err != nil {
+		return nil, fmt.Errorf("converting config schema: %w", err)
+	}
+	return schema, nil
+}
+
+func (c *Configuration) loadPackage(
+	ctx context.Context, src string, cfg ConfigurationSourceConfig,
+) (*packages.Package, error) {
+	var rawPkg *packages.RawPackage
+	if info, err := os.Stat(src); err == nil && info.IsDir() {
+		c.cfg.Log.Info("loading source from disk", "path", src)
+
+		if rawPkg, err = getPackageFromPath(ctx, src); err != nil {
+			return nil, err
+		}
+	} else {
+		ref, err := name.ParseReference(src)
+		if err != nil {
+			return nil, fmt.Errorf("parsing remote reference: %w", err)
+		}
+
+		var craneOpts []crane.Option
+		if cfg.Insecure {
+			craneOpts = append(craneOpts, crane.Insecure)
+		}
+
+		c.cfg.Log.Info("pulling image", "reference", ref.String())
+		if rawPkg, err = c.cfg.Pull(ctx, ref.String(), craneOpts...); err != nil {
+			return nil, fmt.Errorf("importing package from image: %w", err)
+		}
+	}
+
+	pkg, err := packages.DefaultStructuralLoader.LoadComponent(ctx, rawPkg, cfg.Component)
+	if err != nil {
+		return nil, fmt.Errorf("parsing package contents: %w", err)
+	}
+	return pkg, nil
+}
+
+type ConfigurationSourceConfig struct {
+	Insecure  bool
+	Component string
+}
+
+func (c *ConfigurationSourceConfig) Option(opts ...ConfigurationSourceOption) {
+	for _, opt := range opts {
+		opt.ConfigureConfigurationSource(c)
+	}
+}
+
+type ConfigurationSourceOption interface {
+	ConfigureConfigurationSource(*ConfigurationSourceConfig)
+}
+
+// Keywords holding instance data instead of schemas.
+var jsonSchemaDataKeywords = map[string]bool{
+	"default": true, "enum": true, "example": true,
+}
+
+// toJSONSchema rewrites OpenAPI v3.0 specific keywords of the given schema into their JSON Schema equivalent.
+func toJSONSchema(schema map[string]any) {
+	if nullable, _ := schema["nullable"].(bool); nullable {
+		if t, ok := schema["type"].(string); ok {
+			schema["type"] = []any{t, "null"}
+		}
+	}
+	delete(schema, "nullable")
+
+	// OpenAPI v3.0 uses draft-04 style boolean exclusive bounds.
+	for exclusive, bound := range map[string]string{
+		"exclusiveMinimum": "minimum",
+		"exclusiveMaximum": "maximum",
+	} {
+		isExclusive, ok := schema[exclusive].(bool)
+		if !ok {
+			continue
+		}
+		// Without a bound there is nothing to exclude.
+		if boundValue, hasBound := schema[bound]; isExclusive && hasBound {
+			schema[exclusive] = boundValue
+			delete(schema, bound)
+		} else {
+			delete(schema, exclusive)
+		}
+	}
+
+	if intOrString, _ := schema["x-kubernetes-int-or-string"].(bool); intOrString {
+		if _, ok := schema["anyOf"]; !ok {
+			schema["anyOf"] = []any{
+				map[string]any{"type": "integer"},
+				map[string]any{"type": "string"},
+			}
+		}
+	}
+
+	for k, v := range schema {
+		if jsonSchemaDataKeywords[k] {
+			continue
+		}
+		switch v := v.(type) {
+		case map[string]any:
+			if k == "properties" || k == "definitions" || k == "dependencies" || k == "patternProperties" {
+				for _, sub := range v {
+					if subSchema, ok := sub.(map[string]any); ok {
+						toJSONSchema(subSchema)
+					}
+				}
+				continue
+			}
+			toJSONSchema(v)
+		case []any:
+			for _, item := range v {
+				if subSchema, ok := item.(map[string]any); ok {
+					toJSONSchema(subSchema)
+				}
+			}
+		}
+	}
+}
+
+var plainYAMLKey = regexp.MustCompile(`^[A-Za-z0-9_./-]+$`)
+
+// Writes a YAML document for the given object schema,
+// using the defaults of the schema or the given parent default.
+func writeConfigExample(
+	b *strings.Builder, schema *apiextensionsv1.JSONSchemaProps, parentDefault any, indent int,
+) error {
+	defaults, _ := parentDefault.(map[string]any)
+	if schema.Default != nil {
+		// Unmarshal merges into existing maps, don't modify the defaults of the parent.
+		defaults = runtime.DeepCopyJSON(defaults)
+		if err := json.Unmarshal(schema.Default.Raw, &defaults); err != nil {
+			return fmt.Errorf("unmarshal default: %w", err)
+		}
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	prefix := strings.Repeat(" ", indent)
+	for _, name := range names {
+		prop := schema.Properties[name]
+
+		for _, line := range strings.Split(strings.TrimSpace(prop.Description), "\n") {
+			if len(line) > 0 {
+				fmt.Fprintf(b, "%s# %s\n", prefix, line)
+			}
+		}
+		typeComment := prop.Type
+		if len(typeComment) == 0 {
+			typeComment = "any"
+		}
+		for _, required := range schema.Required {
+			if required == name {
+				typeComment += ", required"
+			}
+		}
+		fmt.Fprintf(b, "%s# type: %s\n", prefix, typeComment)
+
+		key := name
+		if !plainYAMLKey.MatchString(key) {
+			key = fmt.Sprintf("%q", key)
+		}
+
+		if prop.Type == "object" && len(prop.Properties) > 0 {
+			fmt.Fprintf(b, "%s%s:\n", prefix, key)
+			if err := writeConfigExample(b, &prop, defaults[name], indent+2); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, ok := defaults[name]
+		if prop.Default != nil {
+			if err := json.Unmarshal(prop.Default.Raw, &value); err != nil {
+				return fmt.Errorf("unmarshal default: %w", err)
+			}
+		} else if !ok {
+			value = configExampleZeroValue(prop.Type)
+		}
+		// JSON is valid YAML and keeps nested values on a single line.
+		v, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(b, "%s%s: %s\n", prefix, key, v)
+	}
+	return nil
+}
+
+func configExampleZeroValue(schemaType string) any {
+	switch schemaType {
+	case "string":
+		return ""
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	case "array":
+		return []any{}
+	case "object":
+		return map[string]any{}
+	default:
+		return nil
+	}
+}
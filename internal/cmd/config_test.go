@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"package-operator.run/internal/packages"
+)
+
+const configTestManifest = `apiVersion: manifests.package-operator.run/v1alpha1
+kind: PackageManifest
+metadata:
+  name: test-stub
+spec:
+  scopes:
+  - Namespaced
+  phases:
+  - name: deploy
+  config:
+    openAPIV3Schema:
+      type: object
+      properties:
+        port:
+          type: integer
+          minimum: 1024
+          exclusiveMinimum: true
+        size:
+          x-kubernetes-int-or-string: true
+        labels:
+          type: object
+          nullable: true
+          default:
+            nullable: true
+          additionalProperties:
+            type: string
+        database:
+          type: object
+          default:
+            host: db
+          properties:
+            host:
+              type: string
+            user:
+              type: string
+`
+
+const configTestManifestWithoutSchema = `apiVersion: manifests.package-operator.run/v1alpha1
+kind: PackageManifest
+metadata:
+  name: test-stub
+spec:
+  scopes:
+  - Namespaced
+  phases:
+  - name: deploy
+`
+
+func TestConfiguration_Schema(t *testing.T) {
+	t.Parallel()
+
+	c := NewConfiguration(WithPuller{Pull: configTestPuller})
+	b, err := c.Schema(context.Background(), "quay.io/test/pkg:v1")
+	require.NoError(t, err)
+
+	schema := map[string]any{}
+	require.NoError(t, json.Unmarshal(b, &schema))
+	assert.Equal(t, jsonSchemaDialect, schema["$schema"])
+
+	props := schema["properties"].(map[string]any)
+	port := props["port"].(map[string]any)
+	assert.InDelta(t, 1024, port["exclusiveMinimum"], 0)
+	assert.NotContains(t, port, "minimum")
+	size := props["size"].(map[string]any)
+	assert.Len(t, size["anyOf"], 2)
+
+	labels := props["labels"].(map[string]any)
+	assert.Equal(t, []any{"object", "null"}, labels["type"])
+	assert.NotContains(t, labels, "nullable")
+	// Defaults are data and stay untouched.
+	assert.Equal(t, map[string]any{"nullable": true}, labels["default"])
+}
+
+func TestConfiguration_Example(t *testing.T) {
+	t.Parallel()
+
+	c := NewConfiguration(WithPuller{Pull: configTestPuller})
+	example, err := c.Example(context.Background(), "quay.io/test/pkg:v1")
+	require.NoError(t, err)
+	assert.Equal(t, `# type: object
+database:
+  # type: string
+  host: "db"
+  # type: string
+  user: ""
+# type: object
+labels: {"nullable":true}
+# type: integer
+port: 0
+# type: any
+size: null
+`, example)
+}
+
+func TestToJSONSchema_ExclusiveWithoutBound(t *testing.T) {
+	t.Parallel()
+
+	schema := map[string]any{
+		"type":             "integer",
+		"exclusiveMinimum": true,
+		"exclusiveMaximum": true,
+		"maximum":          float64(10),
+	}
+	toJSONSchema(schema)
+	assert.Equal(t, map[string]any{
+		"type":             "integer",
+		"exclusiveMaximum": float64(10),
+	}, schema)
+}
+
+func TestWriteConfigExample_KeepsParentDefaults(t *testing.T) {
+	t.Parallel()
+
+	schema := &apiextensionsv1.JSONSchemaProps{
+		Type:    "object",
+		Default: &apiextensionsv1.JSON{Raw: []byte(`{"user":"admin"}`)},
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"host": {Type: "string"},
+			"user": {Type: "string"},
+		},
+	}
+	parentDefault := map[string]any{"host": "db"}
+
+	b := &strings.Builder{}
+	require.NoError(t, writeConfigExample(b, schema, parentDefault, 0))
+	assert.Equal(t, `# type: string
+host: "db"
+# type: string
+user: "admin"
+`, b.String())
+	assert.Equal(t, map[string]any{"host": "db"}, parentDefault)
+}
+
+func TestConfiguration_NoSchema(t *testing.T) {
+	t.Parallel()
+
+	c := NewConfiguration(WithPuller{Pull: func(context.Context, string, ...crane.Option) (*packages.RawPackage, error) {
+		return &packages.RawPackage{Files: packages.Files{
+			"manifest.yaml": []byte(configTestManifestWithoutSchema),
+		}}, nil
+	}})
+	_, err := c.Schema(context.Background(), "quay.io/test/pkg:v1")
+	require.ErrorIs(t, err, ErrNoConfigSchema)
+}
+
+func configTestPuller(context.Context, string, ...crane.Option) (*packages.RawPackage, error) {
+	return &packages.RawPackage{Files: packages.Files{
+		"manifest.yaml": []byte(configTestManifest),
+	}}, nil
+}
@@ -0,0 +1,516 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pmezard/go-difflib/difflib"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/constants"
+	"package-operator.run/internal/environment"
+	"package-operator.run/internal/packages"
+)
+
+func NewDiff(scheme *runtime.Scheme, client client.Client, opts ...DiffOption) *Diff {
+	var cfg DiffConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Diff{
+		cfg:    cfg,
+		client: client,
+		scheme: scheme,
+	}
+}
+
+// Diff compares a new package version against the objects of a live Package.
+type Diff struct {
+	cfg    DiffConfig
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+type DiffConfig struct {
+	Log         logr.Logger
+	Pull        PullFn
+	Environment EnvironmentProber
+}
+
+func (c *DiffConfig) Option(opts ...DiffOption) {
+	for _, opt := range opts {
+		opt.ConfigureDiff(c)
+	}
+}
+
+func (c *DiffConfig) Default() {
+	if c.Log.GetSink() == nil {
+		c.Log = logr.Discard()
+	}
+	if c.Pull == nil {
+		c.Pull = packages.FromRegistry
+	}
+	if c.Environment == nil {
+		c.Environment = emptyEnvironment{}
+	}
+}
+
+type DiffOption interface {
+	ConfigureDiff(*DiffConfig)
+}
+
+// EnvironmentProber detects the environment packages are rendered with.
+type EnvironmentProber interface {
+	Probe(ctx context.Context) (*manifests.PackageEnvironment, error)
+}
+
+type emptyEnvironment struct{}
+
+func (emptyEnvironment) Probe(context.Context) (*manifests.PackageEnvironment, error) {
+	return &manifests.PackageEnvironment{}, nil
+}
+
+// PackageDiff lists the changes a new package version would apply to the cluster.
+type PackageDiff struct {
+	// Objects that already exist and would be changed.
+	Changed []ObjectDiff
+	// Objects that would be created.
+	Added []DiffObjectReference
+	// Objects that are no longer part of the package,
+	// but are not controlled by it, so they would be left on the cluster.
+	Orphaned []DiffObjectReference
+	// Objects that are no longer part of the package and would be deleted.
+	Deleted []DiffObjectReference
+}
+
+// Empty returns true if the new package version would not change anything.
+func (d *PackageDiff) Empty() bool {
+	return len(d.Changed) == 0 && len(d.Added) == 0 &&
+		len(d.Orphaned) == 0 && len(d.Deleted) == 0
+}
+
+// ObjectDiff is a unified diff between the live and the new version of an object.
+type ObjectDiff struct {
+	Object DiffObjectReference
+	Diff   string
+}
+
+type DiffObjectReference struct {
+	schema.GroupKind
+	client.ObjectKey
+}
+
+func (r DiffObjectReference) String() string {
+	if len(r.Namespace) == 0 {
+		return fmt.Sprintf("%s %s", r.GroupKind, r.Name)
+	}
+
+	return fmt.Sprintf("%s %s/%s", r.GroupKind, r.Namespace, r.Name)
+}
+
+func newDiffObjectReference(obj *unstructured.Unstructured) DiffObjectReference {
+	return DiffObjectReference{
+		GroupKind: obj.GroupVersionKind().GroupKind(),
+		ObjectKey: client.ObjectKeyFromObject(obj),
+	}
+}
+
+// DiffPackage renders the package at src, either a source directory or an image reference,
+// with the config and environment of the live (Cluster)Package
+// and compares the result against the cluster by dry-running server-side apply.
+func (d *Diff) DiffPackage(
+	ctx context.Context, src, pkgName string, opts ...DiffPackageOption,
+) (*PackageDiff, error) {
+	var cfg DiffPackageConfig
+
+	cfg.Option(opts...)
+
+	pkg, err := NewClient(d.client).GetPackage(ctx, pkgName, WithNamespace(cfg.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	rawPkg, err := d.loadPackage(ctx, src, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := d.renderPackage(ctx, pkg, rawPkg, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PackageDiff{}
+	desired := map[DiffObjectReference]struct{}{}
+	for _, phase := range spec.Phases {
+		for _, phaseObj := range phase.Objects {
+			obj := phaseObj.Object.DeepCopy()
+			if err := d.defaultNamespace(obj, pkg.Namespace()); err != nil {
+				return nil, err
+			}
+			ref := newDiffObjectReference(obj)
+			desired[ref] = struct{}{}
+
+			if err := d.diffObject(ctx, obj, res); err != nil {
+				return nil, fmt.Errorf("diffing %s: %w", ref, err)
+			}
+		}
+	}
+
+	if err := d.diffRemovedObjects(ctx, pkg, desired, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// defaultNamespace places namespaced objects without a namespace into the namespace of the package.
+// Cluster-scoped objects are left alone, so they are looked up without a namespace.
+func (d *Diff) defaultNamespace(obj *unstructured.Unstructured, namespace string) error {
+	if len(obj.GetNamespace()) > 0 || len(namespace) == 0 {
+		return nil
+	}
+
+	gvk := obj.GroupVersionKind()
+	mapping, err := d.client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		// The API might be introduced by the package itself,
+		// fall back to the namespace like Package Operator does.
+		obj.SetNamespace(namespace)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("mapping %s: %w", gvk, err)
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		obj.SetNamespace(namespace)
+	}
+
+	return nil
+}
+
+func (d *Diff) loadPackage(ctx context.Context, src string, cfg DiffPackageConfig) (*packages.RawPackage, error) {
+	if info, err := os.Stat(src); err == nil && info.IsDir() {
+		d.cfg.Log.Info("loading source from disk", "path", src)
+
+		return getPackageFromPath(ctx, src)
+	}
+
+	ref, err := name.ParseReference(src)
+	if err != nil {
+		return nil, fmt.Errorf("parsing remote reference: %w", err)
+	}
+
+	var craneOpts []crane.Option
+	if cfg.Insecure {
+		craneOpts = append(craneOpts, crane.Insecure)
+	}
+
+	d.cfg.Log.Info("pulling image", "reference", ref.String())
+	rawPkg, err := d.cfg.Pull(ctx, ref.String(), craneOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("importing package from image: %w", err)
+	}
+
+	return rawPkg, nil
+}
+
+// renderPackage renders the package like Package Operator would for the live Package.
+func (d *Diff) renderPackage(
+	ctx context.Context, apiPkg *Package, rawPkg *packages.RawPackage, cfg DiffPackageConfig,
+) (corev1alpha1.ObjectSetTemplateSpec, error) {
+	env, err := d.getEnvironment(ctx, apiPkg.Namespace())
+	if err != nil {
+		return corev1alpha1.ObjectSetTemplateSpec{}, fmt.Errorf("getting environment: %w", err)
+	}
+
+	deployer := packages.NewClusterPackageDeployer(d.client, d.scheme)
+	if len(apiPkg.Namespace()) > 0 {
+		deployer = packages.NewPackageDeployer(d.client, d.client, d.scheme)
+	}
+
+	var deployOpts []packages.DeployOption
+	if len(apiPkg.accessor().GetConfigFrom()) > 0 {
+		sourceConfig, err := packages.NewConfigSourceResolver(d.client, d.client, cfg.ConfigSourceNamespace).
+			Config(ctx, apiPkg.accessor())
+		if err != nil {
+			return corev1alpha1.ObjectSetTemplateSpec{}, fmt.Errorf("resolving config sources: %w", err)
+		}
+		deployOpts = append(deployOpts, packages.WithSourceConfig{Config: sourceConfig})
+	}
+
+	spec, err := deployer.Render(ctx, apiPkg.accessor(), rawPkg, *env, deployOpts...)
+	if err != nil {
+		return corev1alpha1.ObjectSetTemplateSpec{}, fmt.Errorf("rendering package: %w", err)
+	}
+
+	return spec, nil
+}
+
+func (d *Diff) getEnvironment(ctx context.Context, namespace string) (*manifests.PackageEnvironment, error) {
+	env, err := d.cfg.Environment.Probe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sink := environment.NewSink(d.client)
+	sink.SetEnvironment(env)
+
+	return sink.GetEnvironment(ctx, namespace)
+}
+
+// diffObject dry-runs server-side apply of the given object the same way
+// Package Operator would patch it and records the result.
+func (d *Diff) diffObject(ctx context.Context, desired *unstructured.Unstructured, res *PackageDiff) error {
+	ref := newDiffObjectReference(desired)
+
+	live := desired.DeepCopy()
+	exists := true
+	err := d.client.Get(ctx, client.ObjectKeyFromObject(desired), live)
+	switch {
+	case meta.IsNoMatchError(err):
+		// The API is introduced by the package itself, e.g. by a CRD of an earlier phase,
+		// so the object can not exist yet and the API server can not dry-run it.
+		res.Added = append(res.Added, ref)
+		return nil
+	case apimachineryerrors.IsNotFound(err):
+		exists = false
+	case err != nil:
+		return fmt.Errorf("getting live object: %w", err)
+	}
+
+	patch := desired.DeepCopy()
+	// never patch status, Package Operator does not either.
+	unstructured.RemoveNestedField(patch.Object, "status")
+	if exists {
+		keepManagedMetadata(live, patch)
+	}
+
+	objectPatch, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("creating patch: %w", err)
+	}
+
+	updated := desired.DeepCopy()
+	err = d.client.Patch(ctx, updated, client.RawPatch(types.ApplyPatchType, objectPatch),
+		client.FieldOwner(constants.FieldOwner),
+		client.ForceOwnership,
+		client.DryRunAll,
+	)
+	if !exists && (meta.IsNoMatchError(err) || isNamespaceNotFound(err, desired)) {
+		// The namespace or API is created by the package itself.
+		res.Added = append(res.Added, ref)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("dry-run server-side apply: %w", err)
+	}
+
+	if !exists {
+		res.Added = append(res.Added, ref)
+		return nil
+	}
+
+	diff, err := unifiedDiff(ref, live, updated)
+	if err != nil {
+		return err
+	}
+	if len(diff) > 0 {
+		res.Changed = append(res.Changed, ObjectDiff{Object: ref, Diff: diff})
+	}
+
+	return nil
+}
+
+// isNamespaceNotFound returns true if err reports that the namespace of obj does not exist.
+func isNamespaceNotFound(err error, obj *unstructured.Unstructured) bool {
+	var status apimachineryerrors.APIStatus
+	if !apimachineryerrors.IsNotFound(err) || !errors.As(err, &status) {
+		return false
+	}
+	details := status.Status().Details
+	return details != nil && details.Kind == "namespaces" && details.Name == obj.GetNamespace()
+}
+
+// keepManagedMetadata copies metadata that Package Operator manages itself
+// from the live object, so it does not show up as removed.
+func keepManagedMetadata(live, patch *unstructured.Unstructured) {
+	patch.SetOwnerReferences(live.GetOwnerReferences())
+
+	labels := patch.GetLabels()
+	for _, key := range managedLabels {
+		if v, ok := live.GetLabels()[key]; ok {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[key] = v
+		}
+	}
+	patch.SetLabels(labels)
+
+	annotations := patch.GetAnnotations()
+	for _, key := range managedAnnotations {
+		if v, ok := live.GetAnnotations()[key]; ok {
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[key] = v
+		}
+	}
+	patch.SetAnnotations(annotations)
+}
+
+// diffRemovedObjects records objects of the current revision that are not part of the new version.
+func (d *Diff) diffRemovedObjects(
+	ctx context.Context, pkg *Package, desired map[DiffObjectReference]struct{}, res *PackageDiff,
+) error {
+	objectSets, err := pkg.ObjectSets(ctx)
+	if err != nil {
+		return fmt.Errorf("listing revisions: %w", err)
+	}
+	current, ok := objectSets.FindRevision(pkg.CurrentRevision())
+	if !ok {
+		return nil
+	}
+
+	controlled := map[DiffObjectReference]struct{}{}
+	for _, ref := range current.ControllerOf() {
+		controlled[DiffObjectReference{
+			GroupKind: schema.GroupKind{Group: ref.Group, Kind: ref.Kind},
+			ObjectKey: client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace},
+		}] = struct{}{}
+	}
+
+	for _, phase := range current.TemplateSpec().Phases {
+		for _, phaseObj := range phase.Objects {
+			obj := phaseObj.Object.DeepCopy()
+			if err := d.defaultNamespace(obj, current.Namespace()); err != nil {
+				return err
+			}
+			ref := newDiffObjectReference(obj)
+			if _, ok := desired[ref]; ok {
+				continue
+			}
+
+			if _, ok := controlled[ref]; ok {
+				res.Deleted = append(res.Deleted, ref)
+				continue
+			}
+
+			// Objects not controlled by the revision are released, but not deleted.
+			err := d.client.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+			if apimachineryerrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("getting %s: %w", ref, err)
+			}
+			res.Orphaned = append(res.Orphaned, ref)
+		}
+	}
+
+	sortDiffObjectReferences(res.Deleted)
+	sortDiffObjectReferences(res.Orphaned)
+
+	return nil
+}
+
+func sortDiffObjectReferences(refs []DiffObjectReference) {
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].String() < refs[j].String()
+	})
+}
+
+// Metadata fields that change with every write and would only add noise to the diff.
+var volatileMetadataFields = []string{"managedFields", "resourceVersion", "generation", "creationTimestamp"}
+
+func unifiedDiff(ref DiffObjectReference, live, updated *unstructured.Unstructured) (string, error) {
+	a, err := diffableYAML(live)
+	if err != nil {
+		return "", err
+	}
+	b, err := diffableYAML(updated)
+	if err != nil {
+		return "", err
+	}
+	if a == b {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: "live/" + ref.String(),
+		ToFile:   "new/" + ref.String(),
+		Context:  3,
+	})
+}
+
+// Labels and annotations Package Operator adds to every object it manages.
+var (
+	managedLabels = []string{
+		constants.DynamicCacheLabel, manifestsv1alpha1.PackageLabel, manifestsv1alpha1.PackageInstanceLabel,
+	}
+	managedAnnotations = []string{corev1alpha1.ObjectSetRevisionAnnotation}
+)
+
+func diffableYAML(obj *unstructured.Unstructured) (string, error) {
+	obj = obj.DeepCopy()
+	for _, f := range volatileMetadataFields {
+		unstructured.RemoveNestedField(obj.Object, "metadata", f)
+	}
+	for _, key := range managedLabels {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "labels", key)
+	}
+	if len(obj.GetLabels()) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "labels")
+	}
+	for _, key := range managedAnnotations {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", key)
+	}
+	if len(obj.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	}
+
+	b, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("marshalling object: %w", err)
+	}
+
+	return string(b), nil
+}
+
+type DiffPackageConfig struct {
+	Insecure  bool
+	Namespace string
+	// Namespace config sources of ClusterPackages are looked up in.
+	ConfigSourceNamespace string
+}
+
+func (c *DiffPackageConfig) Option(opts ...DiffPackageOption) {
+	for _, opt := range opts {
+		opt.ConfigureDiffPackage(c)
+	}
+}
+
+type DiffPackageOption interface {
+	ConfigureDiffPackage(*DiffPackageConfig)
+}
@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/packages"
+)
+
+const diffTestManifest = `apiVersion: manifests.package-operator.run/v1alpha1
+kind: PackageManifest
+metadata:
+  name: test-stub
+spec:
+  scopes:
+  - Namespaced
+  phases:
+  - name: deploy
+  config:
+    openAPIV3Schema:
+      properties:
+        greeting:
+          type: string
+      type: object
+`
+
+const diffTestConfigMaps = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+  annotations:
+    package-operator.run/phase: deploy
+data:
+  greeting: {{.config.greeting}}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: new
+  annotations:
+    package-operator.run/phase: deploy
+`
+
+func TestDiff_DiffPackage(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	pkg := &corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: corev1alpha1.PackageSpec{
+			Image: "quay.io/test/pkg:v1",
+			// Config is resolved from config sources like Package Operator does.
+			ConfigFrom: []corev1alpha1.PackageConfigSource{
+				{Kind: corev1alpha1.PackageConfigSourceKindConfigMap, Name: "settings"},
+			},
+		},
+		Status: corev1alpha1.PackageStatus{Revision: 1},
+	}
+	settings := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "test"},
+		Data:       map[string]string{"greeting": "hello"},
+	}
+	objectSet := &corev1alpha1.ObjectSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-1", Namespace: "test",
+			Labels: map[string]string{manifestsv1alpha1.PackageInstanceLabel: "test"},
+		},
+		Spec: corev1alpha1.ObjectSetSpec{
+			ObjectSetTemplateSpec: corev1alpha1.ObjectSetTemplateSpec{
+				Phases: []corev1alpha1.ObjectSetTemplatePhase{{
+					Name: "deploy",
+					Objects: []corev1alpha1.ObjectSetObject{
+						{Object: *newDiffTestConfigMap("cm", "hi")},
+						{Object: *newDiffTestConfigMap("removed", "")},
+						{Object: *newDiffTestConfigMap("released", "")},
+					},
+				}},
+			},
+		},
+		Status: corev1alpha1.ObjectSetStatus{
+			Revision: 1,
+			ControllerOf: []corev1alpha1.ControlledObjectReference{
+				{Kind: "ConfigMap", Name: "cm", Namespace: "test"},
+				{Kind: "ConfigMap", Name: "removed", Namespace: "test"},
+			},
+		},
+	}
+
+	liveCM := newDiffTestConfigMap("cm", "hi")
+	liveCM.SetNamespace("test")
+	liveCM.SetLabels(map[string]string{manifestsv1alpha1.PackageInstanceLabel: "test"})
+	liveRemoved := newDiffTestConfigMap("removed", "")
+	liveRemoved.SetNamespace("test")
+	liveReleased := newDiffTestConfigMap("released", "")
+	liveReleased.SetNamespace("test")
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(mapper).
+		WithObjects(pkg, settings, objectSet, liveCM, liveRemoved, liveReleased).
+		WithInterceptorFuncs(interceptor.Funcs{
+			// The fake client does not support server-side apply,
+			// return the applied object as the dry-run result.
+			Patch: func(
+				_ context.Context, _ client.WithWatch, obj client.Object,
+				patch client.Patch, _ ...client.PatchOption,
+			) error {
+				data, err := patch.Data(obj)
+				if err != nil {
+					return err
+				}
+				return json.Unmarshal(data, &obj.(*unstructured.Unstructured).Object)
+			},
+		}).
+		Build()
+
+	pull := func(context.Context, string, ...crane.Option) (*packages.RawPackage, error) {
+		return &packages.RawPackage{Files: packages.Files{
+			"manifest.yaml":          []byte(diffTestManifest),
+			"configmaps.yaml.gotmpl": []byte(diffTestConfigMaps),
+		}}, nil
+	}
+
+	d := NewDiff(scheme, c, WithPuller{Pull: pull})
+	res, err := d.DiffPackage(context.Background(), "quay.io/test/pkg:v2", "test", WithNamespace("test"))
+	require.NoError(t, err)
+
+	require.Len(t, res.Changed, 1)
+	assert.Equal(t, "ConfigMap test/cm", res.Changed[0].Object.String())
+	assert.Contains(t, res.Changed[0].Diff, "-  greeting: hi\n")
+	assert.Contains(t, res.Changed[0].Diff, "+  greeting: hello\n")
+	assert.NotContains(t, res.Changed[0].Diff, manifestsv1alpha1.PackageInstanceLabel)
+
+	assert.Equal(t, []string{"ConfigMap test/new"}, diffRefStrings(res.Added))
+	assert.Equal(t, []string{"ConfigMap test/released"}, diffRefStrings(res.Orphaned))
+	assert.Equal(t, []string{"ConfigMap test/removed"}, diffRefStrings(res.Deleted))
+	assert.False(t, res.Empty())
+}
+
+const diffTestClusterManifest = `apiVersion: manifests.package-operator.run/v1alpha1
+kind: PackageManifest
+metadata:
+  name: test-stub
+spec:
+  scopes:
+  - Cluster
+  phases:
+  - name: crds
+  - name: deploy
+`
+
+const diffTestNewAPIs = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: new-ns
+  annotations:
+    package-operator.run/phase: crds
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+  annotations:
+    package-operator.run/phase: crds
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: widget
+  namespace: new-ns
+  annotations:
+    package-operator.run/phase: deploy
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+  namespace: new-ns
+  annotations:
+    package-operator.run/phase: deploy
+`
+
+func TestDiff_DiffPackage_newAPIsAndNamespaces(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	pkg := &corev1alpha1.ClusterPackage{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       corev1alpha1.PackageSpec{Image: "quay.io/test/pkg:v1"},
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pkg).
+		WithInterceptorFuncs(interceptor.Funcs{
+			// The Widget API is only introduced by the CRD of the package.
+			Get: func(
+				ctx context.Context, c client.WithWatch, key client.ObjectKey,
+				obj client.Object, opts ...client.GetOption,
+			) error {
+				if gvk := obj.GetObjectKind().GroupVersionKind(); gvk.Group == "example.com" {
+					return &meta.NoKindMatchError{GroupKind: gvk.GroupKind(), SearchedVersions: []string{gvk.Version}}
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+			// Dry runs fail for objects in namespaces that do not exist yet.
+			Patch: func(
+				ctx context.Context, c client.WithWatch, obj client.Object,
+				patch client.Patch, _ ...client.PatchOption,
+			) error {
+				if ns := obj.GetNamespace(); len(ns) > 0 {
+					if err := c.Get(ctx, client.ObjectKey{Name: ns}, &corev1.Namespace{}); err != nil {
+						return apimachineryerrors.NewNotFound(corev1.Resource("namespaces"), ns)
+					}
+				}
+				data, err := patch.Data(obj)
+				if err != nil {
+					return err
+				}
+				return json.Unmarshal(data, &obj.(*unstructured.Unstructured).Object)
+			},
+		}).
+		Build()
+
+	pull := func(context.Context, string, ...crane.Option) (*packages.RawPackage, error) {
+		return &packages.RawPackage{Files: packages.Files{
+			"manifest.yaml": []byte(diffTestClusterManifest),
+			"objects.yaml":  []byte(diffTestNewAPIs),
+		}}, nil
+	}
+
+	d := NewDiff(scheme, c, WithPuller{Pull: pull})
+	res, err := d.DiffPackage(context.Background(), "quay.io/test/pkg:v2", "test")
+	require.NoError(t, err)
+
+	assert.Empty(t, res.Changed)
+	assert.Equal(t, []string{
+		"Namespace new-ns",
+		"CustomResourceDefinition.apiextensions.k8s.io widgets.example.com",
+		"Widget.example.com new-ns/widget",
+		"ConfigMap new-ns/cm",
+	}, diffRefStrings(res.Added))
+}
+
+func newDiffTestConfigMap(name, greeting string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name": name,
+		},
+	}}
+	if len(greeting) > 0 {
+		obj.Object["data"] = map[string]any{"greeting": greeting}
+	}
+	return obj
+}
+
+func diffRefStrings(refs []DiffObjectReference) []string {
+	out := make([]string, len(refs))
+	for i, ref := range refs {
+		out[i] = ref.String()
+	}
+	return out
+}
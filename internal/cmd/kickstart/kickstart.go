@@ -51,6 +51,9 @@ func (k *Kickstarter) Kickstart(
 	pkgName string,
 	inputs []string,
 	olmBundle string,
+	helmChart string,
+	helmKubeVersion string,
+	helmAPIVersions []string,
 	paramOpts []string,
 ) (string, error) {
 	folderName := pkgName
@@ -92,7 +95,19 @@ func (k *Kickstarter) Kickstart(
 		pkgName = reg.PackageName
 	}
 
-	rawPkg, res, err := packages.Kickstart(ctx, pkgName, objects, paramOpts)
+	// Import from Helm chart.
+	var kickstartOpts []packages.KickstartOption
+	if len(helmChart) > 0 {
+		objs, chart, err := packages.ImportHelmChart(ctx, helmChart,
+			packages.WithHelmKubeVersion(helmKubeVersion), packages.WithHelmAPIVersions(helmAPIVersions))
+		if err != nil {
+			return "", fmt.Errorf("import helm chart: %w", err)
+		}
+		objects = append(objects, objs...)
+		kickstartOpts = append(kickstartOpts, packages.WithHelmChart{Chart: chart})
+	}
+
+	rawPkg, res, err := packages.Kickstart(ctx, pkgName, objects, paramOpts, kickstartOpts...)
 	if err != nil {
 		return "", err
 	}
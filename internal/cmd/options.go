@@ -34,6 +34,10 @@ func (w WithComponent) ConfigureRenderPackage(c *RenderPackageConfig) {
 	c.Component = string(w)
 }
 
+func (w WithComponent) ConfigureConfigurationSource(c *ConfigurationSourceConfig) {
+	c.Component = string(w)
+}
+
 type WithDigestResolver struct{ Resolver DigestResolver }
 
 func (w WithDigestResolver) ConfigureBuild(c *BuildConfig) {
@@ -44,12 +48,30 @@ func (w WithDigestResolver) ConfigureUpdate(c *UpdateConfig) {
 	c.Resolver = w.Resolver
 }
 
+type WithEnvironmentProber struct{ Prober EnvironmentProber }
+
+func (w WithEnvironmentProber) ConfigureDiff(c *DiffConfig) {
+	c.Environment = w.Prober
+}
+
 type WithLog struct{ Log logr.Logger }
 
 func (w WithLog) ConfigureBuild(c *BuildConfig) {
 	c.Log = w.Log
 }
 
+func (w WithLog) ConfigureConfiguration(c *ConfigurationConfig) {
+	c.Log = w.Log
+}
+
+func (w WithLog) ConfigureDiff(c *DiffConfig) {
+	c.Log = w.Log
+}
+
+func (w WithLog) ConfigureTest(c *TestConfig) {
+	c.Log = w.Log
+}
+
 func (w WithLog) ConfigureTree(c *TreeConfig) {
 	c.Log = w.Log
 }
@@ -74,6 +96,14 @@ func (w WithInsecure) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
 	c.Insecure = bool(w)
 }
 
+func (w WithInsecure) ConfigureConfigurationSource(c *ConfigurationSourceConfig) {
+	c.Insecure = bool(w)
+}
+
+func (w WithInsecure) ConfigureDiffPackage(c *DiffPackageConfig) {
+	c.Insecure = bool(w)
+}
+
 func (w WithInsecure) ConfigureGenerateLockData(c *GenerateLockDataConfig) {
 	c.Insecure = bool(w)
 }
@@ -86,8 +116,18 @@ func (w WithInsecure) ConfigureValidatePackage(c *ValidatePackageConfig) {
 	c.Insecure = bool(w)
 }
 
+type WithConfigSourceNamespace string
+
+func (w WithConfigSourceNamespace) ConfigureDiffPackage(c *DiffPackageConfig) {
+	c.ConfigSourceNamespace = string(w)
+}
+
 type WithNamespace string
 
+func (w WithNamespace) ConfigureDiffPackage(c *DiffPackageConfig) {
+	c.Namespace = string(w)
+}
+
 func (w WithNamespace) ConfigureGetPackage(c *GetPackageConfig) {
 	c.Namespace = string(w)
 }
@@ -110,6 +150,14 @@ func (w WithPackageLoader) ConfigureUpdate(c *UpdateConfig) {
 
 type WithPuller struct{ Pull PullFn }
 
+func (w WithPuller) ConfigureConfiguration(c *ConfigurationConfig) {
+	c.Pull = w.Pull
+}
+
+func (w WithPuller) ConfigureDiff(c *DiffConfig) {
+	c.Pull = w.Pull
+}
+
 func (w WithPuller) ConfigureValidate(c *ValidateConfig) {
 	c.Pull = w.Pull
 }
@@ -132,8 +180,20 @@ func (w WithRemoteReference) ConfigureValidatePackage(c *ValidatePackageConfig)
 	c.RemoteReference = string(w)
 }
 
+type WithSigningKey string
+
+func (w WithSigningKey) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
+	c.SigningKeyPath = string(w)
+}
+
 type WithTags []string
 
 func (w WithTags) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
 	c.Tags = append(c.Tags, w...)
 }
+
+type WithUpdateFixtures bool
+
+func (w WithUpdateFixtures) ConfigureTestPackage(c *TestPackageConfig) {
+	c.UpdateFixtures = bool(w)
+}
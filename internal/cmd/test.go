@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"package-operator.run/internal/packages"
+)
+
+// ErrTestsFailed is returned when at least one template test or kubeconform check failed.
+var ErrTestsFailed = errors.New("tests failed")
+
+func NewTest(opts ...TestOption) *Test {
+	var cfg TestConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Test{
+		cfg: cfg,
+	}
+}
+
+// Test runs the template tests and kubeconform checks of packages.
+type Test struct {
+	cfg TestConfig
+}
+
+type TestConfig struct {
+	Log logr.Logger
+}
+
+func (c *TestConfig) Option(opts ...TestOption) {
+	for _, opt := range opts {
+		opt.ConfigureTest(c)
+	}
+}
+
+func (c *TestConfig) Default() {
+	if c.Log.GetSink() == nil {
+		c.Log = logr.Discard()
+	}
+}
+
+type TestOption interface {
+	ConfigureTest(*TestConfig)
+}
+
+// TestPackage runs all template test cases and kubeconform checks of the package source at path.
+// Failing checks are reported in the returned report, errors are only returned
+// if the package could not be loaded or the checks could not be run.
+func (t *Test) TestPackage(ctx context.Context, path string, opts ...TestPackageOption) (*TestReport, error) {
+	var cfg TestPackageConfig
+
+	cfg.Option(opts...)
+
+	t.cfg.Log.Info("loading source from disk", "path", path)
+
+	rawPkg, err := getPackageFromPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := packages.DefaultStructuralLoader.Load(ctx, rawPkg)
+	if err != nil {
+		return nil, fmt.Errorf("loading package from files: %w", err)
+	}
+
+	var testOpts []packages.TemplateTestValidatorOption
+	if cfg.UpdateFixtures {
+		testOpts = append(testOpts, packages.WithUpdateFixtures(true))
+	}
+
+	results, err := packages.NewTemplateTestValidator(path, testOpts...).
+		RunTests(logr.NewContext(ctx, t.cfg.Log), pkg)
+	if err != nil {
+		return nil, fmt.Errorf("running tests: %w", err)
+	}
+
+	return &TestReport{
+		Package: pkg.Manifest.Name,
+		Results: results,
+	}, nil
+}
+
+type TestPackageConfig struct {
+	UpdateFixtures bool
+}
+
+func (c *TestPackageConfig) Option(opts ...TestPackageOption) {
+	for _, opt := range opts {
+		opt.ConfigureTestPackage(c)
+	}
+}
+
+type TestPackageOption interface {
+	ConfigureTestPackage(*TestPackageConfig)
+}
+
+// TestReport holds the results of all checks run for a package.
+type TestReport struct {
+	// Name of the tested package.
+	Package string
+	Results []packages.TemplateTestResult
+}
+
+// Failed returns the number of failed checks.
+func (r *TestReport) Failed() int {
+	var failed int
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// Duration returns the time spent running all checks.
+func (r *TestReport) Duration() time.Duration {
+	var d time.Duration
+	for _, res := range r.Results {
+		d += res.Duration
+	}
+	return d
+}
+
+const (
+	ansiReset = "\033[0m"
+	ansiRed   = "\033[31m"
+	ansiGreen = "\033[32m"
+	ansiCyan  = "\033[36m"
+)
+
+// WriteText writes a human readable pass/fail line per check to w,
+// followed by the failure details and a summary.
+// If color is true, status and diffs are highlighted using ANSI escape codes.
+func (r *TestReport) WriteText(w io.Writer, color bool) error {
+	colorize := func(code, s string) string {
+		if !color {
+			return s
+		}
+		return code + s + ansiReset
+	}
+
+	var b strings.Builder
+	for _, res := range r.Results {
+		status := colorize(ansiGreen, "PASS")
+		if res.Err != nil {
+			status = colorize(ansiRed, "FAIL")
+		}
+		name := testResultName(res)
+		if len(res.Component) > 0 {
+			name += fmt.Sprintf(" [%s]", res.Component)
+		}
+		fmt.Fprintf(&b, "%s %s (%s)\n", status, name, res.Duration.Round(time.Millisecond))
+
+		if res.Err == nil {
+			continue
+		}
+		for _, line := range strings.Split(res.Err.Error(), "\n") {
+			switch {
+			case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			case strings.HasPrefix(line, "+"):
+				line = colorize(ansiGreen, line)
+			case strings.HasPrefix(line, "-"):
+				line = colorize(ansiRed, line)
+			case strings.HasPrefix(line, "@@"):
+				line = colorize(ansiCyan, line)
+			}
+			fmt.Fprintf(&b, "    %s\n", line)
+		}
+	}
+
+	failed := r.Failed()
+	fmt.Fprintf(&b, "\n%d passed, %d failed\n", len(r.Results)-failed, failed)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJUnit writes the report as JUnit XML to w.
+func (r *TestReport) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Name:     r.Package,
+		Tests:    len(r.Results),
+		Failures: r.Failed(),
+		Time:     junitSeconds(r.Duration()),
+	}
+	for _, res := range r.Results {
+		className := r.Package
+		if len(res.Component) > 0 {
+			className += "." + res.Component
+		}
+
+		tc := junitTestCase{
+			Name:      testResultName(res),
+			ClassName: className,
+			Time:      junitSeconds(res.Duration),
+		}
+		if res.Err != nil {
+			msg, _, _ := strings.Cut(res.Err.Error(), "\n")
+			tc.Failure = &junitFailure{
+				Message:  msg,
+				Type:     string(res.Kind),
+				Contents: res.Err.Error(),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func testResultName(res packages.TemplateTestResult) string {
+	return strings.ToLower(string(res.Kind)) + "/" + res.Name
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message  string `xml:"message,attr"`
+	Type     string `xml:"type,attr"`
+	Contents string `xml:",chardata"`
+}
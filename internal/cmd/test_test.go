@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages"
+)
+
+var errTestFixtureMismatch = errors.New(
+	"file mismatch\n--- FIXTURE/a.yaml\n+++ ACTUAL/a.yaml\n@@ -1 +1 @@\n-a: 1\n+a: 2")
+
+func newTestReport() *TestReport {
+	return &TestReport{
+		Package: "my-pkg",
+		Results: []packages.TemplateTestResult{
+			{
+				Kind:     packages.TemplateTestKindTemplate,
+				Name:     "default",
+				Duration: 10 * time.Millisecond,
+			},
+			{
+				Kind:      packages.TemplateTestKindTemplate,
+				Name:      "changed",
+				Component: "backend",
+				Duration:  20 * time.Millisecond,
+				Err:       errTestFixtureMismatch,
+			},
+			{
+				Kind:     packages.TemplateTestKindKubeconform,
+				Name:     "deployment.yaml",
+				Duration: 5 * time.Millisecond,
+			},
+		},
+	}
+}
+
+func TestTestReport_WriteText(t *testing.T) {
+	t.Parallel()
+
+	r := newTestReport()
+	assert.Equal(t, 1, r.Failed())
+	assert.Equal(t, 35*time.Millisecond, r.Duration())
+
+	var out bytes.Buffer
+	require.NoError(t, r.WriteText(&out, false))
+	assert.Equal(t, `PASS template/default (10ms)
+FAIL template/changed [backend] (20ms)
+    file mismatch
+    --- FIXTURE/a.yaml
+    +++ ACTUAL/a.yaml
+    @@ -1 +1 @@
+    -a: 1
+    +a: 2
+PASS kubeconform/deployment.yaml (5ms)
+
+2 passed, 1 failed
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, r.WriteText(&out, true))
+	assert.Contains(t, out.String(), ansiRed+"FAIL"+ansiReset)
+	assert.Contains(t, out.String(), ansiGreen+"+a: 2"+ansiReset)
+	assert.Contains(t, out.String(), ansiRed+"-a: 1"+ansiReset)
+	assert.Contains(t, out.String(), ansiCyan+"@@ -1 +1 @@"+ansiReset)
+	assert.Contains(t, out.String(), "    --- FIXTURE/a.yaml\n")
+}
+
+func TestTestReport_WriteJUnit(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	require.NoError(t, newTestReport().WriteJUnit(&out))
+
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &suites))
+	require.Len(t, suites.Suites, 1)
+
+	suite := suites.Suites[0]
+	assert.Equal(t, "my-pkg", suite.Name)
+	assert.Equal(t, 3, suite.Tests)
+	assert.Equal(t, 1, suite.Failures)
+	assert.Equal(t, "0.035", suite.Time)
+	require.Len(t, suite.TestCases, 3)
+
+	assert.Equal(t, "template/default", suite.TestCases[0].Name)
+	assert.Equal(t, "my-pkg", suite.TestCases[0].ClassName)
+	assert.Nil(t, suite.TestCases[0].Failure)
+
+	failed := suite.TestCases[1]
+	assert.Equal(t, "my-pkg.backend", failed.ClassName)
+	require.NotNil(t, failed.Failure)
+	assert.Equal(t, "file mismatch", failed.Failure.Message)
+	assert.Equal(t, errTestFixtureMismatch.Error(), failed.Failure.Contents)
+}
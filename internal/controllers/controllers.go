@@ -124,6 +124,32 @@ func GetControllerOf(
 	return controllerOf, nil
 }
 
+// Reports objects that would be adopted if the adoption policy was not in dry run mode
+// via the AdoptionDryRun condition.
+func SetAdoptionDryRunCondition(
+	conditions *[]metav1.Condition, generation int64,
+	wouldAdopt []corev1alpha1.ControlledObjectReference,
+) {
+	if len(wouldAdopt) == 0 {
+		meta.RemoveStatusCondition(conditions, corev1alpha1.ObjectSetAdoptionDryRun)
+		return
+	}
+
+	refs := make([]string, len(wouldAdopt))
+	for i, ref := range wouldAdopt {
+		refs[i] = fmt.Sprintf("%s %s", ref.Kind, client.ObjectKey{
+			Name: ref.Name, Namespace: ref.Namespace,
+		})
+	}
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               corev1alpha1.ObjectSetAdoptionDryRun,
+		Status:             metav1.ConditionTrue,
+		Reason:             "WouldAdopt",
+		Message:            "Objects would be adopted: " + strings.Join(refs, ", "),
+		ObservedGeneration: generation,
+	})
+}
+
 func IsMappedCondition(cond metav1.Condition) bool {
 	return strings.Contains(cond.Type, "/")
 }
@@ -0,0 +1,274 @@
+package controllers
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/constants"
+)
+
+// Metadata fields compared for drift.
+// Everything else in metadata is either set by the API server or handled by owner handling.
+var driftMetadataFields = []string{"labels", "annotations"}
+
+// Returns the paths of all fields specified in desiredObj that differ in actualObj.
+// Only fields owned by the Package Operator field manager are compared,
+// fields taken over by other field managers or defaulted by the API server are ignored.
+// When the ownership of fields is unknown, all fields specified in desiredObj are compared.
+// Status is ignored, as it is never patched.
+func detectDrift(desiredObj, actualObj *unstructured.Unstructured) []string {
+	desired := map[string]any{}
+	for key, value := range desiredObj.Object {
+		switch key {
+		case "status":
+			continue
+
+		case "metadata":
+			desiredMeta, _ := value.(map[string]any)
+			meta := map[string]any{}
+			for _, field := range driftMetadataFields {
+				if v, ok := desiredMeta[field]; ok {
+					meta[field] = v
+				}
+			}
+			value = meta
+		}
+		desired[key] = value
+	}
+
+	var drift []string
+	if owned := ownedFields(actualObj); owned != nil {
+		drift = diffOwnedFields("", owned, desired, actualObj.Object)
+	} else {
+		drift = diffFields("", desired, actualObj.Object)
+	}
+	sort.Strings(drift)
+	return drift
+}
+
+// Returns the fields managed by Package Operator
+// or nil if actualObj does not record field ownership for Package Operator.
+func ownedFields(actualObj *unstructured.Unstructured) *fieldpath.Set {
+	var owned *fieldpath.Set
+	for _, entry := range actualObj.GetManagedFields() {
+		if entry.Manager != constants.FieldOwner || entry.FieldsV1 == nil {
+			continue
+		}
+		set := &fieldpath.Set{}
+		if err := set.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			// Fall back to comparing all fields.
+			return nil
+		}
+		if owned == nil {
+			owned = set
+			continue
+		}
+		owned = owned.Union(set)
+	}
+	return owned
+}
+
+// Compares desired with actual, only taking fields into account that are owned and present in desired.
+// Owned leaf fields are compared using diffFields.
+func diffOwnedFields(path string, owned *fieldpath.Set, desired, actual any) []string {
+	switch d := desired.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return []string{path}
+		}
+		var drift []string
+		for key, value := range d {
+			pe := fieldpath.PathElement{FieldName: &key}
+			if child, ok := owned.Children.Get(pe); ok {
+				drift = append(drift, diffOwnedFields(fieldPath(path, key), child, value, a[key])...)
+				continue
+			}
+			if owned.Members.Has(pe) {
+				drift = append(drift, diffFields(fieldPath(path, key), value, a[key])...)
+			}
+		}
+		return drift
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			return []string{path}
+		}
+		var drift []string
+		diffItem := func(pe fieldpath.PathElement, child *fieldpath.Set) {
+			i, desiredItem, ok := findListItem(pe, d)
+			if !ok {
+				// No longer desired, ownership is released with the next patch.
+				return
+			}
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			_, actualItem, ok := findListItem(pe, a)
+			switch {
+			case !ok:
+				drift = append(drift, itemPath)
+			case child != nil:
+				drift = append(drift, diffOwnedFields(itemPath, child, desiredItem, actualItem)...)
+			case pe.Index != nil:
+				drift = append(drift, diffFields(itemPath, desiredItem, actualItem)...)
+			}
+		}
+		owned.Children.Iterate(func(pe fieldpath.PathElement) {
+			child, _ := owned.Children.Get(pe)
+			diffItem(pe, child)
+		})
+		owned.Members.Iterate(func(pe fieldpath.PathElement) {
+			if _, ok := owned.Children.Get(pe); !ok {
+				diffItem(pe, nil)
+			}
+		})
+		return drift
+	}
+
+	return diffFields(path, desired, actual)
+}
+
+// Returns the list item the given path element points to.
+func findListItem(pe fieldpath.PathElement, list []any) (int, any, bool) {
+	for i, item := range list {
+		switch {
+		case pe.Index != nil:
+			if *pe.Index == i {
+				return i, item, true
+			}
+
+		case pe.Value != nil:
+			if value.Equals(*pe.Value, value.NewValueInterface(item)) {
+				return i, item, true
+			}
+
+		case pe.Key != nil:
+			m, ok := item.(map[string]any)
+			if ok && listItemHasKey(*pe.Key, m) {
+				return i, item, true
+			}
+		}
+	}
+	return 0, nil, false
+}
+
+func listItemHasKey(key value.FieldList, item map[string]any) bool {
+	for _, field := range key {
+		v, ok := item[field.Name]
+		if !ok || !value.Equals(field.Value, value.NewValueInterface(v)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Recursively compares desired with actual, only taking fields present in desired into account.
+// Lists are compared element by element and must have the same length.
+func diffFields(path string, desired, actual any) []string {
+	switch d := desired.(type) {
+	case nil:
+		return nil
+
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return []string{path}
+		}
+		var drift []string
+		for key, value := range d {
+			drift = append(drift, diffFields(fieldPath(path, key), value, a[key])...)
+		}
+		return drift
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(d) {
+			return []string{path}
+		}
+		var drift []string
+		for i := range d {
+			drift = append(drift, diffFields(fmt.Sprintf("%s[%d]", path, i), d[i], a[i])...)
+		}
+		return drift
+	}
+
+	if !driftValueEqual(desired, actual) {
+		return []string{path}
+	}
+	return nil
+}
+
+func fieldPath(path, key string) string {
+	if len(path) == 0 {
+		return key
+	}
+	if strings.Contains(key, ".") {
+		// e.g. labels like app.kubernetes.io/name
+		return fmt.Sprintf("%s[%s]", path, key)
+	}
+	return path + "." + key
+}
+
+// Compares scalar values, normalizing numbers and quantities,
+// because the API server may return them in a different representation
+// e.g. int64 vs. float64 or "1000m" vs. "1".
+func driftValueEqual(desired, actual any) bool {
+	if reflect.DeepEqual(desired, actual) {
+		return true
+	}
+
+	desiredQuantity, ok := driftQuantity(desired)
+	if !ok {
+		return false
+	}
+	actualQuantity, ok := driftQuantity(actual)
+	if !ok {
+		return false
+	}
+	return desiredQuantity.Cmp(actualQuantity) == 0
+}
+
+func driftQuantity(v any) (resource.Quantity, bool) {
+	switch v.(type) {
+	case string, int64, float64:
+	default:
+		return resource.Quantity{}, false
+	}
+	q, err := resource.ParseQuantity(fmt.Sprint(v))
+	if err != nil {
+		return resource.Quantity{}, false
+	}
+	return q, true
+}
+
+// Returns true if drift should not be corrected for the given object.
+func isObserveOnly(obj *unstructured.Unstructured) bool {
+	return obj.GetLabels()[corev1alpha1.ObjectSetObserveOnlyLabel] == "True"
+}
+
+func driftedObject(obj *unstructured.Unstructured, fields []string) corev1alpha1.DriftedObject {
+	return corev1alpha1.DriftedObject{
+		Object:      controlledObjectReference(obj),
+		Fields:      fields,
+		ObserveOnly: isObserveOnly(obj),
+	}
+}
+
+func controlledObjectReference(obj *unstructured.Unstructured) corev1alpha1.ControlledObjectReference {
+	gvk := obj.GroupVersionKind()
+	return corev1alpha1.ControlledObjectReference{
+		Kind:      gvk.Kind,
+		Group:     gvk.Group,
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+}
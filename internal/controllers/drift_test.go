@@ -0,0 +1,261 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDetectDrift(t *testing.T) {
+	t.Parallel()
+
+	desired := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name": "test",
+			"labels": map[string]any{
+				"app.kubernetes.io/name": "test",
+			},
+		},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"name":  "test",
+							"image": "quay.io/test:v1",
+							"resources": map[string]any{
+								"limits": map[string]any{"cpu": "1000m"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"status": map[string]any{"replicas": int64(1)},
+	}
+
+	tests := []struct {
+		name   string
+		actual map[string]any
+		drift  []string
+	}{
+		{
+			name: "no drift",
+			actual: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]any{
+					"name":            "test",
+					"resourceVersion": "123",
+					"labels": map[string]any{
+						"app.kubernetes.io/name": "test",
+						"other":                  "label",
+					},
+				},
+				"spec": map[string]any{
+					// defaulted and normalized by the API server.
+					"replicas":             float64(1),
+					"revisionHistoryLimit": int64(10),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{
+									"name":            "test",
+									"image":           "quay.io/test:v1",
+									"imagePullPolicy": "IfNotPresent",
+									"resources": map[string]any{
+										"limits": map[string]any{"cpu": "1"},
+									},
+								},
+							},
+						},
+					},
+				},
+				"status": map[string]any{"replicas": int64(3)},
+			},
+		},
+		{
+			name: "drift",
+			actual: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]any{
+					"name": "test",
+				},
+				"spec": map[string]any{
+					"replicas": int64(3),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{
+									"name":  "test",
+									"image": "quay.io/test:hotfix",
+									"resources": map[string]any{
+										"limits": map[string]any{"cpu": "2"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			drift: []string{
+				"metadata.labels",
+				"spec.replicas",
+				"spec.template.spec.containers[0].image",
+				"spec.template.spec.containers[0].resources.limits.cpu",
+			},
+		},
+		{
+			name: "list length",
+			actual: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]any{
+					"name": "test",
+					"labels": map[string]any{
+						"app.kubernetes.io/name": "other",
+					},
+				},
+				"spec": map[string]any{
+					"replicas": int64(1),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{},
+						},
+					},
+				},
+			},
+			drift: []string{
+				"metadata.labels[app.kubernetes.io/name]",
+				"spec.template.spec.containers",
+			},
+		},
+	}
+
+	// spec.replicas was taken over by another field manager.
+	managedFields := []any{
+		map[string]any{
+			"manager":    "package-operator",
+			"operation":  "Apply",
+			"fieldsType": "FieldsV1",
+			"fieldsV1": map[string]any{
+				"f:metadata": map[string]any{
+					"f:labels": map[string]any{"f:app.kubernetes.io/name": map[string]any{}},
+				},
+				"f:spec": map[string]any{
+					"f:template": map[string]any{
+						"f:spec": map[string]any{
+							"f:containers": map[string]any{
+								`k:{"name":"test"}`: map[string]any{
+									".":       map[string]any{},
+									"f:name":  map[string]any{},
+									"f:image": map[string]any{},
+									"f:resources": map[string]any{
+										"f:limits": map[string]any{"f:cpu": map[string]any{}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		map[string]any{
+			"manager":    "hpa",
+			"operation":  "Apply",
+			"fieldsType": "FieldsV1",
+			"fieldsV1": map[string]any{
+				"f:spec": map[string]any{"f:replicas": map[string]any{}},
+			},
+		},
+	}
+	tests = append(tests, []struct {
+		name   string
+		actual map[string]any
+		drift  []string
+	}{
+		{
+			name: "owned fields only",
+			actual: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]any{
+					"name":          "test",
+					"managedFields": managedFields,
+					"labels": map[string]any{
+						"app.kubernetes.io/name": "test",
+					},
+				},
+				"spec": map[string]any{
+					"replicas": int64(3),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								// injected by another controller.
+								map[string]any{
+									"name":  "sidecar",
+									"image": "quay.io/sidecar:v1",
+								},
+								map[string]any{
+									"name":  "test",
+									"image": "quay.io/test:hotfix",
+									"resources": map[string]any{
+										"limits": map[string]any{"cpu": "1"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			drift: []string{
+				"spec.template.spec.containers[0].image",
+			},
+		},
+		{
+			name: "owned list item missing",
+			actual: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]any{
+					"name":          "test",
+					"managedFields": managedFields,
+				},
+				"spec": map[string]any{
+					"replicas": int64(1),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{
+									"name":  "sidecar",
+									"image": "quay.io/sidecar:v1",
+								},
+							},
+						},
+					},
+				},
+			},
+			drift: []string{
+				"metadata.labels",
+				"spec.template.spec.containers[0]",
+			},
+		},
+	}...)
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			drift := detectDrift(
+				&unstructured.Unstructured{Object: desired},
+				&unstructured.Unstructured{Object: test.actual})
+			assert.Equal(t, test.drift, drift)
+		})
+	}
+}
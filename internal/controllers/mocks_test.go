@@ -54,6 +54,12 @@ func (m *phaseObjectOwnerMock) GetConditions() *[]metav1.Condition {
 	return args.Get(0).(*[]metav1.Condition)
 }
 
+func (m *phaseObjectOwnerMock) GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy {
+	args := m.Called()
+	policy, _ := args.Get(0).(*corev1alpha1.AdoptionPolicy)
+	return policy
+}
+
 type dynamicCacheMock struct {
 	testutil.CtrlClient
 }
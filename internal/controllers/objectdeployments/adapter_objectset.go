@@ -27,6 +27,7 @@ type genericObjectSet interface {
 	GetGeneration() int64
 	IsStatusPaused() bool
 	SetPaused()
+	SetActive()
 	IsSpecPaused() bool
 	IsAvailable() bool
 }
@@ -132,6 +133,10 @@ func (a *GenericObjectSet) SetPaused() {
 	a.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStatePaused
 }
 
+func (a *GenericObjectSet) SetActive() {
+	a.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStateActive
+}
+
 func (a *GenericObjectSet) IsSpecPaused() bool {
 	return a.Spec.LifecycleState == corev1alpha1.ObjectSetLifecycleStatePaused
 }
@@ -271,6 +276,10 @@ func (a *GenericClusterObjectSet) SetPaused() {
 	a.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStatePaused
 }
 
+func (a *GenericClusterObjectSet) SetActive() {
+	a.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStateActive
+}
+
 func (a *GenericClusterObjectSet) IsSpecPaused() bool {
 	return a.Spec.LifecycleState == corev1alpha1.ObjectSetLifecycleStatePaused
 }
@@ -8,6 +8,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 )
 
 const defaultRevisionLimit int32 = 10
@@ -21,13 +23,16 @@ func (a *archiveReconciler) Reconcile(ctx context.Context,
 	prevObjectSets []genericObjectSet,
 	objectDeployment objectDeploymentAccessor,
 ) (ctrl.Result, error) {
-	if currentObjectSet == nil {
+	if currentObjectSet == nil || currentObjectSet.IsArchived() ||
+		isRollingBack(currentObjectSet, prevObjectSets) {
+		// Nothing to do without current revision or when it is (being) rolled back.
 		return ctrl.Result{}, nil
 	}
 
 	objsetsEligibleForArchival, err := a.objectSetsToBeArchived(
 		ctx,
 		append(prevObjectSets, currentObjectSet),
+		objectDeployment.GetStrategy(),
 	)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("errored when trying to compute objects for archival: %w", err)
@@ -70,6 +75,7 @@ func (a *archiveReconciler) markObjectSetsForArchival(ctx context.Context,
 func (a *archiveReconciler) objectSetsToBeArchived(
 	ctx context.Context,
 	allObjectSets []genericObjectSet,
+	strategy *corev1alpha1.ObjectDeploymentStrategy,
 ) ([]genericObjectSet, error) {
 	// Sort all ObjectSets by their ascending revision number.
 	sort.Sort(objectSetsByRevisionAscending(allObjectSets))
@@ -78,9 +84,9 @@ func (a *archiveReconciler) objectSetsToBeArchived(
 		currentLatestRevision := allObjectSets[j]
 
 		// Case 1:
-		// currentRevision is "Available",
+		// currentRevision is "Available" (and promoted, if the deployment has a strategy),
 		// so all previous revisions can be archived.
-		if currentLatestRevision.IsAvailable() {
+		if currentLatestRevision.IsAvailable() && isPromoted(currentLatestRevision, strategy) {
 			prevRevisionsToArchive, err := a.archiveAllLaterRevisions(ctx, currentLatestRevision, allObjectSets[:j])
 			if err != nil {
 				return []genericObjectSet{}, err
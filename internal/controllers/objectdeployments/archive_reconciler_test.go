@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/testutil"
 )
 
@@ -70,12 +71,14 @@ func Test_ArchivalReconciler(t *testing.T) {
 
 		arch1.On("IsArchived").Return(false)
 		arch2.On("IsArchived").Return(false)
+		latestAvailable.On("IsArchived").Return(false)
 		latestAvailable.On("IsAvailable").Return(true)
 		prevs := []genericObjectSet{
 			arch1,
 			arch2,
 		}
 		objectDeployment := &genericObjectDeploymentMock{}
+		objectDeployment.On("GetStrategy").Return((*corev1alpha1.ObjectDeploymentStrategy)(nil))
 		res, err := r.Reconcile(ctx, latestAvailable, prevs, objectDeployment)
 		require.NoError(t, err)
 		assert.True(t, res.IsZero(), "unexpected requeue")
@@ -117,6 +120,7 @@ func Test_ArchivalReconciler(t *testing.T) {
 			objectDeployment := &genericObjectDeploymentMock{}
 			revisionLimit := int32(10)
 			objectDeployment.On("GetRevisionHistoryLimit").Return(&revisionLimit)
+			objectDeployment.On("GetStrategy").Return((*corev1alpha1.ObjectDeploymentStrategy)(nil))
 
 			// Setup revisions
 
@@ -260,6 +264,7 @@ func testPauseAndArchivalIntermediateRevisions(t *testing.T, alreadyPaused bool)
 	objectDeployment := &genericObjectDeploymentMock{}
 	revisionLimit := int32(10)
 	objectDeployment.On("GetRevisionHistoryLimit").Return(&revisionLimit)
+	objectDeployment.On("GetStrategy").Return((*corev1alpha1.ObjectDeploymentStrategy)(nil))
 
 	// Setup client
 	client := testutil.NewClient()
@@ -381,6 +386,7 @@ func testPauseAndArchivalWhenLatestIsAvailable(t *testing.T, alreadyPaused bool)
 	objectDeployment := &genericObjectDeploymentMock{}
 	revisionLimit := int32(10)
 	objectDeployment.On("GetRevisionHistoryLimit").Return(&revisionLimit)
+	objectDeployment.On("GetStrategy").Return((*corev1alpha1.ObjectDeploymentStrategy)(nil))
 
 	// Setup client
 	client := testutil.NewClient()
@@ -454,6 +460,7 @@ func testDeleteArchive(t *testing.T) {
 	objectDeployment := &genericObjectDeploymentMock{}
 	revisionLimit := int32(3)
 	objectDeployment.On("GetRevisionHistoryLimit").Return(&revisionLimit)
+	objectDeployment.On("GetStrategy").Return((*corev1alpha1.ObjectDeploymentStrategy)(nil))
 
 	// Setup client
 	client := testutil.NewClient()
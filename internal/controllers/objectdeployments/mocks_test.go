@@ -50,6 +50,10 @@ func (o *genericObjectSetMock) SetPaused() {
 	o.Called()
 }
 
+func (o *genericObjectSetMock) SetActive() {
+	o.Called()
+}
+
 func (o *genericObjectSetMock) IsAvailable() bool {
 	args := o.Called()
 	return args.Bool(0)
@@ -158,6 +162,16 @@ func (o *genericObjectDeploymentMock) GetSelector() metav1.LabelSelector {
 	return args.Get(0).(metav1.LabelSelector)
 }
 
+func (o *genericObjectDeploymentMock) IsPaused() bool {
+	args := o.Called()
+	return args.Bool(0)
+}
+
+func (o *genericObjectDeploymentMock) GetStrategy() *corev1alpha1.ObjectDeploymentStrategy {
+	args := o.Called()
+	return args.Get(0).(*corev1alpha1.ObjectDeploymentStrategy)
+}
+
 func (o *genericObjectDeploymentMock) UpdatePhase() {
 	o.Called()
 }
@@ -101,9 +101,13 @@ func (r *newRevisionReconciler) newObjectSetFromDeployment(
 	newObjectSetClientObj.SetNamespace(deploymentClientObj.GetNamespace())
 	newObjectSetClientObj.SetAnnotations(deploymentClientObj.GetAnnotations())
 	newObjectSetClientObj.SetLabels(objectDeployment.GetObjectSetTemplate().Metadata.Labels)
-	newObjectSet.SetTemplateSpec(
-		objectDeployment.GetObjectSetTemplate().Spec,
-	)
+	templateSpec := objectDeployment.GetObjectSetTemplate().Spec
+	if strategy := objectDeployment.GetStrategy(); strategy != nil &&
+		strategy.ArchiveDelaySeconds > templateSpec.SuccessDelaySeconds {
+		// Previous revisions are archived once the new revision Succeeded.
+		templateSpec.SuccessDelaySeconds = strategy.ArchiveDelaySeconds
+	}
+	newObjectSet.SetTemplateSpec(templateSpec)
 	newObjectSet.SetPreviousRevisions(prevObjectSets)
 
 	if newObjectSetClientObj.GetLabels() == nil {
@@ -229,6 +229,40 @@ func Test_newRevisionReconciler_createsObjectSet(t *testing.T) {
 	}
 }
 
+func Test_newRevisionReconciler_archiveDelay(t *testing.T) {
+	t.Parallel()
+
+	log := testr.New(t)
+	ctx := logr.NewContext(context.Background(), log)
+	clientMock := testutil.NewClient()
+	deploymentController := NewObjectDeploymentController(clientMock, log, testScheme)
+	r := newRevisionReconciler{
+		client:       clientMock,
+		newObjectSet: deploymentController.newObjectSet,
+		scheme:       testScheme,
+	}
+
+	objectDeployment := adapters.NewObjectDeployment(testScheme)
+	objectDeployment.ClientObject().SetName("test")
+	objectDeployment.ClientObject().SetNamespace("test")
+	objectDeployment.SetTemplateSpec(corev1alpha1.ObjectSetTemplateSpec{
+		Phases:              []corev1alpha1.ObjectSetTemplatePhase{{}},
+		SuccessDelaySeconds: 10,
+	})
+	objectDeployment.SetStrategy(&corev1alpha1.ObjectDeploymentStrategy{ArchiveDelaySeconds: 60})
+	objectDeployment.SetStatusTemplateHash("abc")
+
+	clientMock.On("Create", mock.Anything, mock.Anything, []client.CreateOption(nil)).Return(nil)
+
+	res, err := r.Reconcile(ctx, nil, nil, objectDeployment)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+
+	clientMock.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(item any) bool {
+		return item.(*corev1alpha1.ObjectSet).Spec.SuccessDelaySeconds == 60
+	}), []client.CreateOption(nil))
+}
+
 func requireObject(t *testing.T,
 	obj *corev1alpha1.ObjectSet,
 	expectedHash string,
@@ -20,6 +20,8 @@ const (
 	ObjectSetHashAnnotation = "package-operator.run/hash"
 	// Used to filter ObjectSets by their owning ObjectDeployment.
 	ObjectSetObjectDeploymentLabel = "package-operator.run/object-deployment"
+	// Marks ObjectSets paused because their ObjectDeployment is paused.
+	ObjectSetPausedByDeploymentAnnotation = "package-operator.run/paused-by-deployment"
 )
 
 type reconciler interface {
@@ -60,6 +62,10 @@ func newGenericObjectDeploymentController(
 		&hashReconciler{
 			client: c,
 		},
+		&pauseReconciler{
+			client:                      c,
+			listObjectSetsForDeployment: controller.listObjectSetsByRevision,
+		},
 		&objectSetReconciler{
 			client:                      c,
 			listObjectSetsForDeployment: controller.listObjectSetsByRevision,
@@ -74,6 +80,11 @@ func newGenericObjectDeploymentController(
 				},
 			},
 		},
+		&rollbackReconciler{
+			client:                      c,
+			listObjectSetsForDeployment: controller.listObjectSetsByRevision,
+			clock:                       defaultClock{},
+		},
 	}
 
 	return controller
@@ -45,37 +45,20 @@ func (o *objectSetReconciler) Reconcile(
 		}
 	}
 
-	// objectSets is already sorted ascending by .status.revision
-	// check if the latest revision is up-to-date, by comparing their hash.
-	var (
-		currentObjectSet genericObjectSet
-		prevObjectSets   []genericObjectSet
-	)
-	if len(objectSets) > 0 {
-		maybeCurrentObjectSet := objectSets[len(objectSets)-1]
-		annotations := maybeCurrentObjectSet.ClientObject().GetAnnotations()
-		if annotations != nil {
-			if hash, ok := annotations[ObjectSetHashAnnotation]; ok &&
-				hash == objectDeployment.GetStatusTemplateHash() {
-				currentObjectSet = maybeCurrentObjectSet
-				prevObjectSets = objectSets[0 : len(objectSets)-1] // previous is everything excluding current
-			}
-		}
-	}
-	if currentObjectSet == nil {
-		// all ObjectSets are outdated.
-		prevObjectSets = objectSets
-	}
+	currentObjectSet, prevObjectSets := splitCurrentObjectSet(objectSets, objectDeployment.GetStatusTemplateHash())
 
 	var (
 		res              ctrl.Result
 		subReconcilerErr error
 	)
 
-	for _, reconciler := range o.reconcilers {
-		res, subReconcilerErr = reconciler.Reconcile(ctx, currentObjectSet, prevObjectSets, objectDeployment)
-		if subReconcilerErr != nil || !res.IsZero() {
-			break
+	// Paused deployments neither roll out new revisions nor archive old ones.
+	if !objectDeployment.IsPaused() {
+		for _, reconciler := range o.reconcilers {
+			res, subReconcilerErr = reconciler.Reconcile(ctx, currentObjectSet, prevObjectSets, objectDeployment)
+			if subReconcilerErr != nil || !res.IsZero() {
+				break
+			}
 		}
 	}
 
@@ -86,6 +69,28 @@ func (o *objectSetReconciler) Reconcile(
 	return ctrl.Result{}, nil
 }
 
+// splitCurrentObjectSet returns the ObjectSet matching the current template hash
+// and all previous ObjectSets.
+func splitCurrentObjectSet(
+	objectSets []genericObjectSet, templateHash string,
+) (currentObjectSet genericObjectSet, prevObjectSets []genericObjectSet) {
+	// objectSets is already sorted ascending by .status.revision
+	// check if the latest revision is up-to-date, by comparing their hash.
+	if len(objectSets) > 0 {
+		maybeCurrentObjectSet := objectSets[len(objectSets)-1]
+		annotations := maybeCurrentObjectSet.ClientObject().GetAnnotations()
+		if annotations != nil {
+			if hash, ok := annotations[ObjectSetHashAnnotation]; ok &&
+				hash == templateHash {
+				// previous is everything excluding current
+				return maybeCurrentObjectSet, objectSets[0 : len(objectSets)-1]
+			}
+		}
+	}
+	// all ObjectSets are outdated.
+	return nil, objectSets
+}
+
 // Does current objectset exist?
 // N -> ObjectDeployment Progressing = True / Is a previous objectset available?
 // __Y -> ObjectDeployment Available = True
@@ -94,7 +99,7 @@ func (o *objectSetReconciler) Reconcile(
 // __N -> ObjectDeployment Progressing = True / Is a previous objectset available?
 // ____Y -> ObjectDeployment Available = True
 // ____N -> ObjectDeployment Available = False
-// __Y -> ObjectDeployment Progressing = False / Is current objectset available?
+// __Y -> ObjectDeployment Progressing = False (True, if not yet promoted) / Is current objectset available?
 // ____N -> Is a previous objectset available?
 // ______Y -> ObjectDeployment Available = True
 // ______N -> ObjectDeployment Available = False
@@ -159,15 +164,27 @@ func (o *objectSetReconciler) setObjectDeploymentStatus(ctx context.Context,
 		return
 	}
 
-	// Latest revision succeeded, so we are no longer progressing.
-	objectDeployment.SetStatusConditions(
-		newProgressingCondition(
-			metav1.ConditionFalse,
-			progressingReasonIdle,
-			"Update concluded.",
-			objectDeployment.GetGeneration(),
-		),
-	)
+	if isPromoted(currentObjectSet, objectDeployment.GetStrategy()) {
+		// Latest revision succeeded, so we are no longer progressing.
+		objectDeployment.SetStatusConditions(
+			newProgressingCondition(
+				metav1.ConditionFalse,
+				progressingReasonIdle,
+				"Update concluded.",
+				objectDeployment.GetGeneration(),
+			),
+		)
+	} else {
+		// Latest revision succeeded, but previous revisions are kept until it is promoted.
+		objectDeployment.SetStatusConditions(
+			newProgressingCondition(
+				metav1.ConditionTrue,
+				progressingReasonAwaitingPromotion,
+				"Latest Revision is Available: waiting for promotion.",
+				objectDeployment.GetGeneration(),
+			),
+		)
+	}
 
 	if !currentObjectSet.IsAvailable() {
 		objectDeployment.SetStatusConditions(
@@ -269,7 +286,9 @@ func (r progressingReason) String() string {
 }
 
 const (
-	progressingReasonIdle                    progressingReason = "Idle"
-	progressingReasonLatestRevPendingSuccess progressingReason = "LatestRevisionPendingSuccess"
-	progressingReasonProgressing             progressingReason = "Progressing"
+	progressingReasonIdle                     progressingReason = "Idle"
+	progressingReasonLatestRevPendingSuccess  progressingReason = "LatestRevisionPendingSuccess"
+	progressingReasonProgressing              progressingReason = "Progressing"
+	progressingReasonAwaitingPromotion        progressingReason = "AwaitingPromotion"
+	progressingReasonProgressDeadlineExceeded progressingReason = "ProgressDeadlineExceeded"
 )
@@ -214,6 +214,8 @@ func makeObjectDeploymentMock(name string, namespace string,
 		},
 	}
 	res.On("SetStatusRevision", mock.Anything).Return()
+	res.On("IsPaused").Return(false)
+	res.On("GetStrategy").Return((*corev1alpha1.ObjectDeploymentStrategy)(nil))
 	res.On("GetSelector").Return(labelSelector)
 	res.On("GetGeneration").Return(generation)
 	res.On("GetStatusTemplateHash").Return(templateHash)
@@ -0,0 +1,105 @@
+package objectdeployments
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// pauseReconciler propagates .spec.paused of the ObjectDeployment to all its ObjectSets.
+// ObjectSets paused by other means (e.g. during bootstrap or archival)
+// are not resumed when the ObjectDeployment is resumed.
+type pauseReconciler struct {
+	client                      client.Client
+	listObjectSetsForDeployment listObjectSetsForDeploymentFn
+}
+
+func (p *pauseReconciler) Reconcile(
+	ctx context.Context, objectDeployment objectDeploymentAccessor,
+) (ctrl.Result, error) {
+	objectSets, err := p.listObjectSetsForDeployment(ctx, objectDeployment)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing objectsets under deployment errored: %w", err)
+	}
+
+	paused := objectDeployment.IsPaused()
+	for _, objectSet := range objectSets {
+		if paused {
+			err = p.pause(ctx, objectSet)
+		} else {
+			err = p.resume(ctx, objectSet)
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !paused {
+		// Keep the condition around after resuming,
+		// its transition time restarts the progress deadline.
+		if meta.IsStatusConditionTrue(*objectDeployment.GetConditions(), corev1alpha1.ObjectDeploymentPaused) {
+			objectDeployment.SetStatusConditions(metav1.Condition{
+				Type:               corev1alpha1.ObjectDeploymentPaused,
+				Status:             metav1.ConditionFalse,
+				Reason:             "Resumed",
+				Message:            "Reconciliation was resumed.",
+				ObservedGeneration: objectDeployment.GetGeneration(),
+			})
+		}
+		return ctrl.Result{}, nil
+	}
+
+	objectDeployment.SetStatusConditions(metav1.Condition{
+		Type:               corev1alpha1.ObjectDeploymentPaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Paused",
+		Message:            "Reconciliation is paused via .spec.paused.",
+		ObservedGeneration: objectDeployment.GetGeneration(),
+	})
+	return ctrl.Result{}, nil
+}
+
+func (p *pauseReconciler) pause(ctx context.Context, objectSet genericObjectSet) error {
+	if objectSet.IsArchived() || objectSet.IsSpecPaused() {
+		return nil
+	}
+
+	obj := objectSet.ClientObject()
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ObjectSetPausedByDeploymentAnnotation] = "True"
+	obj.SetAnnotations(annotations)
+	objectSet.SetPaused()
+
+	if err := p.client.Update(ctx, obj); err != nil {
+		return fmt.Errorf("pausing ObjectSet: %w", err)
+	}
+	return nil
+}
+
+func (p *pauseReconciler) resume(ctx context.Context, objectSet genericObjectSet) error {
+	obj := objectSet.ClientObject()
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[ObjectSetPausedByDeploymentAnnotation]; !ok {
+		return nil
+	}
+
+	delete(annotations, ObjectSetPausedByDeploymentAnnotation)
+	obj.SetAnnotations(annotations)
+	if objectSet.IsSpecPaused() {
+		objectSet.SetActive()
+	}
+
+	if err := p.client.Update(ctx, obj); err != nil {
+		return fmt.Errorf("resuming ObjectSet: %w", err)
+	}
+	return nil
+}
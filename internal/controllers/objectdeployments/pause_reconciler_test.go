@@ -0,0 +1,94 @@
+package objectdeployments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func TestPauseReconciler(t *testing.T) {
+	t.Parallel()
+
+	newObjectSet := func(state corev1alpha1.ObjectSetLifecycleState, annotations map[string]string) genericObjectSet {
+		os := newGenericObjectSet(testScheme)
+		os.ClientObject().SetAnnotations(annotations)
+		os.(*GenericObjectSet).Spec.LifecycleState = state
+		return os
+	}
+	pausedByDeployment := map[string]string{ObjectSetPausedByDeploymentAnnotation: "True"}
+
+	t.Run("pause", func(t *testing.T) {
+		t.Parallel()
+
+		active := newObjectSet(corev1alpha1.ObjectSetLifecycleStateActive, nil)
+		archived := newObjectSet(corev1alpha1.ObjectSetLifecycleStateArchived, nil)
+		alreadyPaused := newObjectSet(corev1alpha1.ObjectSetLifecycleStatePaused, nil)
+
+		c := testutil.NewClient()
+		c.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		r := &pauseReconciler{
+			client: c,
+			listObjectSetsForDeployment: func(context.Context, objectDeploymentAccessor) ([]genericObjectSet, error) {
+				return []genericObjectSet{archived, alreadyPaused, active}, nil
+			},
+		}
+
+		od := adapters.NewObjectDeployment(testScheme)
+		od.SetPaused(true)
+		res, err := r.Reconcile(context.Background(), od)
+		require.NoError(t, err)
+		assert.True(t, res.IsZero())
+
+		c.AssertNumberOfCalls(t, "Update", 1)
+		assert.True(t, active.IsSpecPaused())
+		assert.Equal(t, pausedByDeployment, active.ClientObject().GetAnnotations())
+		assert.True(t, archived.IsArchived())
+		assert.Empty(t, alreadyPaused.ClientObject().GetAnnotations())
+		assert.True(t, meta.IsStatusConditionTrue(*od.GetConditions(), corev1alpha1.ObjectDeploymentPaused))
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		t.Parallel()
+
+		pausedByUs := newObjectSet(corev1alpha1.ObjectSetLifecycleStatePaused, pausedByDeployment)
+		pausedByOthers := newObjectSet(corev1alpha1.ObjectSetLifecycleStatePaused, nil)
+
+		c := testutil.NewClient()
+		c.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		r := &pauseReconciler{
+			client: c,
+			listObjectSetsForDeployment: func(context.Context, objectDeploymentAccessor) ([]genericObjectSet, error) {
+				return []genericObjectSet{pausedByUs, pausedByOthers}, nil
+			},
+		}
+
+		od := adapters.NewObjectDeployment(testScheme)
+		od.SetStatusConditions(metav1.Condition{
+			Type:   corev1alpha1.ObjectDeploymentPaused,
+			Status: metav1.ConditionTrue,
+			Reason: "Paused",
+		})
+		res, err := r.Reconcile(context.Background(), od)
+		require.NoError(t, err)
+		assert.True(t, res.IsZero())
+
+		c.AssertNumberOfCalls(t, "Update", 1)
+		assert.False(t, pausedByUs.IsSpecPaused())
+		assert.Empty(t, pausedByUs.ClientObject().GetAnnotations())
+		assert.True(t, pausedByOthers.IsSpecPaused())
+		pausedCond := meta.FindStatusCondition(*od.GetConditions(), corev1alpha1.ObjectDeploymentPaused)
+		if assert.NotNil(t, pausedCond) {
+			assert.Equal(t, metav1.ConditionFalse, pausedCond.Status)
+			assert.Equal(t, "Resumed", pausedCond.Reason)
+		}
+	})
+}
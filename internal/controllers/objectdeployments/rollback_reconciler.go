@@ -0,0 +1,242 @@
+package objectdeployments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// rollbackReconciler enforces the progress deadline of the ObjectDeployment strategy.
+// When the deadline passes and rollbackOnFailure is set, the failed revision is paused
+// and the previous revision is re-activated to take over the objects of the failed revision.
+// The failed revision is archived after the take over, so only objects exclusive to it are torn down.
+// Runs after the objectSetReconciler to override its Progressing condition.
+type rollbackReconciler struct {
+	client                      client.Client
+	listObjectSetsForDeployment listObjectSetsForDeploymentFn
+	clock                       clock
+}
+
+func (r *rollbackReconciler) Reconcile(
+	ctx context.Context, objectDeployment objectDeploymentAccessor,
+) (ctrl.Result, error) {
+	if objectDeployment.IsPaused() {
+		return ctrl.Result{}, nil
+	}
+	strategy := objectDeployment.GetStrategy()
+	if strategy == nil || strategy.ProgressDeadlineSeconds == 0 {
+		meta.RemoveStatusCondition(objectDeployment.GetConditions(), corev1alpha1.ObjectDeploymentRolledBack)
+		return ctrl.Result{}, nil
+	}
+
+	objectSets, err := r.listObjectSetsForDeployment(ctx, objectDeployment)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing objectsets under deployment errored: %w", err)
+	}
+	currentObjectSet, prevObjectSets := splitCurrentObjectSet(objectSets, objectDeployment.GetStatusTemplateHash())
+	if currentObjectSet == nil || currentObjectSet.GetRevision() == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	if currentObjectSet.IsArchived() {
+		// The current revision is only ever archived when it was rolled back.
+		r.setDeadlineExceeded(objectDeployment, currentObjectSet, strategy)
+		return ctrl.Result{}, nil
+	}
+	meta.RemoveStatusCondition(objectDeployment.GetConditions(), corev1alpha1.ObjectDeploymentRolledBack)
+
+	if isRollingBack(currentObjectSet, prevObjectSets) {
+		// Deadline already exceeded, finish the rollback.
+		r.setDeadlineExceeded(objectDeployment, currentObjectSet, strategy)
+		return ctrl.Result{}, r.rollback(ctx, objectDeployment, currentObjectSet, prevObjectSets)
+	}
+
+	if currentObjectSet.IsAvailable() ||
+		meta.IsStatusConditionTrue(currentObjectSet.GetConditions(), corev1alpha1.ObjectSetSucceeded) {
+		return ctrl.Result{}, nil
+	}
+
+	deadline := progressDeadline(objectDeployment, currentObjectSet, strategy)
+	if now := r.clock.Now(); now.Before(deadline) {
+		return ctrl.Result{RequeueAfter: deadline.Sub(now)}, nil
+	}
+
+	r.setDeadlineExceeded(objectDeployment, currentObjectSet, strategy)
+	if !strategy.RollbackOnFailure {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, r.rollback(ctx, objectDeployment, currentObjectSet, prevObjectSets)
+}
+
+func (r *rollbackReconciler) setDeadlineExceeded(
+	objectDeployment objectDeploymentAccessor, currentObjectSet genericObjectSet,
+	strategy *corev1alpha1.ObjectDeploymentStrategy,
+) {
+	objectDeployment.SetStatusConditions(newProgressingCondition(
+		metav1.ConditionFalse,
+		progressingReasonProgressDeadlineExceeded,
+		fmt.Sprintf("Revision %d did not become available within %ds.",
+			currentObjectSet.GetRevision(), strategy.ProgressDeadlineSeconds),
+		objectDeployment.GetGeneration(),
+	))
+}
+
+// Returns the time the current revision has to be available by.
+// Progress is measured from the creation of the revision, the last time the deployment was resumed
+// or the last change of the revision's availability, whichever is latest.
+func progressDeadline(
+	objectDeployment objectDeploymentAccessor, currentObjectSet genericObjectSet,
+	strategy *corev1alpha1.ObjectDeploymentStrategy,
+) time.Time {
+	start := currentObjectSet.ClientObject().GetCreationTimestamp().Time
+	for _, cond := range []*metav1.Condition{
+		meta.FindStatusCondition(*objectDeployment.GetConditions(), corev1alpha1.ObjectDeploymentPaused),
+		meta.FindStatusCondition(currentObjectSet.GetConditions(), corev1alpha1.ObjectSetAvailable),
+	} {
+		if cond != nil && cond.LastTransitionTime.After(start) {
+			start = cond.LastTransitionTime.Time
+		}
+	}
+	return start.Add(time.Duration(strategy.ProgressDeadlineSeconds) * time.Second)
+}
+
+// Returns true while one of the previous revisions takes over the objects of the given revision.
+func isRollingBack(objectSet genericObjectSet, prevObjectSets []genericObjectSet) bool {
+	for _, prev := range prevObjectSets {
+		source, ok := prev.ClientObject().GetAnnotations()[corev1alpha1.ObjectSetRollbackFromAnnotation]
+		if ok && source == objectSet.ClientObject().GetName() && !prev.IsArchived() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *rollbackReconciler) rollback(
+	ctx context.Context, objectDeployment objectDeploymentAccessor,
+	currentObjectSet genericObjectSet, prevObjectSets []genericObjectSet,
+) error {
+	var target genericObjectSet
+	for i := len(prevObjectSets) - 1; i >= 0; i-- {
+		if !prevObjectSets[i].IsArchived() {
+			target = prevObjectSets[i]
+			break
+		}
+	}
+	if target == nil {
+		// Nothing to roll back to.
+		return nil
+	}
+
+	// Stop the failed revision from fighting over its objects.
+	if !currentObjectSet.IsSpecPaused() {
+		currentObjectSet.SetPaused()
+		if err := r.client.Update(ctx, currentObjectSet.ClientObject()); err != nil {
+			return fmt.Errorf("pausing failed objectset: %w", err)
+		}
+	}
+
+	if err := r.activate(ctx, target, currentObjectSet); err != nil {
+		return err
+	}
+
+	takenOver, err := hasTakenOver(target, currentObjectSet)
+	if err != nil {
+		return err
+	}
+	if !takenOver {
+		objectDeployment.SetStatusConditions(metav1.Condition{
+			Type:   corev1alpha1.ObjectDeploymentRolledBack,
+			Status: metav1.ConditionFalse,
+			Reason: "RollingBack",
+			Message: fmt.Sprintf("Waiting for revision %d to take over the objects of revision %d.",
+				target.GetRevision(), currentObjectSet.GetRevision()),
+			ObservedGeneration: objectDeployment.GetGeneration(),
+		})
+		return nil
+	}
+
+	log := logr.FromContextOrDiscard(ctx)
+	log.Info("rolled back failed revision",
+		"failedRevision", currentObjectSet.GetRevision(), "revision", target.GetRevision())
+
+	msg := fmt.Sprintf("Revision %d failed to become available, rolled back to revision %d.",
+		currentObjectSet.GetRevision(), target.GetRevision())
+	if availableCond := meta.FindStatusCondition(
+		currentObjectSet.GetConditions(), corev1alpha1.ObjectSetAvailable,
+	); availableCond != nil && len(availableCond.Message) > 0 {
+		msg += " " + availableCond.Message
+	}
+
+	// Objects that were taken over are no longer controlled by the failed revision,
+	// so archiving only tears down objects exclusive to it.
+	currentObjectSet.SetArchived()
+	if err := r.client.Update(ctx, currentObjectSet.ClientObject()); err != nil {
+		return fmt.Errorf("archiving failed objectset: %w", err)
+	}
+
+	objectDeployment.SetStatusConditions(metav1.Condition{
+		Type:               corev1alpha1.ObjectDeploymentRolledBack,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ProgressDeadlineExceeded",
+		Message:            msg,
+		ObservedGeneration: objectDeployment.GetGeneration(),
+	})
+	return nil
+}
+
+// Re-activates the target revision and allows it to take over the objects of the failed revision.
+func (r *rollbackReconciler) activate(ctx context.Context, target, failed genericObjectSet) error {
+	obj := target.ClientObject()
+	annotations := obj.GetAnnotations()
+	if annotations[corev1alpha1.ObjectSetRollbackFromAnnotation] == failed.ClientObject().GetName() &&
+		!target.IsSpecPaused() {
+		return nil
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[corev1alpha1.ObjectSetRollbackFromAnnotation] = failed.ClientObject().GetName()
+	obj.SetAnnotations(annotations)
+	// Previous revisions paused for archival need to pick up reconciliation again.
+	target.SetActive()
+	if err := r.client.Update(ctx, obj); err != nil {
+		return fmt.Errorf("re-activating previous objectset: %w", err)
+	}
+	return nil
+}
+
+// Returns true when the target revision controls all of its objects
+// that were controlled by the failed revision.
+func hasTakenOver(target, failed genericObjectSet) (bool, error) {
+	targetObjects, err := target.GetObjects()
+	if err != nil {
+		return false, err
+	}
+	controlledByTarget := target.GetActivelyReconciledObjects()
+	if controlledByTarget == nil {
+		// Status not yet reported.
+		return false, nil
+	}
+	shared := intersection(targetObjects, failed.GetActivelyReconciledObjects())
+	return len(intersection(controlledByTarget, shared)) == len(shared), nil
+}
+
+type clock interface {
+	Now() time.Time
+}
+
+type defaultClock struct{}
+
+func (c defaultClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,172 @@
+package objectdeployments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+type staticClock struct{ now time.Time }
+
+func (c staticClock) Now() time.Time { return c.now }
+
+func TestRollbackReconciler(t *testing.T) {
+	t.Parallel()
+
+	created := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name              string
+		rollbackOnFailure bool
+		available         bool
+		// previous revision already re-activated to take over the objects.
+		rollingBack bool
+		// previous revision controls its objects again.
+		takenOver          bool
+		resumedAfter       time.Duration
+		elapsed            time.Duration
+		expectedRequeue    time.Duration
+		expectedReason     string
+		expectedRolledBack metav1.ConditionStatus
+	}{
+		{
+			name:            "within deadline",
+			elapsed:         20 * time.Second,
+			expectedRequeue: 40 * time.Second,
+		},
+		{
+			name:      "available",
+			available: true,
+			elapsed:   2 * time.Minute,
+		},
+		{
+			name:           "deadline exceeded",
+			elapsed:        2 * time.Minute,
+			expectedReason: string(progressingReasonProgressDeadlineExceeded),
+		},
+		{
+			name:            "deadline restarted by resume",
+			resumedAfter:    90 * time.Second,
+			elapsed:         2 * time.Minute,
+			expectedRequeue: 30 * time.Second,
+		},
+		{
+			name:               "deadline exceeded with rollback",
+			rollbackOnFailure:  true,
+			elapsed:            2 * time.Minute,
+			expectedReason:     string(progressingReasonProgressDeadlineExceeded),
+			expectedRolledBack: metav1.ConditionFalse,
+		},
+		{
+			name:               "rollback waits for take over",
+			rollbackOnFailure:  true,
+			rollingBack:        true,
+			elapsed:            2 * time.Minute,
+			expectedReason:     string(progressingReasonProgressDeadlineExceeded),
+			expectedRolledBack: metav1.ConditionFalse,
+		},
+		{
+			name:               "rollback after take over",
+			rollbackOnFailure:  true,
+			rollingBack:        true,
+			takenOver:          true,
+			elapsed:            2 * time.Minute,
+			expectedReason:     string(progressingReasonProgressDeadlineExceeded),
+			expectedRolledBack: metav1.ConditionTrue,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			prev := &GenericObjectSet{makeObjectSet("test-1", "test", 1, "1", true, true, false)}
+			prev.SetPaused()
+			current := &GenericObjectSet{makeObjectSet("test-2", "test", 2, "2", test.available, false, false)}
+			current.CreationTimestamp = created
+			current.Status.Conditions[0].Message = "probe failed"
+			current.Status.Conditions[0].LastTransitionTime = created
+			if test.rollingBack {
+				prev.SetActive()
+				prev.Annotations[corev1alpha1.ObjectSetRollbackFromAnnotation] = "test-2"
+				current.SetPaused()
+			}
+			if test.takenOver {
+				prev.Status.ControllerOf = []corev1alpha1.ControlledObjectReference{}
+			}
+
+			c := testutil.NewClient()
+			c.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			r := &rollbackReconciler{
+				client: c,
+				listObjectSetsForDeployment: func(context.Context, objectDeploymentAccessor) ([]genericObjectSet, error) {
+					return []genericObjectSet{prev, current}, nil
+				},
+				clock: staticClock{now: created.Add(test.elapsed)},
+			}
+
+			od := adapters.NewObjectDeployment(testScheme)
+			od.SetStatusTemplateHash("2")
+			od.SetStrategy(&corev1alpha1.ObjectDeploymentStrategy{
+				ProgressDeadlineSeconds: 60,
+				RollbackOnFailure:       test.rollbackOnFailure,
+			})
+			if test.resumedAfter > 0 {
+				od.SetStatusConditions(metav1.Condition{
+					Type:               corev1alpha1.ObjectDeploymentPaused,
+					Status:             metav1.ConditionFalse,
+					Reason:             "Resumed",
+					LastTransitionTime: metav1.NewTime(created.Add(test.resumedAfter)),
+				})
+			}
+
+			res, err := r.Reconcile(context.Background(), od)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRequeue, res.RequeueAfter)
+
+			progressing := meta.FindStatusCondition(*od.GetConditions(), corev1alpha1.ObjectDeploymentProgressing)
+			if len(test.expectedReason) == 0 {
+				assert.Nil(t, progressing)
+			} else if assert.NotNil(t, progressing) {
+				assert.Equal(t, metav1.ConditionFalse, progressing.Status)
+				assert.Equal(t, test.expectedReason, progressing.Reason)
+			}
+
+			rolledBack := meta.FindStatusCondition(*od.GetConditions(), corev1alpha1.ObjectDeploymentRolledBack)
+			if len(test.expectedRolledBack) == 0 {
+				assert.Nil(t, rolledBack)
+				assert.False(t, current.IsArchived())
+				c.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			// The previous revision is re-activated to take over the objects,
+			// before the failed revision is archived.
+			assert.False(t, prev.IsSpecPaused())
+			assert.Equal(t, "test-2", prev.Annotations[corev1alpha1.ObjectSetRollbackFromAnnotation])
+			assert.True(t, current.IsSpecPaused() || current.IsArchived())
+			if assert.NotNil(t, rolledBack) {
+				assert.Equal(t, test.expectedRolledBack, rolledBack.Status)
+			}
+			if test.expectedRolledBack == metav1.ConditionFalse {
+				assert.False(t, current.IsArchived())
+				assert.Equal(t, "Waiting for revision 1 to take over the objects of revision 2.", rolledBack.Message)
+				return
+			}
+
+			assert.True(t, current.IsArchived())
+			assert.Equal(t,
+				"Revision 2 failed to become available, rolled back to revision 1. probe failed",
+				rolledBack.Message)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package objectdeployments
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// isPromoted returns true when the given revision may replace all previous revisions.
+// Without a strategy every revision is promoted.
+func isPromoted(objectSet genericObjectSet, strategy *corev1alpha1.ObjectDeploymentStrategy) bool {
+	if strategy == nil {
+		return true
+	}
+	// Succeeded is only set after successDelaySeconds,
+	// which is raised to the archiveDelaySeconds of the strategy.
+	if !meta.IsStatusConditionTrue(objectSet.GetConditions(), corev1alpha1.ObjectSetSucceeded) {
+		return false
+	}
+	if !strategy.ManualPromotion {
+		return true
+	}
+	return objectSet.ClientObject().GetAnnotations()[corev1alpha1.ObjectSetPromotedAnnotation] == "True"
+}
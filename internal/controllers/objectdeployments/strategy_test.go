@@ -0,0 +1,68 @@
+package objectdeployments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func Test_isPromoted(t *testing.T) {
+	t.Parallel()
+
+	succeeded := []metav1.Condition{{Type: corev1alpha1.ObjectSetSucceeded, Status: metav1.ConditionTrue}}
+	promoted := map[string]string{corev1alpha1.ObjectSetPromotedAnnotation: "True"}
+
+	tests := []struct {
+		name        string
+		strategy    *corev1alpha1.ObjectDeploymentStrategy
+		conditions  []metav1.Condition
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:     "no strategy",
+			expected: true,
+		},
+		{
+			name:     "archive delay pending",
+			strategy: &corev1alpha1.ObjectDeploymentStrategy{ArchiveDelaySeconds: 30},
+		},
+		{
+			name:       "archive delay passed",
+			strategy:   &corev1alpha1.ObjectDeploymentStrategy{ArchiveDelaySeconds: 30},
+			conditions: succeeded,
+			expected:   true,
+		},
+		{
+			name:       "manual promotion pending",
+			strategy:   &corev1alpha1.ObjectDeploymentStrategy{ManualPromotion: true},
+			conditions: succeeded,
+		},
+		{
+			name:        "manual promotion not yet succeeded",
+			strategy:    &corev1alpha1.ObjectDeploymentStrategy{ManualPromotion: true},
+			annotations: promoted,
+		},
+		{
+			name:        "manually promoted",
+			strategy:    &corev1alpha1.ObjectDeploymentStrategy{ManualPromotion: true},
+			conditions:  succeeded,
+			annotations: promoted,
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			objectSet := &GenericObjectSet{corev1alpha1.ObjectSet{
+				ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations},
+				Status:     corev1alpha1.ObjectSetStatus{Conditions: test.conditions},
+			}}
+			assert.Equal(t, test.expected, isPromoted(objectSet, test.strategy))
+		})
+	}
+}
@@ -15,10 +15,13 @@ type genericObjectSetPhase interface {
 	GetPrevious() []corev1alpha1.PreviousRevisionReference
 	GetPhase() corev1alpha1.ObjectSetTemplatePhase
 	GetAvailabilityProbes() []corev1alpha1.ObjectSetProbe
+	GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy
 	GetRevision() int64
 	GetGeneration() int64
 	IsPaused() bool
 	SetStatusControllerOf([]corev1alpha1.ControlledObjectReference)
+	GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus
+	SetStatusProbedObjects([]corev1alpha1.ObjectProbeStatus)
 	UpdateStatusPhase()
 }
 
@@ -87,6 +90,10 @@ func (a *GenericObjectSetPhase) GetAvailabilityProbes() []corev1alpha1.ObjectSet
 	return a.Spec.AvailabilityProbes
 }
 
+func (a *GenericObjectSetPhase) GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy {
+	return a.Spec.Adoption
+}
+
 func (a *GenericObjectSetPhase) GetRevision() int64 {
 	return a.Spec.Revision
 }
@@ -104,6 +111,14 @@ func (a *GenericObjectSetPhase) SetStatusControllerOf(controllerOf []corev1alpha
 	a.Status.ControllerOf = controllerOf
 }
 
+func (a *GenericObjectSetPhase) GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus {
+	return a.Status.ProbedObjects
+}
+
+func (a *GenericObjectSetPhase) SetStatusProbedObjects(probed []corev1alpha1.ObjectProbeStatus) {
+	a.Status.ProbedObjects = probed
+}
+
 type GenericClusterObjectSetPhase struct {
 	corev1alpha1.ClusterObjectSetPhase
 }
@@ -134,6 +149,10 @@ func (a *GenericClusterObjectSetPhase) GetAvailabilityProbes() []corev1alpha1.Ob
 	return a.Spec.AvailabilityProbes
 }
 
+func (a *GenericClusterObjectSetPhase) GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy {
+	return a.Spec.Adoption
+}
+
 func (a *GenericClusterObjectSetPhase) GetRevision() int64 {
 	return a.Spec.Revision
 }
@@ -149,4 +168,12 @@ func (a *GenericClusterObjectSetPhase) IsPaused() bool {
 func (a *GenericClusterObjectSetPhase) SetStatusControllerOf(controllerOf []corev1alpha1.ControlledObjectReference) {
 	a.Status.ControllerOf = controllerOf
 }
+
+func (a *GenericClusterObjectSetPhase) GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus {
+	return a.Status.ProbedObjects
+}
+
+func (a *GenericClusterObjectSetPhase) SetStatusProbedObjects(probed []corev1alpha1.ObjectProbeStatus) {
+	a.Status.ProbedObjects = probed
+}
 func (a *GenericClusterObjectSetPhase) UpdateStatusPhase() {}
@@ -131,11 +131,12 @@ func NewSameClusterObjectSetPhaseController(
 	class string,
 	client client.Client, // client to get and update ObjectSetPhases.
 	restMapper meta.RESTMapper,
+	ownerStrategy ownerStrategy,
 ) *GenericObjectSetPhaseController {
 	return NewGenericObjectSetPhaseController(
 		newGenericObjectSetPhase,
 		newGenericObjectSet,
-		ownerhandling.NewNative(scheme),
+		ownerStrategy,
 		log, scheme, dynamicCache, uncachedClient,
 		class, client, client,
 		preflight.NewAPIExistence(
@@ -156,11 +157,12 @@ func NewSameClusterClusterObjectSetPhaseController(
 	class string,
 	client client.Client, // client to get and update ObjectSetPhases.
 	restMapper meta.RESTMapper,
+	ownerStrategy ownerStrategy,
 ) *GenericObjectSetPhaseController {
 	return NewGenericObjectSetPhaseController(
 		newGenericClusterObjectSetPhase,
 		newGenericClusterObjectSet,
-		ownerhandling.NewNative(scheme),
+		ownerStrategy,
 		log, scheme, dynamicCache, uncachedClient,
 		class, client, client,
 		preflight.NewAPIExistence(
@@ -206,6 +208,7 @@ func NewGenericObjectSetPhaseController(
 				return newObjectSet(s)
 			}, client).Lookup,
 		ownerStrategy,
+		dynamicCache,
 	)
 	controller.teardownHandler = phaseReconciler
 	controller.reconciler = []reconciler{
@@ -319,12 +322,16 @@ func (c *GenericObjectSetPhaseController) SetupWithManager(
 	mgr ctrl.Manager,
 ) error {
 	objectSetPhase := c.newObjectSetPhase(c.scheme).ClientObject()
+	ownerHandler := c.ownerStrategy.EnqueueRequestForOwner(objectSetPhase, mgr.GetRESTMapper(), false)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(objectSetPhase).
 		WatchesRawSource(
-			c.dynamicCache.Source(
-				c.ownerStrategy.EnqueueRequestForOwner(objectSetPhase, mgr.GetRESTMapper(), false),
-			),
-		).Complete(c)
+			c.dynamicCache.Source(ownerHandler),
+		)
+	if ownerhandling.RecordsObjectOwnerships(c.ownerStrategy) {
+		// Owned objects do not change when their owners do.
+		b = b.Watches(&corev1alpha1.ObjectOwnership{}, ownerHandler)
+	}
+	return b.Complete(c)
 }
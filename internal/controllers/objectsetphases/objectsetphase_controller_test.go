@@ -321,7 +321,7 @@ func TestInitializers(t *testing.T) {
 		ctrl := NewSameClusterObjectSetPhaseController(
 			log, scheme,
 			dc, client, class, client,
-			mapper,
+			mapper, ownerhandling.NewNative(scheme),
 		)
 
 		require.NotNil(t, ctrl)
@@ -333,7 +333,7 @@ func TestInitializers(t *testing.T) {
 		ctrl := NewSameClusterClusterObjectSetPhaseController(
 			log, scheme,
 			dc, client, class, client,
-			mapper,
+			mapper, ownerhandling.NewNative(scheme),
 		)
 
 		require.NotNil(t, ctrl)
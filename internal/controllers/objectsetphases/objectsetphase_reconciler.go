@@ -25,7 +25,9 @@ type objectSetPhaseReconciler struct {
 	phaseReconciler         phaseReconciler
 	lookupPreviousRevisions lookupPreviousRevisions
 	ownerStrategy           ownerStrategy
-	backoff                 *flowcontrol.Backoff
+	// Provides objects referenced by probes.
+	probeCache internalprobing.ObjectCache
+	backoff    *flowcontrol.Backoff
 }
 
 func newObjectSetPhaseReconciler(
@@ -33,6 +35,7 @@ func newObjectSetPhaseReconciler(
 	phaseReconciler phaseReconciler,
 	lookupPreviousRevisions lookupPreviousRevisions,
 	ownerStrategy ownerStrategy,
+	probeCache internalprobing.ObjectCache,
 	opts ...objectSetPhaseReconcilerOption,
 ) *objectSetPhaseReconciler {
 	var cfg objectSetPhaseReconcilerConfig
@@ -45,6 +48,7 @@ func newObjectSetPhaseReconciler(
 		phaseReconciler:         phaseReconciler,
 		lookupPreviousRevisions: lookupPreviousRevisions,
 		ownerStrategy:           ownerStrategy,
+		probeCache:              probeCache,
 		backoff:                 cfg.GetBackoff(),
 	}
 }
@@ -54,7 +58,10 @@ type phaseReconciler interface {
 		ctx context.Context, owner controllers.PhaseObjectOwner,
 		phase corev1alpha1.ObjectSetTemplatePhase,
 		probe probing.Prober, previous []controllers.PreviousObjectSet,
-	) ([]client.Object, controllers.ProbingResult, error)
+	) (
+		[]client.Object, controllers.ProbingResult, []corev1alpha1.DriftedObject,
+		[]corev1alpha1.ControlledObjectReference, error,
+	)
 
 	TeardownPhase(
 		ctx context.Context, owner controllers.PhaseObjectOwner,
@@ -78,13 +85,17 @@ func (r *objectSetPhaseReconciler) Reconcile(
 		return res, fmt.Errorf("lookup previous revisions: %w", err)
 	}
 
+	// Objects of remote phases live in another cluster than the one Package Operator is running in.
 	probe, err := internalprobing.Parse(
-		ctx, objectSetPhase.GetAvailabilityProbes())
+		ctx, objectSetPhase.GetAvailabilityProbes(),
+		internalprobing.NewCacheObjectResolver(ctx, r.probeCache, objectSetPhase.ClientObject()),
+		internalprobing.WithDisableEndpointProbes(true))
 	if err != nil {
 		return res, fmt.Errorf("parsing probes: %w", err)
 	}
 
-	actualObjects, probingResult, err := r.phaseReconciler.ReconcilePhase(
+	// Drift is only reported in the status of ObjectSets.
+	actualObjects, probingResult, _, wouldAdopt, err := r.phaseReconciler.ReconcilePhase(
 		ctx, objectSetPhase, objectSetPhase.GetPhase(), probe, previous)
 	if controllers.IsExternalResourceNotFound(err) {
 		id := string(objectSetPhase.ClientObject().GetUID())
@@ -101,6 +112,14 @@ func (r *objectSetPhaseReconciler) Reconcile(
 	if err := r.reportOwnActiveObjects(ctx, objectSetPhase, actualObjects); err != nil {
 		return res, fmt.Errorf("reporting active objects: %w", err)
 	}
+	objectSetPhase.SetStatusProbedObjects(controllers.UpdateObjectProbeStatus(
+		objectSetPhase.GetStatusProbedObjects(), probingResult.Objects, metav1.Now()))
+	controllers.SetAdoptionDryRunCondition(
+		objectSetPhase.GetConditions(), objectSetPhase.ClientObject().GetGeneration(), wouldAdopt)
+
+	if internalprobing.RequiresPolling(objectSetPhase.GetAvailabilityProbes()) {
+		res.RequeueAfter = internalprobing.PollInterval
+	}
 
 	if !probingResult.IsZero() {
 		meta.SetStatusCondition(
@@ -123,7 +142,7 @@ func (r *objectSetPhaseReconciler) Reconcile(
 		ObservedGeneration: objectSetPhase.ClientObject().GetGeneration(),
 	})
 
-	return ctrl.Result{}, nil
+	return res, nil
 }
 
 func (r *objectSetPhaseReconciler) Teardown(
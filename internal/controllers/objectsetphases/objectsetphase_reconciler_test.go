@@ -71,17 +71,20 @@ func TestPhaseReconciler_Reconcile(t *testing.T) {
 			objectSetPhase.ClientObject().SetName("testPhaseOwner")
 			m := &phaseReconcilerMock{}
 			ownerStrategy := &ownerhandlingmocks.OwnerStrategyMock{}
-			r := newObjectSetPhaseReconciler(testScheme, m, lookup, ownerStrategy)
+			r := newObjectSetPhaseReconciler(testScheme, m, lookup, ownerStrategy, &dynamicCacheMock{})
 
+			probed := []corev1alpha1.ObjectProbeStatus{{
+				Version: "v1", Kind: "ConfigMap", Name: "cm", Status: test.condition.Status,
+			}}
 			if test.condition.Reason == "ProbeFailure" {
 				m.
 					On("ReconcilePhase", mock.Anything, objectSetPhase, objectSetPhase.GetPhase(), mock.Anything, previousList).
-					Return([]client.Object{}, controllers.ProbingResult{PhaseName: "this"}, nil).
+					Return([]client.Object{}, controllers.ProbingResult{PhaseName: "this", Objects: probed}, nil, nil, nil).
 					Once()
 			} else {
 				m.
 					On("ReconcilePhase", mock.Anything, objectSetPhase, objectSetPhase.GetPhase(), mock.Anything, previousList).
-					Return([]client.Object{}, controllers.ProbingResult{}, nil).
+					Return([]client.Object{}, controllers.ProbingResult{Objects: probed}, nil, nil, nil).
 					Once()
 			}
 
@@ -95,6 +98,11 @@ func TestPhaseReconciler_Reconcile(t *testing.T) {
 			assert.Equal(t, corev1alpha1.ObjectSetPhaseAvailable, cond.Type)
 			assert.Equal(t, test.condition.Status, cond.Status)
 			assert.Equal(t, test.condition.Reason, cond.Reason)
+
+			if probedStatus := objectSetPhase.GetStatusProbedObjects(); assert.Len(t, probedStatus, 1) {
+				assert.Equal(t, test.condition.Status, probedStatus[0].Status)
+				assert.False(t, probedStatus[0].LastTransitionTime.IsZero())
+			}
 		})
 	}
 }
@@ -114,11 +122,11 @@ func TestPhaseReconciler_ReconcileBackoff(t *testing.T) {
 	objectSetPhase.ClientObject().SetName("testPhaseOwner")
 	m := &phaseReconcilerMock{}
 	ownerStrategy := &ownerhandlingmocks.OwnerStrategyMock{}
-	r := newObjectSetPhaseReconciler(testScheme, m, lookup, ownerStrategy)
+	r := newObjectSetPhaseReconciler(testScheme, m, lookup, ownerStrategy, &dynamicCacheMock{})
 
 	m.
 		On("ReconcilePhase", mock.Anything, objectSetPhase, objectSetPhase.GetPhase(), mock.Anything, previousList).
-		Return([]client.Object{}, controllers.ProbingResult{}, controllers.NewExternalResourceNotFoundError(nil)).
+		Return([]client.Object{}, controllers.ProbingResult{}, nil, nil, controllers.NewExternalResourceNotFoundError(nil)).
 		Once()
 
 	res, err := r.Reconcile(context.Background(), objectSetPhase)
@@ -140,7 +148,7 @@ func TestPhaseReconciler_Teardown(t *testing.T) {
 	ownerStrategy := &ownerhandlingmocks.OwnerStrategyMock{}
 	m := &phaseReconcilerMock{}
 	m.On("TeardownPhase", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
-	r := newObjectSetPhaseReconciler(testScheme, m, lookup, ownerStrategy)
+	r := newObjectSetPhaseReconciler(testScheme, m, lookup, ownerStrategy, &dynamicCacheMock{})
 	_, err := r.Teardown(context.Background(), objectSetPhase)
 	require.NoError(t, err)
 	m.AssertCalled(t, "TeardownPhase", mock.Anything, mock.Anything, mock.Anything)
@@ -20,11 +20,19 @@ type genericObjectSet interface {
 	SetPhases(phases []corev1alpha1.ObjectSetTemplatePhase)
 	GetAvailabilityProbes() []corev1alpha1.ObjectSetProbe
 	GetSuccessDelaySeconds() int32
+	GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy
 	SetRevision(revision int64)
 	GetRevision() int64
 	GetRemotePhases() []corev1alpha1.RemotePhaseReference
 	SetRemotePhases([]corev1alpha1.RemotePhaseReference)
 	SetStatusControllerOf([]corev1alpha1.ControlledObjectReference)
+	GetHooks() []corev1alpha1.ObjectSetHook
+	GetStatusHooks() []corev1alpha1.ObjectSetHookStatus
+	SetStatusHooks([]corev1alpha1.ObjectSetHookStatus)
+	GetStatusDriftedObjects() []corev1alpha1.DriftedObject
+	SetStatusDriftedObjects([]corev1alpha1.DriftedObject)
+	GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus
+	SetStatusProbedObjects([]corev1alpha1.ObjectProbeStatus)
 }
 
 type genericObjectSetFactory func(
@@ -106,6 +114,10 @@ func (a *GenericObjectSet) GetSuccessDelaySeconds() int32 {
 	return a.Spec.SuccessDelaySeconds
 }
 
+func (a *GenericObjectSet) GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy {
+	return a.Spec.Adoption
+}
+
 func (a *GenericObjectSet) SetRevision(revision int64) {
 	a.Status.Revision = revision
 }
@@ -126,6 +138,34 @@ func (a *GenericObjectSet) SetStatusControllerOf(controllerOf []corev1alpha1.Con
 	a.Status.ControllerOf = controllerOf
 }
 
+func (a *GenericObjectSet) GetHooks() []corev1alpha1.ObjectSetHook {
+	return a.Spec.Hooks
+}
+
+func (a *GenericObjectSet) GetStatusHooks() []corev1alpha1.ObjectSetHookStatus {
+	return a.Status.Hooks
+}
+
+func (a *GenericObjectSet) SetStatusHooks(hooks []corev1alpha1.ObjectSetHookStatus) {
+	a.Status.Hooks = hooks
+}
+
+func (a *GenericObjectSet) GetStatusDriftedObjects() []corev1alpha1.DriftedObject {
+	return a.Status.DriftedObjects
+}
+
+func (a *GenericObjectSet) SetStatusDriftedObjects(drifted []corev1alpha1.DriftedObject) {
+	a.Status.DriftedObjects = drifted
+}
+
+func (a *GenericObjectSet) GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus {
+	return a.Status.ProbedObjects
+}
+
+func (a *GenericObjectSet) SetStatusProbedObjects(probed []corev1alpha1.ObjectProbeStatus) {
+	a.Status.ProbedObjects = probed
+}
+
 type GenericClusterObjectSet struct {
 	corev1alpha1.ClusterObjectSet
 }
@@ -170,6 +210,10 @@ func (a *GenericClusterObjectSet) GetSuccessDelaySeconds() int32 {
 	return a.Spec.SuccessDelaySeconds
 }
 
+func (a *GenericClusterObjectSet) GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy {
+	return a.Spec.Adoption
+}
+
 func (a *GenericClusterObjectSet) SetRevision(revision int64) {
 	a.Status.Revision = revision
 }
@@ -190,6 +234,34 @@ func (a *GenericClusterObjectSet) SetStatusControllerOf(controllerOf []corev1alp
 	a.Status.ControllerOf = controllerOf
 }
 
+func (a *GenericClusterObjectSet) GetHooks() []corev1alpha1.ObjectSetHook {
+	return a.Spec.Hooks
+}
+
+func (a *GenericClusterObjectSet) GetStatusHooks() []corev1alpha1.ObjectSetHookStatus {
+	return a.Status.Hooks
+}
+
+func (a *GenericClusterObjectSet) SetStatusHooks(hooks []corev1alpha1.ObjectSetHookStatus) {
+	a.Status.Hooks = hooks
+}
+
+func (a *GenericClusterObjectSet) GetStatusDriftedObjects() []corev1alpha1.DriftedObject {
+	return a.Status.DriftedObjects
+}
+
+func (a *GenericClusterObjectSet) SetStatusDriftedObjects(drifted []corev1alpha1.DriftedObject) {
+	a.Status.DriftedObjects = drifted
+}
+
+func (a *GenericClusterObjectSet) GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus {
+	return a.Status.ProbedObjects
+}
+
+func (a *GenericClusterObjectSet) SetStatusProbedObjects(probed []corev1alpha1.ObjectProbeStatus) {
+	a.Status.ProbedObjects = probed
+}
+
 func objectSetStatusPhase(conditions []metav1.Condition) corev1alpha1.ObjectSetStatusPhase {
 	if meta.IsStatusConditionTrue(
 		conditions,
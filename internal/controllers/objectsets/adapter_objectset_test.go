@@ -133,6 +133,21 @@ func TestGenericObjectSet(t *testing.T) {
 	controllerOf := []corev1alpha1.ControlledObjectReference{{}}
 	objectSet.SetStatusControllerOf(controllerOf)
 	assert.Equal(t, controllerOf, objectSet.Status.ControllerOf)
+
+	objectSet.Spec.Hooks = []corev1alpha1.ObjectSetHook{{}}
+	assert.Equal(t, objectSet.Spec.Hooks, objectSet.GetHooks())
+
+	hooks := []corev1alpha1.ObjectSetHookStatus{{}}
+	objectSet.SetStatusHooks(hooks)
+	assert.Equal(t, hooks, objectSet.GetStatusHooks())
+
+	drifted := []corev1alpha1.DriftedObject{{}}
+	objectSet.SetStatusDriftedObjects(drifted)
+	assert.Equal(t, drifted, objectSet.GetStatusDriftedObjects())
+
+	probed := []corev1alpha1.ObjectProbeStatus{{Kind: "ConfigMap", Status: metav1.ConditionTrue}}
+	objectSet.SetStatusProbedObjects(probed)
+	assert.Equal(t, probed, objectSet.GetStatusProbedObjects())
 }
 
 func TestGenericClusterObjectSet(t *testing.T) {
@@ -177,4 +192,19 @@ func TestGenericClusterObjectSet(t *testing.T) {
 	controllerOf := []corev1alpha1.ControlledObjectReference{{}}
 	objectSet.SetStatusControllerOf(controllerOf)
 	assert.Equal(t, controllerOf, objectSet.Status.ControllerOf)
+
+	objectSet.Spec.Hooks = []corev1alpha1.ObjectSetHook{{}}
+	assert.Equal(t, objectSet.Spec.Hooks, objectSet.GetHooks())
+
+	hooks := []corev1alpha1.ObjectSetHookStatus{{}}
+	objectSet.SetStatusHooks(hooks)
+	assert.Equal(t, hooks, objectSet.GetStatusHooks())
+
+	drifted := []corev1alpha1.DriftedObject{{}}
+	objectSet.SetStatusDriftedObjects(drifted)
+	assert.Equal(t, drifted, objectSet.GetStatusDriftedObjects())
+
+	probed := []corev1alpha1.ObjectProbeStatus{{Kind: "ConfigMap", Status: metav1.ConditionTrue}}
+	objectSet.SetStatusProbedObjects(probed)
+	assert.Equal(t, probed, objectSet.GetStatusProbedObjects())
 }
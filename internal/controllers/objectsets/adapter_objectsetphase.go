@@ -15,9 +15,11 @@ type genericObjectSetPhase interface {
 	SetPhase(phase corev1alpha1.ObjectSetTemplatePhase)
 	SetPaused(paused bool)
 	SetAvailabilityProbes([]corev1alpha1.ObjectSetProbe)
+	SetAdoptionPolicy(*corev1alpha1.AdoptionPolicy)
 	SetRevision(revision int64)
 	SetPrevious([]corev1alpha1.PreviousRevisionReference)
 	GetStatusControllerOf() []corev1alpha1.ControlledObjectReference
+	GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus
 }
 
 type genericObjectSetPhaseFactory func(
@@ -75,6 +77,10 @@ func (a *GenericObjectSetPhase) SetAvailabilityProbes(probes []corev1alpha1.Obje
 	a.Spec.AvailabilityProbes = probes
 }
 
+func (a *GenericObjectSetPhase) SetAdoptionPolicy(policy *corev1alpha1.AdoptionPolicy) {
+	a.Spec.Adoption = policy
+}
+
 func (a *GenericObjectSetPhase) IsPaused() bool {
 	return a.Spec.Paused
 }
@@ -99,6 +105,10 @@ func (a *GenericObjectSetPhase) GetStatusControllerOf() []corev1alpha1.Controlle
 	return a.Status.ControllerOf
 }
 
+func (a *GenericObjectSetPhase) GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus {
+	return a.Status.ProbedObjects
+}
+
 type GenericClusterObjectSetPhase struct {
 	corev1alpha1.ClusterObjectSetPhase
 }
@@ -123,6 +133,10 @@ func (a *GenericClusterObjectSetPhase) SetAvailabilityProbes(probes []corev1alph
 	a.Spec.AvailabilityProbes = probes
 }
 
+func (a *GenericClusterObjectSetPhase) SetAdoptionPolicy(policy *corev1alpha1.AdoptionPolicy) {
+	a.Spec.Adoption = policy
+}
+
 func (a *GenericClusterObjectSetPhase) SetPhase(phase corev1alpha1.ObjectSetTemplatePhase) {
 	if a.Labels == nil {
 		a.Labels = map[string]string{}
@@ -142,3 +156,7 @@ func (a *GenericClusterObjectSetPhase) SetPrevious(previous []corev1alpha1.Previ
 func (a *GenericClusterObjectSetPhase) GetStatusControllerOf() []corev1alpha1.ControlledObjectReference {
 	return a.Status.ControllerOf
 }
+
+func (a *GenericClusterObjectSetPhase) GetStatusProbedObjects() []corev1alpha1.ObjectProbeStatus {
+	return a.Status.ProbedObjects
+}
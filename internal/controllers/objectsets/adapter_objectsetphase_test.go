@@ -40,10 +40,19 @@ func TestGenericObjectSetPhase(t *testing.T) {
 	}
 	assert.Equal(t, objectSet.Status.ControllerOf, objectSet.GetStatusControllerOf())
 
+	objectSet.Status.ProbedObjects = []corev1alpha1.ObjectProbeStatus{
+		{},
+	}
+	assert.Equal(t, objectSet.Status.ProbedObjects, objectSet.GetStatusProbedObjects())
+
 	probes := []corev1alpha1.ObjectSetProbe{{}}
 	objectSet.SetAvailabilityProbes(probes)
 	assert.Equal(t, probes, objectSet.Spec.AvailabilityProbes)
 
+	adoption := &corev1alpha1.AdoptionPolicy{DryRun: true}
+	objectSet.SetAdoptionPolicy(adoption)
+	assert.Equal(t, adoption, objectSet.Spec.Adoption)
+
 	var revision int64 = 34
 	objectSet.SetRevision(revision)
 	assert.Equal(t, revision, objectSet.Spec.Revision)
@@ -85,10 +94,19 @@ func TestGenericClusterObjectSetPhase(t *testing.T) {
 	}
 	assert.Equal(t, objectSet.Status.ControllerOf, objectSet.GetStatusControllerOf())
 
+	objectSet.Status.ProbedObjects = []corev1alpha1.ObjectProbeStatus{
+		{},
+	}
+	assert.Equal(t, objectSet.Status.ProbedObjects, objectSet.GetStatusProbedObjects())
+
 	probes := []corev1alpha1.ObjectSetProbe{{}}
 	objectSet.SetAvailabilityProbes(probes)
 	assert.Equal(t, probes, objectSet.Spec.AvailabilityProbes)
 
+	adoption := &corev1alpha1.AdoptionPolicy{DryRun: true}
+	objectSet.SetAdoptionPolicy(adoption)
+	assert.Equal(t, adoption, objectSet.Spec.Adoption)
+
 	var revision int64 = 34
 	objectSet.SetRevision(revision)
 	assert.Equal(t, revision, objectSet.Spec.Revision)
@@ -0,0 +1,402 @@
+package objectsets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/constants"
+	"package-operator.run/internal/ownerhandling"
+	"package-operator.run/pkg/probing"
+)
+
+// hooksReconcilerRequeueDelay is used while waiting for hooks to complete.
+// Hook objects are watched, so this is only a safety net.
+const hooksReconcilerRequeueDelay = 10 * time.Second
+
+var (
+	errHookFailed        = errors.New("hook failed")
+	errHookNotControlled = errors.New("hook object exists, but is not controlled by this ObjectSet")
+)
+
+var (
+	// Hooks follow the batch/v1 Job convention of reporting completion.
+	hookCompleteProbe = &probing.ConditionProbe{Type: "Complete", Status: string(metav1.ConditionTrue)}
+	hookFailedProbe   = &probing.ConditionProbe{Type: "Failed", Status: string(metav1.ConditionTrue)}
+)
+
+// hooksReconciler runs lifecycle hooks to completion,
+// before phases of an ObjectSet are reconciled or torn down.
+type hooksReconciler struct {
+	scheme        *runtime.Scheme
+	client        client.Client
+	dynamicCache  dynamicCache
+	ownerStrategy hookOwnerStrategy
+}
+
+// hookOwnerStrategy sets and checks the controller of hook objects.
+// Hooks always use native ownerReferences, independent of the owner strategy configured for phases,
+// so the garbage collector cleans up hook objects left behind by deleted ObjectSets.
+// Hook objects are never handed over between revisions, so they do not need
+// the alternative owner strategies used to adopt objects across ObjectSets.
+type hookOwnerStrategy interface {
+	IsController(owner, obj metav1.Object) bool
+	SetControllerReference(owner, obj metav1.Object) error
+}
+
+func newHooksReconciler(
+	scheme *runtime.Scheme, client client.Client, dynamicCache dynamicCache,
+) *hooksReconciler {
+	return &hooksReconciler{
+		scheme:        scheme,
+		client:        client,
+		dynamicCache:  dynamicCache,
+		ownerStrategy: ownerhandling.NewNative(scheme),
+	}
+}
+
+type hookState string
+
+const (
+	hookStateRunning   hookState = "Running"
+	hookStateSucceeded hookState = "Succeeded"
+	hookStateFailed    hookState = "Failed"
+)
+
+// Runs PreInstall or PreUpgrade hooks that have not yet completed.
+// Blocks the following reconcilers until all hooks have completed.
+func (r *hooksReconciler) Reconcile(
+	ctx context.Context, objectSet genericObjectSet,
+) (res ctrl.Result, err error) {
+	if objectSet.IsPaused() {
+		return res, nil
+	}
+
+	hookType := corev1alpha1.ObjectSetHookTypePreInstall
+	if len(objectSet.GetPrevious()) > 0 {
+		hookType = corev1alpha1.ObjectSetHookTypePreUpgrade
+	}
+
+	for _, hook := range objectSet.GetHooks() {
+		if hook.Type != hookType || hasHookStatus(objectSet, hook) {
+			continue
+		}
+
+		actualObj, state, err := r.runHook(ctx, objectSet, hook)
+		if err != nil {
+			return res, err
+		}
+
+		switch {
+		case state == hookStateRunning:
+			meta.SetStatusCondition(objectSet.GetConditions(), metav1.Condition{
+				Type:               corev1alpha1.ObjectSetAvailable,
+				Status:             metav1.ConditionFalse,
+				Reason:             "HookInProgress",
+				Message:            fmt.Sprintf("Waiting for %s to complete.", hook),
+				ObservedGeneration: objectSet.ClientObject().GetGeneration(),
+			})
+			return ctrl.Result{RequeueAfter: hooksReconcilerRequeueDelay}, nil
+
+		case state == hookStateFailed &&
+			hook.FailurePolicy != corev1alpha1.ObjectSetHookFailurePolicyIgnore:
+			meta.SetStatusCondition(objectSet.GetConditions(), metav1.Condition{
+				Type:               corev1alpha1.ObjectSetAvailable,
+				Status:             metav1.ConditionFalse,
+				Reason:             "HookFailed",
+				Message:            fmt.Sprintf("%s failed, delete the hook object to retry.", hook),
+				ObservedGeneration: objectSet.ClientObject().GetGeneration(),
+			})
+			return ctrl.Result{RequeueAfter: hooksReconcilerRequeueDelay}, nil
+		}
+
+		if err := r.recordHook(ctx, objectSet, hook, actualObj, state); err != nil {
+			return res, err
+		}
+		if shouldDeleteHook(hook, state) {
+			if err := r.client.Delete(ctx, actualObj,
+				client.PropagationPolicy(metav1.DeletePropagationBackground),
+			); client.IgnoreNotFound(err) != nil {
+				return res, fmt.Errorf("deleting hook object: %w", err)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// Runs PreDelete hooks when the ObjectSet is deleted.
+// Hook objects are cleaned up by the garbage collector together with the ObjectSet.
+// Hooks targeting a terminating namespace can never be created, so they are skipped.
+func (r *hooksReconciler) Teardown(
+	ctx context.Context, objectSet genericObjectSet,
+) (cleanupDone bool, err error) {
+	if objectSet.ClientObject().GetDeletionTimestamp().IsZero() ||
+		objectSet.IsArchived() ||
+		// objectSet is deleted with the `orphan` cascade option, nothing to clean up.
+		controllerutil.ContainsFinalizer(objectSet.ClientObject(), "orphan") {
+		return true, nil
+	}
+
+	log := logr.FromContextOrDiscard(ctx)
+	for _, hook := range objectSet.GetHooks() {
+		if hook.Type != corev1alpha1.ObjectSetHookTypePreDelete {
+			continue
+		}
+
+		terminating, err := r.isNamespaceTerminating(ctx, objectSet, hook)
+		if err != nil {
+			return false, err
+		}
+		if terminating {
+			log.Info("skipping hook, namespace is terminating", "hook", hook.String())
+			meta.SetStatusCondition(objectSet.GetConditions(), metav1.Condition{
+				Type:               corev1alpha1.ObjectSetHooksSkipped,
+				Status:             metav1.ConditionTrue,
+				Reason:             "NamespaceTerminating",
+				Message:            fmt.Sprintf("Skipped %s, its namespace is terminating.", hook),
+				ObservedGeneration: objectSet.ClientObject().GetGeneration(),
+			})
+			continue
+		}
+
+		_, state, err := r.runHook(ctx, objectSet, hook)
+		if err != nil {
+			return false, err
+		}
+
+		switch {
+		case state == hookStateRunning:
+			log.Info("waiting for hook to complete", "hook", hook.String())
+			return false, nil
+
+		case state == hookStateFailed &&
+			hook.FailurePolicy != corev1alpha1.ObjectSetHookFailurePolicyIgnore:
+			return false, fmt.Errorf("%w: %s", errHookFailed, hook)
+		}
+	}
+
+	return true, nil
+}
+
+// Ensures the hook object exists and reports its state.
+func (r *hooksReconciler) runHook(
+	ctx context.Context, objectSet genericObjectSet,
+	hook corev1alpha1.ObjectSetHook,
+) (*unstructured.Unstructured, hookState, error) {
+	desiredObj, err := r.desiredHookObject(objectSet, hook)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := r.dynamicCache.Watch(
+		ctx, objectSet.ClientObject(), desiredObj); err != nil {
+		return nil, "", fmt.Errorf("watching hook object: %w", err)
+	}
+
+	actualObj := &unstructured.Unstructured{}
+	actualObj.SetGroupVersionKind(desiredObj.GroupVersionKind())
+	err = r.dynamicCache.Get(ctx, client.ObjectKeyFromObject(desiredObj), actualObj)
+	switch {
+	case apimachineryerrors.IsNotFound(err):
+		// The cache may lag behind a previous create, so AlreadyExists is expected.
+		if err := r.client.Create(ctx, desiredObj); err != nil &&
+			!apimachineryerrors.IsAlreadyExists(err) {
+			return nil, "", fmt.Errorf("creating hook object: %w", err)
+		}
+		logr.FromContextOrDiscard(ctx).Info("created hook", "hook", hook.String())
+		return desiredObj, hookStateRunning, nil
+
+	case err != nil:
+		return nil, "", fmt.Errorf("getting hook object: %w", err)
+	}
+
+	if !r.ownerStrategy.IsController(objectSet.ClientObject(), actualObj) {
+		previous, err := r.isControlledByPrevious(objectSet, actualObj)
+		if err != nil {
+			return nil, "", err
+		}
+		if !previous {
+			return nil, "", fmt.Errorf("%w: %s", errHookNotControlled, hook)
+		}
+
+		// Hook objects keep their name across revisions,
+		// so a retained or failed hook of a previous revision is replaced.
+		uid := actualObj.GetUID()
+		if err := r.client.Delete(ctx, actualObj,
+			client.PropagationPolicy(metav1.DeletePropagationBackground),
+			client.Preconditions{UID: &uid},
+		); client.IgnoreNotFound(err) != nil {
+			return nil, "", fmt.Errorf("deleting hook object of previous revision: %w", err)
+		}
+		logr.FromContextOrDiscard(ctx).Info("deleted hook of previous revision", "hook", hook.String())
+		return actualObj, hookStateRunning, nil
+	}
+
+	if success, _ := hookCompleteProbe.Probe(actualObj); success {
+		return actualObj, hookStateSucceeded, nil
+	}
+	if failed, _ := hookFailedProbe.Probe(actualObj); failed {
+		return actualObj, hookStateFailed, nil
+	}
+	return actualObj, hookStateRunning, nil
+}
+
+// Returns true if the given object is controlled by a previous revision of the ObjectSet.
+func (r *hooksReconciler) isControlledByPrevious(
+	objectSet genericObjectSet, obj metav1.Object,
+) (bool, error) {
+	controllerRef := metav1.GetControllerOf(obj)
+	if controllerRef == nil {
+		return false, nil
+	}
+	gvk, err := apiutil.GVKForObject(objectSet.ClientObject(), r.scheme)
+	if err != nil {
+		return false, err
+	}
+	if controllerRef.APIVersion != gvk.GroupVersion().String() ||
+		controllerRef.Kind != gvk.Kind {
+		return false, nil
+	}
+	for _, prev := range objectSet.GetPrevious() {
+		if prev.Name == controllerRef.Name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Returns true if the namespace the hook object would be created in is being deleted.
+func (r *hooksReconciler) isNamespaceTerminating(
+	ctx context.Context, objectSet genericObjectSet, hook corev1alpha1.ObjectSetHook,
+) (bool, error) {
+	namespace := hook.Object.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = objectSet.ClientObject().GetNamespace()
+	}
+	if len(namespace) == 0 {
+		// cluster scoped hook object.
+		return false, nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("getting hook namespace: %w", err)
+	}
+	return !ns.DeletionTimestamp.IsZero() ||
+		ns.Status.Phase == corev1.NamespaceTerminating, nil
+}
+
+// Builds the hook object including system labels, namespace and owner reference.
+func (r *hooksReconciler) desiredHookObject(
+	objectSet genericObjectSet, hook corev1alpha1.ObjectSetHook,
+) (*unstructured.Unstructured, error) {
+	desiredObj := hook.Object.DeepCopy()
+
+	// Default namespace to the owners namespace
+	if len(desiredObj.GetNamespace()) == 0 {
+		desiredObj.SetNamespace(
+			objectSet.ClientObject().GetNamespace())
+	}
+
+	labels := desiredObj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[constants.DynamicCacheLabel] = "True"
+	desiredObj.SetLabels(labels)
+
+	if err := r.ownerStrategy.SetControllerReference(objectSet.ClientObject(), desiredObj); err != nil {
+		return nil, fmt.Errorf("set controller reference: %w", err)
+	}
+	return desiredObj, nil
+}
+
+// Persists the hook result right away,
+// so a hook is never run twice, even if its object is deleted afterwards.
+func (r *hooksReconciler) recordHook(
+	ctx context.Context, objectSet genericObjectSet,
+	hook corev1alpha1.ObjectSetHook, actualObj *unstructured.Unstructured,
+	state hookState,
+) error {
+	result := corev1alpha1.ObjectSetHookResultSucceeded
+	if state == hookStateFailed {
+		result = corev1alpha1.ObjectSetHookResultFailed
+	}
+
+	objectSet.SetStatusHooks(append(objectSet.GetStatusHooks(), corev1alpha1.ObjectSetHookStatus{
+		Type:   hook.Type,
+		Object: hookObjectReference(actualObj),
+		Result: result,
+	}))
+	if err := r.client.Status().Update(ctx, objectSet.ClientObject()); err != nil {
+		return fmt.Errorf("update hooks in status: %w", err)
+	}
+	return nil
+}
+
+func shouldDeleteHook(hook corev1alpha1.ObjectSetHook, state hookState) bool {
+	switch hook.RetentionPolicy {
+	case corev1alpha1.ObjectSetHookRetentionPolicyDelete:
+		return true
+	case corev1alpha1.ObjectSetHookRetentionPolicyRetain:
+		return false
+	default:
+		return state == hookStateSucceeded
+	}
+}
+
+func hasHookStatus(objectSet genericObjectSet, hook corev1alpha1.ObjectSetHook) bool {
+	ref := hookObjectReference(&hook.Object)
+	if len(ref.Namespace) == 0 {
+		ref.Namespace = objectSet.ClientObject().GetNamespace()
+	}
+	for _, status := range objectSet.GetStatusHooks() {
+		if status.Type == hook.Type && status.Object == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func hookObjectReference(obj *unstructured.Unstructured) corev1alpha1.ControlledObjectReference {
+	gvk := obj.GroupVersionKind()
+	return corev1alpha1.ControlledObjectReference{
+		Kind:      gvk.Kind,
+		Group:     gvk.Group,
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+}
+
+// teardownHandlerList runs teardown handlers in order,
+// only moving on to the next handler when the previous one is done.
+type teardownHandlerList []teardownHandler
+
+func (l teardownHandlerList) Teardown(
+	ctx context.Context, objectSet genericObjectSet,
+) (cleanupDone bool, err error) {
+	for _, h := range l {
+		if cleanupDone, err := h.Teardown(ctx, objectSet); err != nil || !cleanupDone {
+			return false, err
+		}
+	}
+	return true, nil
+}
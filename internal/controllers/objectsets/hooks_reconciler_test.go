@@ -0,0 +1,342 @@
+package objectsets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/ownerhandling"
+	"package-operator.run/internal/testutil"
+)
+
+func newHookObjectSet(hooks ...corev1alpha1.ObjectSetHook) *GenericObjectSet {
+	objectSet := newGenericObjectSet(testScheme).(*GenericObjectSet)
+	objectSet.Name = "test"
+	objectSet.Namespace = "test-ns"
+	objectSet.Spec.Hooks = hooks
+	return objectSet
+}
+
+func newHookJob(name string) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"})
+	obj.SetName(name)
+	return obj
+}
+
+// Mocks a cache lookup returning the hook object controlled by objectSet and reporting the given condition.
+func mockHookObject(
+	dc *dynamicCacheMock, objectSet genericObjectSet, name, conditionType string,
+) {
+	dc.
+		On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*unstructured.Unstructured"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(2).(*unstructured.Unstructured)
+			obj.SetName(name)
+			obj.SetNamespace("test-ns")
+			if err := ownerhandling.NewNative(testScheme).
+				SetControllerReference(objectSet.ClientObject(), obj); err != nil {
+				panic(err)
+			}
+			if len(conditionType) > 0 {
+				_ = unstructured.SetNestedSlice(obj.Object, []any{
+					map[string]any{"type": conditionType, "status": "True"},
+				}, "status", "conditions")
+			}
+		}).
+		Return(nil)
+}
+
+// Mocks a lookup of the hook namespace in the given phase.
+func mockHookNamespace(c *testutil.CtrlClient, phase corev1.NamespacePhase) {
+	c.
+		On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.Namespace"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			ns := args.Get(2).(*corev1.Namespace)
+			ns.Name = "test-ns"
+			ns.Status.Phase = phase
+		}).
+		Return(nil)
+}
+
+func TestHooksReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates missing hook", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		dc := &dynamicCacheMock{}
+		r := newHooksReconciler(testScheme, c, dc)
+
+		objectSet := newHookObjectSet(
+			corev1alpha1.ObjectSetHook{
+				Type:   corev1alpha1.ObjectSetHookTypePreUpgrade,
+				Object: newHookJob("upgrade"),
+			},
+			corev1alpha1.ObjectSetHook{
+				Type:   corev1alpha1.ObjectSetHookTypePreInstall,
+				Object: newHookJob("install"),
+			},
+		)
+
+		dc.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		dc.
+			On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+		c.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		res, err := r.Reconcile(context.Background(), objectSet)
+		require.NoError(t, err)
+		assert.Equal(t, hooksReconcilerRequeueDelay, res.RequeueAfter)
+
+		c.AssertNumberOfCalls(t, "Create", 1)
+		created := c.Calls[0].Arguments.Get(1).(*unstructured.Unstructured)
+		assert.Equal(t, "install", created.GetName())
+		assert.Equal(t, "test-ns", created.GetNamespace())
+		assert.Equal(t, "True", created.GetLabels()["package-operator.run/cache"])
+		// Hooks are owned via native ownerReferences, so they are garbage collected with the ObjectSet.
+		assert.Equal(t, []metav1.OwnerReference{{
+			APIVersion:         corev1alpha1.GroupVersion.String(),
+			Kind:               "ObjectSet",
+			Name:               "test",
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		}}, created.GetOwnerReferences())
+
+		cond := meta.FindStatusCondition(objectSet.Status.Conditions, corev1alpha1.ObjectSetAvailable)
+		require.NotNil(t, cond)
+		assert.Equal(t, "HookInProgress", cond.Reason)
+	})
+
+	t.Run("records and deletes succeeded hook", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		dc := &dynamicCacheMock{}
+		r := newHooksReconciler(testScheme, c, dc)
+
+		objectSet := newHookObjectSet(corev1alpha1.ObjectSetHook{
+			Type:            corev1alpha1.ObjectSetHookTypePreInstall,
+			Object:          newHookJob("install"),
+			RetentionPolicy: corev1alpha1.ObjectSetHookRetentionPolicyDeleteOnSuccess,
+		})
+
+		dc.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockHookObject(dc, objectSet, "install", "Complete")
+		c.StatusMock.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		c.On("Delete", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		res, err := r.Reconcile(context.Background(), objectSet)
+		require.NoError(t, err)
+		assert.True(t, res.IsZero())
+
+		assert.Equal(t, []corev1alpha1.ObjectSetHookStatus{
+			{
+				Type: corev1alpha1.ObjectSetHookTypePreInstall,
+				Object: corev1alpha1.ControlledObjectReference{
+					Kind: "Job", Group: "batch", Name: "install", Namespace: "test-ns",
+				},
+				Result: corev1alpha1.ObjectSetHookResultSucceeded,
+			},
+		}, objectSet.Status.Hooks)
+		c.StatusMock.AssertNumberOfCalls(t, "Update", 1)
+		c.AssertNumberOfCalls(t, "Delete", 1)
+
+		// Recorded hooks are not run again.
+		res, err = r.Reconcile(context.Background(), objectSet)
+		require.NoError(t, err)
+		assert.True(t, res.IsZero())
+		dc.AssertNumberOfCalls(t, "Get", 1)
+	})
+
+	t.Run("blocks on failed hook", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		dc := &dynamicCacheMock{}
+		r := newHooksReconciler(testScheme, c, dc)
+
+		objectSet := newHookObjectSet(corev1alpha1.ObjectSetHook{
+			Type:          corev1alpha1.ObjectSetHookTypePreInstall,
+			Object:        newHookJob("install"),
+			FailurePolicy: corev1alpha1.ObjectSetHookFailurePolicyAbort,
+		})
+
+		dc.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockHookObject(dc, objectSet, "install", "Failed")
+
+		res, err := r.Reconcile(context.Background(), objectSet)
+		require.NoError(t, err)
+		assert.Equal(t, hooksReconcilerRequeueDelay, res.RequeueAfter)
+		assert.Empty(t, objectSet.Status.Hooks)
+
+		cond := meta.FindStatusCondition(objectSet.Status.Conditions, corev1alpha1.ObjectSetAvailable)
+		require.NotNil(t, cond)
+		assert.Equal(t, "HookFailed", cond.Reason)
+	})
+
+	t.Run("skipped while paused", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		dc := &dynamicCacheMock{}
+		r := newHooksReconciler(testScheme, c, dc)
+
+		objectSet := newHookObjectSet(corev1alpha1.ObjectSetHook{
+			Type:   corev1alpha1.ObjectSetHookTypePreInstall,
+			Object: newHookJob("install"),
+		})
+		objectSet.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStatePaused
+
+		res, err := r.Reconcile(context.Background(), objectSet)
+		require.NoError(t, err)
+		assert.True(t, res.IsZero())
+		dc.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("replaces hook of previous revision", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		dc := &dynamicCacheMock{}
+		r := newHooksReconciler(testScheme, c, dc)
+
+		objectSet := newHookObjectSet(corev1alpha1.ObjectSetHook{
+			Type:            corev1alpha1.ObjectSetHookTypePreUpgrade,
+			Object:          newHookJob("upgrade"),
+			RetentionPolicy: corev1alpha1.ObjectSetHookRetentionPolicyRetain,
+		})
+		objectSet.Spec.Previous = []corev1alpha1.PreviousRevisionReference{{Name: "test-1"}}
+		previous := newHookObjectSet()
+		previous.Name = "test-1"
+
+		dc.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockHookObject(dc, previous, "upgrade", "Complete")
+		c.On("Delete", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		res, err := r.Reconcile(context.Background(), objectSet)
+		require.NoError(t, err)
+		assert.Equal(t, hooksReconcilerRequeueDelay, res.RequeueAfter)
+		assert.Empty(t, objectSet.Status.Hooks)
+		c.AssertNumberOfCalls(t, "Delete", 1)
+	})
+
+	t.Run("hook controlled by someone else", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		dc := &dynamicCacheMock{}
+		r := newHooksReconciler(testScheme, c, dc)
+
+		objectSet := newHookObjectSet(corev1alpha1.ObjectSetHook{
+			Type:   corev1alpha1.ObjectSetHookTypePreInstall,
+			Object: newHookJob("install"),
+		})
+		other := newHookObjectSet()
+		other.Name = "other"
+
+		dc.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockHookObject(dc, other, "install", "")
+
+		_, err := r.Reconcile(context.Background(), objectSet)
+		require.ErrorIs(t, err, errHookNotControlled)
+		c.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestHooksReconciler_Teardown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not deleted", func(t *testing.T) {
+		t.Parallel()
+
+		r := newHooksReconciler(testScheme, testutil.NewClient(), &dynamicCacheMock{})
+		objectSet := newHookObjectSet(corev1alpha1.ObjectSetHook{
+			Type:   corev1alpha1.ObjectSetHookTypePreDelete,
+			Object: newHookJob("cleanup"),
+		})
+
+		done, err := r.Teardown(context.Background(), objectSet)
+		require.NoError(t, err)
+		assert.True(t, done)
+	})
+
+	for _, tc := range []struct {
+		name          string
+		conditionType string
+		failurePolicy corev1alpha1.ObjectSetHookFailurePolicy
+		done          bool
+		err           error
+	}{
+		{name: "running", done: false},
+		{name: "succeeded", conditionType: "Complete", done: true},
+		{
+			name: "failure ignored", conditionType: "Failed", done: true,
+			failurePolicy: corev1alpha1.ObjectSetHookFailurePolicyIgnore,
+		},
+		{
+			name: "failed", conditionType: "Failed", done: false, err: errHookFailed,
+			failurePolicy: corev1alpha1.ObjectSetHookFailurePolicyAbort,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.NewClient()
+			dc := &dynamicCacheMock{}
+			r := newHooksReconciler(testScheme, c, dc)
+			objectSet := newHookObjectSet(corev1alpha1.ObjectSetHook{
+				Type:          corev1alpha1.ObjectSetHookTypePreDelete,
+				Object:        newHookJob("cleanup"),
+				FailurePolicy: tc.failurePolicy,
+			})
+			now := metav1.Now()
+			objectSet.DeletionTimestamp = &now
+
+			mockHookNamespace(c, corev1.NamespaceActive)
+			dc.On("Watch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			mockHookObject(dc, objectSet, "cleanup", tc.conditionType)
+
+			done, err := r.Teardown(context.Background(), objectSet)
+			require.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.done, done)
+		})
+	}
+}
+
+func TestHooksReconciler_Teardown_NamespaceTerminating(t *testing.T) {
+	t.Parallel()
+
+	c := testutil.NewClient()
+	dc := &dynamicCacheMock{}
+	r := newHooksReconciler(testScheme, c, dc)
+	objectSet := newHookObjectSet(corev1alpha1.ObjectSetHook{
+		Type:   corev1alpha1.ObjectSetHookTypePreDelete,
+		Object: newHookJob("cleanup"),
+	})
+	now := metav1.Now()
+	objectSet.DeletionTimestamp = &now
+
+	mockHookNamespace(c, corev1.NamespaceTerminating)
+
+	done, err := r.Teardown(context.Background(), objectSet)
+	require.NoError(t, err)
+	assert.True(t, done)
+	dc.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything, mock.Anything)
+
+	cond := meta.FindStatusCondition(objectSet.Status.Conditions, corev1alpha1.ObjectSetHooksSkipped)
+	require.NotNil(t, cond)
+	assert.Equal(t, "NamespaceTerminating", cond.Reason)
+}
@@ -38,6 +38,7 @@ type GenericObjectSetController struct {
 
 	recorder        metricsRecorder
 	dynamicCache    dynamicCache
+	ownerStrategy   ownerhandling.OwnerStrategy // ownership of objects in phases.
 	teardownHandler teardownHandler
 }
 
@@ -67,13 +68,14 @@ func NewObjectSetController(
 	scheme *runtime.Scheme,
 	dw dynamicCache, uc client.Reader,
 	r metricsRecorder, restMapper meta.RESTMapper,
+	ownerStrategy ownerhandling.OwnerStrategy,
 ) *GenericObjectSetController {
 	return newGenericObjectSetController(
 		newGenericObjectSet,
 		newGenericObjectSetPhase,
 		adapters.NewObjectSlice,
 		c, log, scheme, dw, uc, r,
-		restMapper,
+		restMapper, ownerStrategy,
 	)
 }
 
@@ -82,13 +84,14 @@ func NewClusterObjectSetController(
 	scheme *runtime.Scheme,
 	dw dynamicCache, uc client.Reader,
 	r metricsRecorder, restMapper meta.RESTMapper,
+	ownerStrategy ownerhandling.OwnerStrategy,
 ) *GenericObjectSetController {
 	return newGenericObjectSetController(
 		newGenericClusterObjectSet,
 		newGenericClusterObjectSetPhase,
 		adapters.NewClusterObjectSlice,
 		c, log, scheme, dw, uc, r,
-		restMapper,
+		restMapper, ownerStrategy,
 	)
 }
 
@@ -100,16 +103,18 @@ func newGenericObjectSetController(
 	scheme *runtime.Scheme,
 	dynamicCache dynamicCache, uncachedClient client.Reader,
 	recorder metricsRecorder, restMapper meta.RESTMapper,
+	ownerStrategy ownerhandling.OwnerStrategy,
 ) *GenericObjectSetController {
 	controller := &GenericObjectSetController{
 		newObjectSet:      newObjectSet,
 		newObjectSetPhase: newObjectSetPhase,
 
-		client:       client,
-		log:          log,
-		scheme:       scheme,
-		dynamicCache: dynamicCache,
-		recorder:     recorder,
+		client:        client,
+		log:           log,
+		scheme:        scheme,
+		dynamicCache:  dynamicCache,
+		ownerStrategy: ownerStrategy,
+		recorder:      recorder,
 	}
 
 	phasesReconciler := newObjectSetPhasesReconciler(
@@ -118,7 +123,7 @@ func newGenericObjectSetController(
 			scheme, client,
 			dynamicCache,
 			uncachedClient,
-			ownerhandling.NewNative(scheme),
+			ownerStrategy,
 			preflight.NewAPIExistence(restMapper,
 				preflight.List{
 					preflight.NewNoOwnerReferences(restMapper),
@@ -133,12 +138,16 @@ func newGenericObjectSetController(
 			scheme, func(s *runtime.Scheme) controllers.PreviousObjectSet {
 				return newObjectSet(s)
 			}, client).Lookup,
+		ownerStrategy,
 		preflight.PhasesCheckerList{
 			preflight.NewObjectDuplicate(),
 		},
+		dynamicCache,
 	)
 
-	controller.teardownHandler = phasesReconciler
+	hooksReconciler := newHooksReconciler(scheme, client, dynamicCache)
+
+	controller.teardownHandler = teardownHandlerList{hooksReconciler, phasesReconciler}
 
 	controller.reconciler = []reconciler{
 		&revisionReconciler{
@@ -147,6 +156,7 @@ func newGenericObjectSetController(
 			newObjectSet: newObjectSet,
 		},
 		newObjectSliceLoadReconciler(scheme, client, newObjectSlice),
+		hooksReconciler,
 		phasesReconciler,
 	}
 
@@ -156,13 +166,18 @@ func newGenericObjectSetController(
 func (c *GenericObjectSetController) SetupWithManager(mgr ctrl.Manager) error {
 	objectSet := c.newObjectSet(c.scheme).ClientObject()
 	objectSetPhase := c.newObjectSetPhase(c.scheme).ClientObject()
-
-	return ctrl.NewControllerManagedBy(mgr).
-		For(objectSet, builder.WithPredicates(&predicate.GenerationChangedPredicate{})).
+	ownerHandler := c.ownerStrategy.EnqueueRequestForOwner(objectSet, mgr.GetRESTMapper(), false)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(objectSet, builder.WithPredicates(predicate.Or(
+			predicate.GenerationChangedPredicate{},
+			// picks up the rollback-from annotation.
+			predicate.AnnotationChangedPredicate{},
+		))).
 		Owns(objectSetPhase).
 		WatchesRawSource(
 			c.dynamicCache.Source(
-				handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), objectSet),
+				ownerHandler,
 				predicate.NewPredicateFuncs(func(object client.Object) bool {
 					c.log.Info(
 						"processing dynamic cache event",
@@ -171,8 +186,12 @@ func (c *GenericObjectSetController) SetupWithManager(mgr ctrl.Manager) error {
 					return true
 				}),
 			),
-		).
-		Complete(c)
+		)
+	if ownerhandling.RecordsObjectOwnerships(c.ownerStrategy) {
+		// Owned objects do not change when their owners do.
+		b = b.Watches(&corev1alpha1.ObjectOwnership{}, ownerHandler)
+	}
+	return b.Complete(c)
 }
 
 func (c *GenericObjectSetController) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
@@ -3,6 +3,7 @@ package objectsets
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -16,7 +17,6 @@ import (
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/controllers"
-	"package-operator.run/internal/ownerhandling"
 	"package-operator.run/internal/preflight"
 	internalprobing "package-operator.run/internal/probing"
 	"package-operator.run/pkg/probing"
@@ -31,7 +31,9 @@ type objectSetPhasesReconciler struct {
 	lookupPreviousRevisions lookupPreviousRevisions
 	ownerStrategy           ownerStrategy
 	preflightChecker        phasesChecker
-	backoff                 *flowcontrol.Backoff
+	// Provides objects referenced by probes.
+	probeCache internalprobing.ObjectCache
+	backoff    *flowcontrol.Backoff
 }
 
 type ownerStrategy interface {
@@ -50,7 +52,9 @@ func newObjectSetPhasesReconciler(
 	phaseReconciler phaseReconciler,
 	remotePhase remotePhaseReconciler,
 	lookupPreviousRevisions lookupPreviousRevisions,
+	ownerStrategy ownerStrategy,
 	checker phasesChecker,
+	probeCache internalprobing.ObjectCache,
 	opts ...objectSetPhasesReconcilerOption,
 ) *objectSetPhasesReconciler {
 	var cfg objectSetPhasesReconcilerConfig
@@ -64,8 +68,9 @@ func newObjectSetPhasesReconciler(
 		phaseReconciler:         phaseReconciler,
 		remotePhase:             remotePhase,
 		lookupPreviousRevisions: lookupPreviousRevisions,
-		ownerStrategy:           ownerhandling.NewNative(scheme),
+		ownerStrategy:           ownerStrategy,
 		preflightChecker:        checker,
+		probeCache:              probeCache,
 		backoff:                 cfg.GetBackoff(),
 	}
 }
@@ -90,7 +95,10 @@ type phaseReconciler interface {
 		ctx context.Context, owner controllers.PhaseObjectOwner,
 		phase corev1alpha1.ObjectSetTemplatePhase,
 		probe probing.Prober, previous []controllers.PreviousObjectSet,
-	) ([]client.Object, controllers.ProbingResult, error)
+	) (
+		[]client.Object, controllers.ProbingResult, []corev1alpha1.DriftedObject,
+		[]corev1alpha1.ControlledObjectReference, error,
+	)
 
 	TeardownPhase(
 		ctx context.Context, owner controllers.PhaseObjectOwner,
@@ -116,7 +124,7 @@ func (r *objectSetPhasesReconciler) Reconcile(
 
 	controllers.DeleteMappedConditions(ctx, objectSet.GetConditions())
 
-	controllerOf, probingResult, err := r.reconcile(ctx, objectSet)
+	controllerOf, probingResult, drifted, wouldAdopt, err := r.reconcile(ctx, objectSet)
 	if controllers.IsExternalResourceNotFound(err) {
 		id := string(objectSet.ClientObject().GetUID())
 
@@ -129,6 +137,15 @@ func (r *objectSetPhasesReconciler) Reconcile(
 		return res, err
 	}
 	objectSet.SetStatusControllerOf(controllerOf)
+	setDriftedStatus(objectSet, drifted)
+	controllers.SetAdoptionDryRunCondition(
+		objectSet.GetConditions(), objectSet.ClientObject().GetGeneration(), wouldAdopt)
+	objectSet.SetStatusProbedObjects(controllers.UpdateObjectProbeStatus(
+		objectSet.GetStatusProbedObjects(), probingResult.Objects, metav1.NewTime(r.cfg.Clock.Now())))
+
+	if internalprobing.RequiresPolling(objectSet.GetAvailabilityProbes()) {
+		res.RequeueAfter = internalprobing.PollInterval
+	}
 
 	inTransition := isObjectSetInTransition(objectSet, controllerOf)
 	if inTransition {
@@ -184,36 +201,44 @@ func (r *objectSetPhasesReconciler) Reconcile(
 
 func (r *objectSetPhasesReconciler) reconcile(
 	ctx context.Context, objectSet genericObjectSet,
-) ([]corev1alpha1.ControlledObjectReference, controllers.ProbingResult, error) {
+) (
+	controllerOfAll []corev1alpha1.ControlledObjectReference, res controllers.ProbingResult,
+	driftedAll []corev1alpha1.DriftedObject, wouldAdoptAll []corev1alpha1.ControlledObjectReference, err error,
+) {
 	previous, err := r.lookupPreviousRevisions(ctx, objectSet)
 	if err != nil {
-		return nil, controllers.ProbingResult{}, fmt.Errorf("lookup previous revisions: %w", err)
+		return nil, res, nil, nil, fmt.Errorf("lookup previous revisions: %w", err)
 	}
 
 	probe, err := internalprobing.Parse(
-		ctx, objectSet.GetAvailabilityProbes())
+		ctx, objectSet.GetAvailabilityProbes(),
+		internalprobing.NewCacheObjectResolver(ctx, r.probeCache, objectSet.ClientObject()))
 	if err != nil {
-		return nil, controllers.ProbingResult{}, fmt.Errorf("parsing probes: %w", err)
+		return nil, res, nil, nil, fmt.Errorf("parsing probes: %w", err)
 	}
 
-	var controllerOfAll []corev1alpha1.ControlledObjectReference
+	var probedAll []corev1alpha1.ObjectProbeStatus
 	for _, phase := range objectSet.GetPhases() {
-		controllerOf, probingResult, err := r.reconcilePhase(
+		controllerOf, probingResult, drifted, wouldAdopt, err := r.reconcilePhase(
 			ctx, objectSet, phase, probe, previous)
 		if err != nil {
-			return nil, controllers.ProbingResult{}, err
+			return nil, res, nil, nil, err
 		}
 
 		// always gather all objects we are controller of
 		controllerOfAll = append(controllerOfAll, controllerOf...)
+		driftedAll = append(driftedAll, drifted...)
+		wouldAdoptAll = append(wouldAdoptAll, wouldAdopt...)
+		probedAll = append(probedAll, probingResult.Objects...)
 
 		if !probingResult.IsZero() {
 			// break on first failing probe
-			return controllerOfAll, probingResult, nil
+			probingResult.Objects = probedAll
+			return controllerOfAll, probingResult, driftedAll, wouldAdoptAll, nil
 		}
 	}
 
-	return controllerOfAll, controllers.ProbingResult{}, nil
+	return controllerOfAll, controllers.ProbingResult{Objects: probedAll}, driftedAll, wouldAdoptAll, nil
 }
 
 func (r *objectSetPhasesReconciler) reconcilePhase(
@@ -221,10 +246,15 @@ func (r *objectSetPhasesReconciler) reconcilePhase(
 	phase corev1alpha1.ObjectSetTemplatePhase,
 	probe probing.Prober,
 	previous []controllers.PreviousObjectSet,
-) ([]corev1alpha1.ControlledObjectReference, controllers.ProbingResult, error) {
+) (
+	[]corev1alpha1.ControlledObjectReference, controllers.ProbingResult,
+	[]corev1alpha1.DriftedObject, []corev1alpha1.ControlledObjectReference, error,
+) {
 	if len(phase.Class) > 0 {
-		return r.remotePhase.Reconcile(
+		// Drift of remote phases is not reported, adoption dry runs are reported by the ObjectSetPhase.
+		controllerOf, probingResult, err := r.remotePhase.Reconcile(
 			ctx, objectSet, phase)
+		return controllerOf, probingResult, nil, nil, err
 	}
 	return r.reconcileLocalPhase(
 		ctx, objectSet, phase, probe, previous)
@@ -236,20 +266,46 @@ func (r *objectSetPhasesReconciler) reconcileLocalPhase(
 	phase corev1alpha1.ObjectSetTemplatePhase,
 	probe probing.Prober,
 	previous []controllers.PreviousObjectSet,
-) ([]corev1alpha1.ControlledObjectReference, controllers.ProbingResult, error) {
-	actualObjects, probingResult, err := r.phaseReconciler.ReconcilePhase(
+) (
+	[]corev1alpha1.ControlledObjectReference, controllers.ProbingResult,
+	[]corev1alpha1.DriftedObject, []corev1alpha1.ControlledObjectReference, error,
+) {
+	actualObjects, probingResult, drifted, wouldAdopt, err := r.phaseReconciler.ReconcilePhase(
 		ctx, objectSet, phase, probe, previous)
 	if err != nil {
-		return nil, probingResult, err
+		return nil, probingResult, nil, nil, err
 	}
 
 	controllerOf, err := controllers.GetControllerOf(
 		ctx, r.scheme, r.ownerStrategy,
 		objectSet.ClientObject(), actualObjects)
 	if err != nil {
-		return nil, controllers.ProbingResult{}, err
+		return nil, controllers.ProbingResult{}, nil, nil, err
+	}
+	return controllerOf, probingResult, drifted, wouldAdopt, nil
+}
+
+// Reports drifted objects in status and via the Drifted condition.
+func setDriftedStatus(objectSet genericObjectSet, drifted []corev1alpha1.DriftedObject) {
+	objectSet.SetStatusDriftedObjects(drifted)
+	if len(drifted) == 0 {
+		meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetDrifted)
+		return
+	}
+
+	refs := make([]string, len(drifted))
+	for i, d := range drifted {
+		refs[i] = fmt.Sprintf("%s %s", d.Object.Kind, client.ObjectKey{
+			Name: d.Object.Name, Namespace: d.Object.Namespace,
+		})
 	}
-	return controllerOf, probingResult, nil
+	meta.SetStatusCondition(objectSet.GetConditions(), metav1.Condition{
+		Type:               corev1alpha1.ObjectSetDrifted,
+		Status:             metav1.ConditionTrue,
+		Reason:             "DriftDetected",
+		Message:            "Objects differ from their desired state: " + strings.Join(refs, ", "),
+		ObservedGeneration: objectSet.ClientObject().GetGeneration(),
+	})
 }
 
 func (r *objectSetPhasesReconciler) Teardown(
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -13,10 +14,13 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/ownerhandling"
 	"package-operator.run/internal/preflight"
 	"package-operator.run/internal/testutil/controllersmocks"
 )
@@ -65,7 +69,8 @@ func TestObjectSetPhasesReconciler_Reconcile(t *testing.T) {
 		return []controllers.PreviousObjectSet{}, nil
 	}
 	checker := &phasesCheckerMock{}
-	r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker)
+	r := newObjectSetPhasesReconciler(
+		testScheme, pr, remotePr, lookup, ownerhandling.NewNative(testScheme), checker, &dynamicCacheMock{})
 
 	phase1 := corev1alpha1.ObjectSetTemplatePhase{
 		Name: "phase1",
@@ -82,7 +87,7 @@ func TestObjectSetPhasesReconciler_Reconcile(t *testing.T) {
 	}
 
 	pr.On("ReconcilePhase", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return([]client.Object{}, controllers.ProbingResult{}, nil)
+		Return([]client.Object{}, controllers.ProbingResult{}, nil, nil, nil)
 	remotePr.On("Reconcile", mock.Anything, mock.Anything, mock.Anything).
 		Return([]corev1alpha1.ControlledObjectReference{}, controllers.ProbingResult{}, nil)
 	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
@@ -118,7 +123,8 @@ func TestPhaseReconciler_ReconcileBackoff(t *testing.T) {
 		return []controllers.PreviousObjectSet{}, nil
 	}
 	checker := &phasesCheckerMock{}
-	r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker)
+	r := newObjectSetPhasesReconciler(
+		testScheme, pr, remotePr, lookup, ownerhandling.NewNative(testScheme), checker, &dynamicCacheMock{})
 
 	os := &GenericObjectSet{}
 	os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{
@@ -128,7 +134,7 @@ func TestPhaseReconciler_ReconcileBackoff(t *testing.T) {
 	}
 
 	pr.On("ReconcilePhase", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return([]client.Object{}, controllers.ProbingResult{}, controllers.NewExternalResourceNotFoundError(nil))
+		Return([]client.Object{}, controllers.ProbingResult{}, nil, nil, controllers.NewExternalResourceNotFoundError(nil))
 	remotePr.On("Reconcile", mock.Anything, mock.Anything, mock.Anything).
 		Return([]corev1alpha1.ControlledObjectReference{}, controllers.ProbingResult{}, nil)
 	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
@@ -168,7 +174,8 @@ func TestObjectSetPhasesReconciler_Teardown(t *testing.T) {
 				return []controllers.PreviousObjectSet{}, nil
 			}
 			checker := &phasesCheckerMock{}
-			r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker)
+			r := newObjectSetPhasesReconciler(
+				testScheme, pr, remotePr, lookup, ownerhandling.NewNative(testScheme), checker, &dynamicCacheMock{})
 
 			phase1 := corev1alpha1.ObjectSetTemplatePhase{
 				Name: "phase1",
@@ -283,13 +290,13 @@ func TestObjectSetPhasesReconciler_SuccessDelay(t *testing.T) {
 
 			cm.On("Now").Return(time.Now().Add(tc.TimeSinceAvailable))
 			prm.On("ReconcilePhase", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-				Return([]client.Object{}, controllers.ProbingResult{}, nil)
+				Return([]client.Object{}, controllers.ProbingResult{}, nil, nil, nil)
 			rprm.On("Reconcile", mock.Anything, mock.Anything, mock.Anything).
 				Return([]corev1alpha1.ControlledObjectReference{}, controllers.ProbingResult{}, nil)
 			checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
 
 			rec := newObjectSetPhasesReconciler(
-				testScheme, prm, rprm, lookup, checker,
+				testScheme, prm, rprm, lookup, ownerhandling.NewNative(testScheme), checker, &dynamicCacheMock{},
 				withClock{
 					Clock: cm,
 				},
@@ -317,6 +324,32 @@ func (m *clockMock) Now() time.Time {
 	return args.Get(0).(time.Time)
 }
 
+func Test_setDriftedStatus(t *testing.T) {
+	t.Parallel()
+
+	objectSet := newGenericObjectSet(testScheme)
+	drifted := []corev1alpha1.DriftedObject{
+		{
+			Object: corev1alpha1.ControlledObjectReference{
+				Kind: "ConfigMap", Name: "cm", Namespace: "test-ns",
+			},
+			Fields:      []string{"data.key"},
+			ObserveOnly: true,
+		},
+	}
+
+	setDriftedStatus(objectSet, drifted)
+	assert.Equal(t, drifted, objectSet.GetStatusDriftedObjects())
+	cond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetDrifted)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "Objects differ from their desired state: ConfigMap test-ns/cm", cond.Message)
+
+	setDriftedStatus(objectSet, nil)
+	assert.Empty(t, objectSet.GetStatusDriftedObjects())
+	assert.Nil(t, meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetDrifted))
+}
+
 func Test_isObjectSetInTransition(t *testing.T) {
 	t.Parallel()
 
@@ -393,3 +426,153 @@ func Test_isObjectSetInTransition(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectSetPhasesReconciler_Reconcile_probedObjects(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	remoteTransition := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	cm := &clockMock{}
+	pr := &phaseReconcilerMock{}
+	remotePr := &remotePhaseReconcilerMock{}
+	lookup := func(_ context.Context, _ controllers.PreviousOwner) ([]controllers.PreviousObjectSet, error) {
+		return []controllers.PreviousObjectSet{}, nil
+	}
+	checker := &phasesCheckerMock{}
+	r := newObjectSetPhasesReconciler(
+		testScheme, pr, remotePr, lookup, ownerhandling.NewNative(testScheme), checker, &dynamicCacheMock{},
+		withClock{Clock: cm})
+
+	os := &GenericObjectSet{}
+	os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{
+		{Name: "phase1"},
+		{Name: "phase2", Class: "class"},
+		{Name: "phase3"},
+	}
+
+	cm.On("Now").Return(now)
+	pr.On("ReconcilePhase", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]client.Object{}, controllers.ProbingResult{
+			Objects: []corev1alpha1.ObjectProbeStatus{{
+				Version: "v1", Kind: "ConfigMap", Name: "cm", Status: metav1.ConditionTrue,
+			}},
+		}, nil, nil, nil).Once()
+	remotePr.On("Reconcile", mock.Anything, mock.Anything, mock.Anything).
+		Return([]corev1alpha1.ControlledObjectReference{}, controllers.ProbingResult{
+			PhaseName:    "phase2",
+			FailedProbes: []string{"apps Deployment /deploy: not ready"},
+			Objects: []corev1alpha1.ObjectProbeStatus{{
+				Group: "apps", Version: "v1", Kind: "Deployment", Name: "deploy",
+				Status: metav1.ConditionFalse, Message: "not ready", LastTransitionTime: remoteTransition,
+			}},
+		}, nil)
+	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
+
+	_, err := r.Reconcile(context.Background(), os)
+	require.NoError(t, err)
+
+	// phase3 is not probed, because phase2 failed.
+	pr.AssertNumberOfCalls(t, "ReconcilePhase", 1)
+	assert.Equal(t, []corev1alpha1.ObjectProbeStatus{
+		{
+			Version: "v1", Kind: "ConfigMap", Name: "cm",
+			Status: metav1.ConditionTrue, LastTransitionTime: metav1.NewTime(now),
+		},
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "deploy",
+			Status: metav1.ConditionFalse, Message: "not ready", LastTransitionTime: remoteTransition,
+		},
+	}, os.Status.ProbedObjects)
+}
+
+func TestObjectSetPhasesReconciler_Reconcile_ownershipStrategy(t *testing.T) {
+	t.Parallel()
+
+	c := fake.NewClientBuilder().WithScheme(testScheme).Build()
+	strategy, err := ownerhandling.NewOwnership(
+		logr.Discard(), testScheme, c, c, &controllertest.FakeInformer{Synced: true})
+	require.NoError(t, err)
+
+	os := &GenericObjectSet{}
+	os.Name = "test"
+	os.Namespace = "test"
+	os.UID = "test-uid"
+
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetName("cm")
+	cm.SetNamespace("test")
+	os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{{
+		Name:    "phase1",
+		Objects: []corev1alpha1.ObjectSetObject{{Object: *cm.DeepCopy()}},
+	}}
+
+	ctx := context.Background()
+	require.NoError(t, strategy.SetControllerReference(os.ClientObject(), cm))
+	require.NoError(t, strategy.PersistOwners(ctx, cm))
+	// The controller is only recorded in an ObjectOwnership.
+	require.Empty(t, cm.GetOwnerReferences())
+
+	pr := &phaseReconcilerMock{}
+	remotePr := &remotePhaseReconcilerMock{}
+	lookup := func(_ context.Context, _ controllers.PreviousOwner) ([]controllers.PreviousObjectSet, error) {
+		return []controllers.PreviousObjectSet{}, nil
+	}
+	checker := &phasesCheckerMock{}
+	r := newObjectSetPhasesReconciler(
+		testScheme, pr, remotePr, lookup, strategy, checker, &dynamicCacheMock{})
+
+	pr.On("ReconcilePhase", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]client.Object{cm}, controllers.ProbingResult{}, nil, nil, nil)
+	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
+
+	_, err = r.Reconcile(ctx, os)
+	require.NoError(t, err)
+
+	assert.Equal(t, []corev1alpha1.ControlledObjectReference{
+		{Kind: "ConfigMap", Name: "cm", Namespace: "test"},
+	}, os.Status.ControllerOf)
+	// Controlling all objects, the ObjectSet is not in transition.
+	assert.False(t, meta.IsStatusConditionTrue(os.Status.Conditions, corev1alpha1.ObjectSetInTransition))
+}
+
+func TestObjectSetPhasesReconciler_Reconcile_adoptionDryRun(t *testing.T) {
+	t.Parallel()
+
+	pr := &phaseReconcilerMock{}
+	remotePr := &remotePhaseReconcilerMock{}
+	lookup := func(_ context.Context, _ controllers.PreviousOwner) ([]controllers.PreviousObjectSet, error) {
+		return []controllers.PreviousObjectSet{}, nil
+	}
+	checker := &phasesCheckerMock{}
+	r := newObjectSetPhasesReconciler(
+		testScheme, pr, remotePr, lookup, ownerhandling.NewNative(testScheme), checker, &dynamicCacheMock{})
+
+	phase1 := corev1alpha1.ObjectSetTemplatePhase{Name: "phase1"}
+	phase2 := corev1alpha1.ObjectSetTemplatePhase{Name: "phase2"}
+	os := &GenericObjectSet{}
+	os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{phase1, phase2}
+
+	wouldAdopt := []corev1alpha1.ControlledObjectReference{
+		{Group: "apps", Kind: "Deployment", Name: "deploy", Namespace: "test"},
+	}
+	pr.On("ReconcilePhase", mock.Anything, mock.Anything, phase1, mock.Anything, mock.Anything).
+		Return([]client.Object{}, controllers.ProbingResult{}, nil, wouldAdopt, nil)
+	pr.On("ReconcilePhase", mock.Anything, mock.Anything, phase2, mock.Anything, mock.Anything).
+		Return([]client.Object{}, controllers.ProbingResult{}, nil, nil, nil)
+	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
+
+	_, err := r.Reconcile(context.Background(), os)
+	require.NoError(t, err)
+
+	// Objects that would be adopted do not block later phases or availability.
+	pr.AssertCalled(t, "ReconcilePhase", mock.Anything, mock.Anything, phase2, mock.Anything, mock.Anything)
+	assert.True(t, meta.IsStatusConditionTrue(os.Status.Conditions, corev1alpha1.ObjectSetAvailable))
+
+	cond := meta.FindStatusCondition(os.Status.Conditions, corev1alpha1.ObjectSetAdoptionDryRun)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "Objects would be adopted: Deployment test/deploy", cond.Message)
+}
@@ -190,9 +190,10 @@ func (r *objectSetRemotePhaseReconciler) Reconcile(
 			},
 		}, nil
 	}
+	probedObjects := currentObjectSetPhase.GetStatusProbedObjects()
 	if availableCond.Status == metav1.ConditionTrue {
 		// Remote Phase is Available!
-		return activeObjects, controllers.ProbingResult{}, nil
+		return activeObjects, controllers.ProbingResult{Objects: probedObjects}, nil
 	}
 
 	// Remote Phase is not Available!
@@ -202,6 +203,7 @@ func (r *objectSetRemotePhaseReconciler) Reconcile(
 		FailedProbes: []string{
 			availableCond.Message,
 		},
+		Objects: probedObjects,
 	}, nil
 }
 
@@ -220,6 +222,7 @@ func (r *objectSetRemotePhaseReconciler) desiredObjectSetPhase(
 
 	desiredObjectSetPhase.SetPhase(phase)
 	desiredObjectSetPhase.SetAvailabilityProbes(objectSet.GetAvailabilityProbes())
+	desiredObjectSetPhase.SetAdoptionPolicy(objectSet.GetAdoptionPolicy())
 	desiredObjectSetPhase.SetRevision(objectSet.GetRevision())
 	desiredObjectSetPhase.SetPrevious(objectSet.GetPrevious())
 	if objectSet.IsPaused() {
@@ -0,0 +1,35 @@
+package packages
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+)
+
+// ConfigSourceConfig configures how config sources referenced via .spec.configFrom are looked up.
+type ConfigSourceConfig struct {
+	// Namespace ClusterPackage config sources are looked up in.
+	Namespace string
+}
+
+func (c ConfigSourceConfig) ConfigureUnpackReconciler(cfg *unpackReconcilerConfig) {
+	cfg.ConfigSources = c
+}
+
+// Returns the "<kind>/<namespace>/<name>" keys of all config sources referenced by the package.
+func (c ConfigSourceConfig) packageSources(pkg adapters.GenericPackageAccessor) []string {
+	namespace := pkg.ClientObject().GetNamespace()
+	if len(namespace) == 0 {
+		namespace = c.Namespace
+	}
+	keys := make([]string, 0, len(pkg.GetConfigFrom()))
+	for _, src := range pkg.GetConfigFrom() {
+		keys = append(keys, configSourceKey(src.Kind, client.ObjectKey{Namespace: namespace, Name: src.Name}))
+	}
+	return keys
+}
+
+func configSourceKey(kind corev1alpha1.PackageConfigSourceKind, key client.ObjectKey) string {
+	return string(kind) + "/" + key.String()
+}
@@ -0,0 +1,171 @@
+package packages
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/packages"
+)
+
+// ImagePullSecretConfig configures manager-wide registry credentials for package image pulls.
+type ImagePullSecretConfig struct {
+	// Namespace Package Operator is deployed into.
+	// ClusterPackage pull secrets and the manager-wide Secret
+	// and ServiceAccount are looked up in this namespace.
+	Namespace string
+	// Name of a Secret used for every image pull,
+	// after the pull secrets referenced by the Package itself.
+	DefaultSecret string
+	// Name of a ServiceAccount whose imagePullSecrets are used for every image pull,
+	// after the pull secrets referenced by the Package and the default Secret.
+	// The ServiceAccount is read from the cache.
+	ServiceAccount string
+}
+
+func (c ImagePullSecretConfig) ConfigureUnpackReconciler(cfg *unpackReconcilerConfig) {
+	cfg.ImagePullSecrets = c
+}
+
+// Returns true if the given Secret is used for every image pull,
+// either as the default Secret or via the configured ServiceAccount.
+func (c ImagePullSecretConfig) isManagerWideSecret(
+	ctx context.Context, reader client.Reader, secret client.Object,
+) (bool, error) {
+	if secret.GetNamespace() != c.Namespace {
+		return false, nil
+	}
+	refs, err := c.managerWideSecrets(ctx, reader)
+	if err != nil {
+		return false, err
+	}
+	for _, ref := range refs {
+		if ref.Name == secret.GetName() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Returns references to the default Secret and the imagePullSecrets of the configured ServiceAccount.
+func (c ImagePullSecretConfig) managerWideSecrets(
+	ctx context.Context, reader client.Reader,
+) ([]types.NamespacedName, error) {
+	var refs []types.NamespacedName
+	if len(c.DefaultSecret) > 0 {
+		refs = append(refs, types.NamespacedName{
+			Namespace: c.Namespace,
+			Name:      c.DefaultSecret,
+		})
+	}
+	if len(c.ServiceAccount) > 0 {
+		sa := &corev1.ServiceAccount{}
+		if err := reader.Get(ctx, types.NamespacedName{
+			Namespace: c.Namespace,
+			Name:      c.ServiceAccount,
+		}, sa); err != nil {
+			return nil, fmt.Errorf("getting ServiceAccount for image pull secrets: %w", err)
+		}
+		for _, ref := range sa.ImagePullSecrets {
+			refs = append(refs, types.NamespacedName{
+				Namespace: c.Namespace,
+				Name:      ref.Name,
+			})
+		}
+	}
+	return refs, nil
+}
+
+// Returns references to the image pull secrets referenced by the package itself.
+func (c ImagePullSecretConfig) packageSecrets(pkg adapters.GenericPackageAccessor) []types.NamespacedName {
+	pkgNamespace := pkg.ClientObject().GetNamespace()
+	if len(pkgNamespace) == 0 {
+		pkgNamespace = c.Namespace
+	}
+
+	refs := make([]types.NamespacedName, 0, len(pkg.GetImagePullSecrets()))
+	for _, ref := range pkg.GetImagePullSecrets() {
+		refs = append(refs, types.NamespacedName{
+			Namespace: pkgNamespace,
+			Name:      ref.Name,
+		})
+	}
+	return refs
+}
+
+// Resolves the image pull secrets that apply to a package into a keychain.
+type imagePullSecretResolver struct {
+	// Reads the ServiceAccount and Secret metadata from the cache to detect changes of pull secrets.
+	client client.Reader
+	// Reads Secret contents, so Secret data is never cached.
+	uncachedClient client.Reader
+	cfg            ImagePullSecretConfig
+}
+
+// Returns references to all image pull secrets applying to the package.
+func (r *imagePullSecretResolver) secretRefs(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) ([]types.NamespacedName, error) {
+	refs := r.cfg.packageSecrets(pkg)
+	managerWideRefs, err := r.cfg.managerWideSecrets(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	return append(refs, managerWideRefs...), nil
+}
+
+// Revision returns a string identifying the observed state of all image pull secrets applying to the package.
+// The result changes whenever one of the Secrets is updated or recreated.
+// Returns an empty string, if no image pull secrets apply.
+func (r *imagePullSecretResolver) Revision(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (string, error) {
+	refs, err := r.secretRefs(ctx, pkg)
+	if err != nil {
+		return "", err
+	}
+
+	revisions := make([]string, len(refs))
+	for i, ref := range refs {
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+		if err := r.client.Get(ctx, ref, obj); err != nil {
+			return "", fmt.Errorf("getting image pull secret %s: %w", ref, err)
+		}
+		revisions[i] = fmt.Sprintf("%s@%s", ref, obj.GetResourceVersion())
+	}
+	return strings.Join(revisions, ","), nil
+}
+
+// Returns a keychain holding the credentials of all image pull secrets applying to the package.
+// Returns nil, if no image pull secrets apply.
+func (r *imagePullSecretResolver) Keychain(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (packages.Keychain, error) {
+	refs, err := r.secretRefs(ctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	secrets := make([]corev1.Secret, len(refs))
+	for i, ref := range refs {
+		if err := r.uncachedClient.Get(ctx, ref, &secrets[i]); err != nil {
+			return nil, fmt.Errorf("getting image pull secret %s: %w", ref, err)
+		}
+	}
+
+	keychain, err := packages.NewPullSecretKeychain(secrets...)
+	if err != nil {
+		return nil, fmt.Errorf("loading image pull secrets: %w", err)
+	}
+	return keychain, nil
+}
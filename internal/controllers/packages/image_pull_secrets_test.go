@@ -0,0 +1,128 @@
+package packages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/packages"
+	"package-operator.run/internal/testutil"
+)
+
+func TestImagePullSecretResolver_noSecrets(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	uc := testutil.NewClient()
+	r := &imagePullSecretResolver{client: c, uncachedClient: uc}
+
+	revision, err := r.Revision(context.Background(), &adapters.GenericPackage{})
+	require.NoError(t, err)
+	assert.Empty(t, revision)
+
+	keychain, err := r.Keychain(context.Background(), &adapters.GenericPackage{})
+	require.NoError(t, err)
+	assert.Nil(t, keychain)
+	c.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	uc.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImagePullSecretResolver(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	uc := testutil.NewClient()
+	r := &imagePullSecretResolver{
+		client:         c,
+		uncachedClient: uc,
+		cfg: ImagePullSecretConfig{
+			Namespace:      "pko",
+			DefaultSecret:  "default-secret",
+			ServiceAccount: "pko-sa",
+		},
+	}
+
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Namespace: "pko", Name: "pko-sa"}, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sa := args.Get(2).(*corev1.ServiceAccount)
+			sa.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "sa-secret"}}
+		}).
+		Return(nil)
+
+	c.
+		On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.PartialObjectMetadata"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(2).(*metav1.PartialObjectMetadata)
+			obj.SetResourceVersion("42")
+		}).
+		Return(nil)
+
+	var requestedSecrets []client.ObjectKey
+	uc.
+		On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.Secret"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			requestedSecrets = append(requestedSecrets, args.Get(1).(client.ObjectKey))
+			secret := args.Get(2).(*corev1.Secret)
+			secret.Type = corev1.SecretTypeDockerConfigJson
+			secret.Data = map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`),
+			}
+		}).
+		Return(nil)
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+			Spec: corev1alpha1.PackageSpec{
+				ImagePullSecrets: []corev1alpha1.ImagePullSecretReference{{Name: "pkg-secret"}},
+			},
+		},
+	}
+	revision, err := r.Revision(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.Equal(t, "test/pkg-secret@42,pko/default-secret@42,pko/sa-secret@42", revision)
+	uc.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	keychain, err := r.Keychain(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.NotEmpty(t, keychain.ID())
+
+	assert.Equal(t, []client.ObjectKey{
+		{Namespace: "test", Name: "pkg-secret"},
+		{Namespace: "pko", Name: "default-secret"},
+		{Namespace: "pko", Name: "sa-secret"},
+	}, requestedSecrets)
+}
+
+func TestImagePullSecretResolver_clusterPackage(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	uc := testutil.NewClient()
+	r := &imagePullSecretResolver{
+		client:         c,
+		uncachedClient: uc,
+		cfg:            ImagePullSecretConfig{Namespace: "pko"},
+	}
+
+	uc.
+		On("Get", mock.Anything, client.ObjectKey{Namespace: "pko", Name: "pkg-secret"}, mock.Anything, mock.Anything).
+		Return(nil)
+
+	pkg := &adapters.GenericClusterPackage{
+		ClusterPackage: corev1alpha1.ClusterPackage{
+			Spec: corev1alpha1.PackageSpec{
+				ImagePullSecrets: []corev1alpha1.ImagePullSecretReference{{Name: "pkg-secret"}},
+			},
+		},
+	}
+	// Secret is returned empty and has no supported type.
+	_, err := r.Keychain(context.Background(), pkg)
+	require.ErrorIs(t, err, packages.ErrUnsupportedPullSecretType)
+}
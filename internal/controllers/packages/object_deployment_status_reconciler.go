@@ -2,20 +2,32 @@ package packages
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/packages"
 )
 
+// Interval to re-check dependencies that are not available yet.
+const dependencyRequeueInterval = 10 * time.Second
+
 type objectDeploymentStatusReconciler struct {
 	client              client.Client
 	scheme              *runtime.Scheme
+	newPackage          adapters.GenericPackageFactory
 	newObjectDeployment adapters.ObjectDeploymentFactory
 }
 
@@ -43,6 +55,17 @@ func (r *objectDeploymentStatusReconciler) Reconcile(
 		meta.SetStatusCondition(packageObj.GetConditions(), *packageProgressingCond)
 	}
 
+	if objDepPausedCond := meta.FindStatusCondition(
+		*objDep.GetConditions(), corev1alpha1.ObjectDeploymentPaused,
+	); objDepPausedCond != nil {
+		packagePausedCond := objDepPausedCond.DeepCopy()
+		packagePausedCond.ObservedGeneration = packageObj.ClientObject().GetGeneration()
+
+		meta.SetStatusCondition(packageObj.GetConditions(), *packagePausedCond)
+	} else {
+		meta.RemoveStatusCondition(packageObj.GetConditions(), corev1alpha1.PackagePaused)
+	}
+
 	controllers.DeleteMappedConditions(ctx, packageObj.GetConditions())
 	controllers.MapConditions(
 		ctx,
@@ -52,5 +75,66 @@ func (r *objectDeploymentStatusReconciler) Reconcile(
 
 	packageObj.SetStatusRevision(objDep.GetStatusRevision())
 
-	return ctrl.Result{}, nil
+	return r.reconcileDependencies(ctx, packageObj, objDep)
+}
+
+// Ensures dependencies are installed and reports the package as not available,
+// while any of its dependencies is not available.
+func (r *objectDeploymentStatusReconciler) reconcileDependencies(
+	ctx context.Context, packageObj adapters.GenericPackageAccessor, objDep adapters.ObjectDeploymentAccessor,
+) (ctrl.Result, error) {
+	deps := packages.ParseDependencies(
+		objDep.ClientObject().GetAnnotations()[manifestsv1alpha1.PackageDependenciesAnnotation])
+	if len(deps) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	// Dependencies may be deleted or changed after the package was unpacked.
+	err := packages.ReconcileDependencies(ctx, r.client, r.scheme, packageObj, deps)
+	if errors.Is(err, packages.ErrDependencyConflict) {
+		meta.SetStatusCondition(packageObj.GetConditions(), metav1.Condition{
+			Type:               corev1alpha1.PackageAvailable,
+			Status:             metav1.ConditionFalse,
+			Reason:             "DependencyConflict",
+			Message:            err.Error(),
+			ObservedGeneration: packageObj.ClientObject().GetGeneration(),
+		})
+		return ctrl.Result{RequeueAfter: dependencyRequeueInterval}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("reconciling dependencies: %w", err)
+	}
+
+	var unavailable []string
+	for _, d := range deps {
+		dep := r.newPackage(r.scheme)
+		err := r.client.Get(ctx, client.ObjectKey{
+			Name:      d.Name,
+			Namespace: packageObj.ClientObject().GetNamespace(),
+		}, dep.ClientObject())
+		if apimachineryerrors.IsNotFound(err) {
+			unavailable = append(unavailable, d.Name)
+			continue
+		}
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("getting dependency %s: %w", d.Name, err)
+		}
+		if !meta.IsStatusConditionTrue(*dep.GetConditions(), corev1alpha1.PackageAvailable) {
+			unavailable = append(unavailable, d.Name)
+		}
+	}
+	if len(unavailable) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	if meta.IsStatusConditionTrue(*packageObj.GetConditions(), corev1alpha1.PackageAvailable) {
+		meta.SetStatusCondition(packageObj.GetConditions(), metav1.Condition{
+			Type:               corev1alpha1.PackageAvailable,
+			Status:             metav1.ConditionFalse,
+			Reason:             "DependenciesNotAvailable",
+			Message:            "Waiting for dependencies: " + strings.Join(unavailable, ", "),
+			ObservedGeneration: packageObj.ClientObject().GetGeneration(),
+		})
+	}
+	return ctrl.Result{RequeueAfter: dependencyRequeueInterval}, nil
 }
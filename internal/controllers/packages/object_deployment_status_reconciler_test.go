@@ -0,0 +1,169 @@
+package packages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func TestObjectDeploymentStatusReconciler_dependencies(t *testing.T) {
+	t.Parallel()
+
+	scheme := testutil.NewTestSchemeWithCoreV1Alpha1()
+	c := testutil.NewClient()
+	r := &objectDeploymentStatusReconciler{
+		client:              c,
+		scheme:              scheme,
+		newPackage:          adapters.NewGenericPackage,
+		newObjectDeployment: adapters.NewObjectDeployment,
+	}
+
+	available := []metav1.Condition{{
+		Type:   corev1alpha1.ObjectDeploymentAvailable,
+		Status: metav1.ConditionTrue,
+		Reason: "Available",
+	}}
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Name: "test", Namespace: "test"},
+			mock.AnythingOfType("*v1alpha1.ObjectDeployment"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			objDep := args.Get(2).(*corev1alpha1.ObjectDeployment)
+			objDep.Annotations = map[string]string{
+				manifestsv1alpha1.PackageDependenciesAnnotation: "dep-1,dep-2,dep-3",
+			}
+			objDep.Status.Conditions = available
+		}).
+		Return(nil)
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Name: "dep-1", Namespace: "test"}, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*corev1alpha1.Package).Status.Conditions = available
+		}).
+		Return(nil)
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Name: "dep-2", Namespace: "test"}, mock.Anything, mock.Anything).
+		Return(nil)
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Name: "dep-3", Namespace: "test"}, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		},
+	}
+	res, err := r.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.Equal(t, dependencyRequeueInterval, res.RequeueAfter)
+
+	cond := meta.FindStatusCondition(pkg.Status.Conditions, corev1alpha1.PackageAvailable)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, "DependenciesNotAvailable", cond.Reason)
+		assert.Equal(t, "Waiting for dependencies: dep-2, dep-3", cond.Message)
+	}
+}
+
+func TestObjectDeploymentStatusReconciler_dependencyRecreated(t *testing.T) {
+	t.Parallel()
+
+	const depImage = "quay.io/package-operator/dep@sha256:52a6b1268e32ed5b6f59da8222f7627979bfb739f32aae3fb5b5ed31b8bf80c4"
+
+	scheme := testutil.NewTestSchemeWithCoreV1Alpha1()
+	c := testutil.NewClient()
+	r := &objectDeploymentStatusReconciler{
+		client:              c,
+		scheme:              scheme,
+		newPackage:          adapters.NewGenericPackage,
+		newObjectDeployment: adapters.NewObjectDeployment,
+	}
+
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Name: "test", Namespace: "test"},
+			mock.AnythingOfType("*v1alpha1.ObjectDeployment"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			objDep := args.Get(2).(*corev1alpha1.ObjectDeployment)
+			objDep.Annotations = map[string]string{
+				manifestsv1alpha1.PackageDependenciesAnnotation: "dep=" + depImage,
+			}
+		}).
+		Return(nil)
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Name: "dep", Namespace: "test"}, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+	var created *corev1alpha1.Package
+	c.
+		On("Create", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			created = args.Get(1).(*corev1alpha1.Package)
+		}).
+		Return(nil)
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", UID: "1234"},
+		},
+	}
+	res, err := r.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.Equal(t, dependencyRequeueInterval, res.RequeueAfter)
+
+	// Deleted dependencies are re-created outside of unpacking.
+	if assert.NotNil(t, created) {
+		assert.Equal(t, "dep", created.Name)
+		assert.Equal(t, depImage, created.Spec.Image)
+	}
+}
+
+func TestObjectDeploymentStatusReconciler_paused(t *testing.T) {
+	t.Parallel()
+
+	scheme := testutil.NewTestSchemeWithCoreV1Alpha1()
+	c := testutil.NewClient()
+	r := &objectDeploymentStatusReconciler{
+		client:              c,
+		scheme:              scheme,
+		newPackage:          adapters.NewGenericPackage,
+		newObjectDeployment: adapters.NewObjectDeployment,
+	}
+
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Name: "test", Namespace: "test"},
+			mock.AnythingOfType("*v1alpha1.ObjectDeployment"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			objDep := args.Get(2).(*corev1alpha1.ObjectDeployment)
+			objDep.Status.Conditions = []metav1.Condition{{
+				Type:   corev1alpha1.ObjectDeploymentPaused,
+				Status: metav1.ConditionTrue,
+				Reason: "Paused",
+			}}
+		}).
+		Return(nil)
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", Generation: 2},
+		},
+	}
+	_, err := r.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+
+	cond := meta.FindStatusCondition(pkg.Status.Conditions, corev1alpha1.PackagePaused)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, int64(2), cond.ObservedGeneration)
+	}
+}
@@ -6,11 +6,15 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/controllers"
@@ -35,6 +39,7 @@ type metricsRecorder interface {
 // Generic reconciler for both Package and ClusterPackage objects.
 type GenericPackageController struct {
 	newPackage          adapters.GenericPackageFactory
+	newPackageList      adapters.GenericPackageListFactory
 	newObjectDeployment adapters.ObjectDeploymentFactory
 
 	recorder         metricsRecorder
@@ -43,6 +48,8 @@ type GenericPackageController struct {
 	scheme           *runtime.Scheme
 	reconciler       []reconciler
 	unpackReconciler *unpackReconciler
+	imagePullSecrets ImagePullSecretConfig
+	configSources    ConfigSourceConfig
 }
 
 func NewPackageController(
@@ -51,11 +58,13 @@ func NewPackageController(
 	imagePuller imagePuller,
 	metricsRecorder metricsRecorder,
 	packageHashModifier *int32,
+	imagePullSecrets ImagePullSecretConfig,
+	configSources ConfigSourceConfig,
 ) *GenericPackageController {
 	return newGenericPackageController(
-		adapters.NewGenericPackage, adapters.NewObjectDeployment,
+		adapters.NewGenericPackage, adapters.NewGenericPackageList, adapters.NewObjectDeployment,
 		c, uncachedClient, log, scheme, imagePuller, packages.NewPackageDeployer(c, uncachedClient, scheme),
-		metricsRecorder, packageHashModifier,
+		metricsRecorder, packageHashModifier, imagePullSecrets, configSources,
 	)
 }
 
@@ -65,16 +74,19 @@ func NewClusterPackageController(
 	imagePuller imagePuller,
 	metricsRecorder metricsRecorder,
 	packageHashModifier *int32,
+	imagePullSecrets ImagePullSecretConfig,
+	configSources ConfigSourceConfig,
 ) *GenericPackageController {
 	return newGenericPackageController(
-		adapters.NewGenericClusterPackage, adapters.NewClusterObjectDeployment,
+		adapters.NewGenericClusterPackage, adapters.NewGenericClusterPackageList, adapters.NewClusterObjectDeployment,
 		c, uncachedClient, log, scheme, imagePuller, packages.NewClusterPackageDeployer(c, scheme),
-		metricsRecorder, packageHashModifier,
+		metricsRecorder, packageHashModifier, imagePullSecrets, configSources,
 	)
 }
 
 func newGenericPackageController(
 	newPackage adapters.GenericPackageFactory,
+	newPackageList adapters.GenericPackageListFactory,
 	newObjectDeployment adapters.ObjectDeploymentFactory,
 	client client.Client, uncachedClient client.Client, log logr.Logger,
 	scheme *runtime.Scheme,
@@ -82,25 +94,36 @@ func newGenericPackageController(
 	packageDeployer packageDeployer,
 	metricsRecorder metricsRecorder,
 	packageHashModifier *int32,
+	imagePullSecrets ImagePullSecretConfig,
+	configSources ConfigSourceConfig,
 ) *GenericPackageController {
 	controller := &GenericPackageController{
 		newPackage:          newPackage,
+		newPackageList:      newPackageList,
 		newObjectDeployment: newObjectDeployment,
 		recorder:            metricsRecorder,
 		client:              client,
 		log:                 log,
 		scheme:              scheme,
+		imagePullSecrets:    imagePullSecrets,
+		configSources:       configSources,
 		unpackReconciler: newUnpackReconciler(
 			client, uncachedClient, imagePuller, packageDeployer,
-			metricsRecorder, packageHashModifier,
+			metricsRecorder, packageHashModifier, imagePullSecrets, configSources,
 		),
 	}
 
 	controller.reconciler = []reconciler{
+		&pauseReconciler{
+			client:              client,
+			scheme:              scheme,
+			newObjectDeployment: newObjectDeployment,
+		},
 		controller.unpackReconciler,
 		&objectDeploymentStatusReconciler{
 			client:              client,
 			scheme:              scheme,
+			newPackage:          newPackage,
 			newObjectDeployment: newObjectDeployment,
 		},
 	}
@@ -116,13 +139,130 @@ func (c *GenericPackageController) SetupWithManager(mgr ctrl.Manager) error {
 	pkg := c.newPackage(c.scheme).ClientObject()
 	objDep := c.newObjectDeployment(c.scheme).ClientObject()
 
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), pkg, imagePullSecretsIndexKey, c.imagePullSecretsIndex); err != nil {
+		return fmt.Errorf("indexing image pull secrets: %w", err)
+	}
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), pkg, configSourcesIndexKey, c.configSourcesIndex); err != nil {
+		return fmt.Errorf("indexing config sources: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 5}).
 		For(pkg).
 		Owns(objDep).
+		// Dependencies are owned by the packages depending on them.
+		Watches(pkg, handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), pkg)).
+		// Only metadata of config sources is cached, contents are read uncached while unpacking.
+		WatchesMetadata(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(
+			c.configSourceRequests(corev1alpha1.PackageConfigSourceKindSecret))).
+		WatchesMetadata(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(c.imagePullSecretRequests)).
+		WatchesMetadata(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(
+			c.configSourceRequests(corev1alpha1.PackageConfigSourceKindConfigMap))).
 		Complete(c)
 }
 
+// Field index key for config sources referenced by packages, as "<kind>/<namespace>/<name>".
+const configSourcesIndexKey = ".spec.configFrom"
+
+func (c *GenericPackageController) configSourcesIndex(obj client.Object) []string {
+	pkg := genericPackageFromObject(obj)
+	if pkg == nil {
+		return nil
+	}
+	return c.configSources.packageSources(pkg)
+}
+
+// Maps config sources to all packages referencing them.
+func (c *GenericPackageController) configSourceRequests(
+	kind corev1alpha1.PackageConfigSourceKind,
+) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		pkgList := c.newPackageList(c.scheme)
+		if err := c.client.List(ctx, pkgList.ClientObjectList(), client.MatchingFields{
+			configSourcesIndexKey: configSourceKey(kind, client.ObjectKeyFromObject(obj)),
+		}); err != nil {
+			c.log.Error(err, "listing packages for config source", "kind", kind, "object", client.ObjectKeyFromObject(obj))
+			return nil
+		}
+
+		items := pkgList.GetItems()
+		requests := make([]reconcile.Request, len(items))
+		for i, pkg := range items {
+			requests[i] = reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(pkg.ClientObject()),
+			}
+		}
+		return requests
+	}
+}
+
+// Field index key for image pull secrets referenced by packages, as "<namespace>/<name>".
+const imagePullSecretsIndexKey = ".spec.imagePullSecrets"
+
+func (c *GenericPackageController) imagePullSecretsIndex(obj client.Object) []string {
+	pkg := genericPackageFromObject(obj)
+	if pkg == nil {
+		return nil
+	}
+	refs := c.imagePullSecrets.packageSecrets(pkg)
+	keys := make([]string, len(refs))
+	for i, ref := range refs {
+		keys[i] = ref.String()
+	}
+	return keys
+}
+
+// Maps image pull secrets to all packages using them.
+// Packages only store a hash of their pull credentials,
+// so changes to pull secrets must trigger a reconcile to re-pull.
+func (c *GenericPackageController) imagePullSecretRequests(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	log := c.log.WithValues("object", client.ObjectKeyFromObject(obj))
+
+	var listOpts []client.ListOption
+	managerWide, err := c.imagePullSecrets.isManagerWideSecret(ctx, c.client, obj)
+	if err != nil {
+		log.Error(err, "checking manager-wide image pull secrets")
+		return nil
+	}
+	// Secrets used for every image pull affect all packages,
+	// other Secrets only the packages referencing them.
+	if !managerWide {
+		listOpts = append(listOpts, client.MatchingFields{
+			imagePullSecretsIndexKey: client.ObjectKeyFromObject(obj).String(),
+		})
+	}
+
+	pkgList := c.newPackageList(c.scheme)
+	if err := c.client.List(ctx, pkgList.ClientObjectList(), listOpts...); err != nil {
+		log.Error(err, "listing packages for image pull secret")
+		return nil
+	}
+
+	items := pkgList.GetItems()
+	requests := make([]reconcile.Request, len(items))
+	for i, pkg := range items {
+		requests[i] = reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(pkg.ClientObject()),
+		}
+	}
+	return requests
+}
+
+// Wraps Package and ClusterPackage objects into a GenericPackageAccessor.
+func genericPackageFromObject(obj client.Object) adapters.GenericPackageAccessor {
+	switch o := obj.(type) {
+	case *corev1alpha1.Package:
+		return &adapters.GenericPackage{Package: *o}
+	case *corev1alpha1.ClusterPackage:
+		return &adapters.GenericClusterPackage{ClusterPackage: *o}
+	}
+	return nil
+}
+
 func (c *GenericPackageController) Reconcile(
 	ctx context.Context, req ctrl.Request,
 ) (res ctrl.Result, err error) {
@@ -0,0 +1,139 @@
+package packages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func TestGenericPackageController_configSourceRequests(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	controller := &GenericPackageController{
+		newPackageList: adapters.NewGenericPackageList,
+		client:         c,
+		log:            logr.Discard(),
+		scheme:         testutil.NewTestSchemeWithCoreV1Alpha1(),
+	}
+
+	c.
+		On("List", mock.Anything, mock.AnythingOfType("*v1alpha1.PackageList"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1alpha1.PackageList)
+			list.Items = []corev1alpha1.Package{
+				newConfigSourcePackage("test", "referencing", corev1alpha1.PackageConfigSourceKindSecret, "creds"),
+			}
+		}).
+		Return(nil)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "test"},
+	}
+	requests := controller.configSourceRequests(corev1alpha1.PackageConfigSourceKindSecret)(
+		context.Background(), secret)
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "referencing"}},
+	}, requests)
+	c.AssertCalled(t, "List", mock.Anything, mock.Anything,
+		[]client.ListOption{client.MatchingFields{configSourcesIndexKey: "Secret/test/creds"}})
+}
+
+func TestGenericPackageController_configSourcesIndex(t *testing.T) {
+	t.Parallel()
+	controller := &GenericPackageController{
+		configSources: ConfigSourceConfig{Namespace: "pko"},
+	}
+
+	pkg := newConfigSourcePackage("test", "referencing", corev1alpha1.PackageConfigSourceKindSecret, "creds")
+	assert.Equal(t, []string{"Secret/test/creds"}, controller.configSourcesIndex(&pkg))
+	assert.Equal(t, []string{"ConfigMap/pko/settings"}, controller.configSourcesIndex(&corev1alpha1.ClusterPackage{
+		Spec: corev1alpha1.PackageSpec{
+			ConfigFrom: []corev1alpha1.PackageConfigSource{
+				{Kind: corev1alpha1.PackageConfigSourceKindConfigMap, Name: "settings"},
+			},
+		},
+	}))
+}
+
+func TestGenericPackageController_imagePullSecretRequests(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	controller := &GenericPackageController{
+		newPackageList:   adapters.NewGenericPackageList,
+		client:           c,
+		log:              logr.Discard(),
+		scheme:           testutil.NewTestSchemeWithCoreV1Alpha1(),
+		imagePullSecrets: ImagePullSecretConfig{Namespace: "pko", DefaultSecret: "default-secret"},
+	}
+
+	c.
+		On("List", mock.Anything, mock.AnythingOfType("*v1alpha1.PackageList"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1alpha1.PackageList)
+			list.Items = []corev1alpha1.Package{
+				{ObjectMeta: metav1.ObjectMeta{Name: "referencing", Namespace: "test"}},
+			}
+		}).
+		Return(nil)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "test"},
+	}
+	requests := controller.imagePullSecretRequests(context.Background(), secret)
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "referencing"}},
+	}, requests)
+	c.AssertCalled(t, "List", mock.Anything, mock.Anything,
+		[]client.ListOption{client.MatchingFields{imagePullSecretsIndexKey: "test/pull-secret"}})
+
+	// The default secret applies to all packages.
+	defaultSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-secret", Namespace: "pko"},
+	}
+	controller.imagePullSecretRequests(context.Background(), defaultSecret)
+	c.AssertCalled(t, "List", mock.Anything, mock.Anything, []client.ListOption(nil))
+}
+
+func TestGenericPackageController_imagePullSecretsIndex(t *testing.T) {
+	t.Parallel()
+	controller := &GenericPackageController{
+		imagePullSecrets: ImagePullSecretConfig{Namespace: "pko"},
+	}
+
+	assert.Equal(t, []string{"test/pull-secret"}, controller.imagePullSecretsIndex(&corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+		Spec: corev1alpha1.PackageSpec{
+			ImagePullSecrets: []corev1alpha1.ImagePullSecretReference{{Name: "pull-secret"}},
+		},
+	}))
+	assert.Equal(t, []string{"pko/pull-secret"}, controller.imagePullSecretsIndex(&corev1alpha1.ClusterPackage{
+		Spec: corev1alpha1.PackageSpec{
+			ImagePullSecrets: []corev1alpha1.ImagePullSecretReference{{Name: "pull-secret"}},
+		},
+	}))
+}
+
+func newConfigSourcePackage(
+	namespace, name string, kind corev1alpha1.PackageConfigSourceKind, sourceName string,
+) corev1alpha1.Package {
+	return corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: corev1alpha1.PackageSpec{
+			ConfigFrom: []corev1alpha1.PackageConfigSource{
+				{Kind: kind, Name: sourceName},
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package packages
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"package-operator.run/internal/adapters"
+)
+
+// pauseReconciler propagates .spec.paused to the ObjectDeployment of the package.
+// Pausing does not change the package contents, so this can't wait for the next unpack.
+type pauseReconciler struct {
+	client              client.Client
+	scheme              *runtime.Scheme
+	newObjectDeployment adapters.ObjectDeploymentFactory
+}
+
+func (r *pauseReconciler) Reconcile(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (ctrl.Result, error) {
+	objDep := r.newObjectDeployment(r.scheme)
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(pkg.ClientObject()), objDep.ClientObject()); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if objDep.IsPaused() == pkg.IsPaused() {
+		return ctrl.Result{}, nil
+	}
+
+	objDep.SetPaused(pkg.IsPaused())
+	if err := r.client.Update(ctx, objDep.ClientObject()); err != nil {
+		return ctrl.Result{}, fmt.Errorf("updating ObjectDeployment paused state: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
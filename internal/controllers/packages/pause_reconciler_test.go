@@ -0,0 +1,69 @@
+package packages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func TestPauseReconciler(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		pkgPaused    bool
+		objDepPaused bool
+		update       bool
+	}{
+		"pause":          {pkgPaused: true, update: true},
+		"resume":         {objDepPaused: true, update: true},
+		"already paused": {pkgPaused: true, objDepPaused: true},
+		"not paused":     {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.NewClient()
+			r := &pauseReconciler{
+				client:              c,
+				scheme:              testutil.NewTestSchemeWithCoreV1Alpha1(),
+				newObjectDeployment: adapters.NewObjectDeployment,
+			}
+
+			c.
+				On("Get", mock.Anything, client.ObjectKey{Name: "test", Namespace: "test"},
+					mock.AnythingOfType("*v1alpha1.ObjectDeployment"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					args.Get(2).(*corev1alpha1.ObjectDeployment).Spec.Paused = tc.objDepPaused
+				}).
+				Return(nil)
+			c.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			pkg := &adapters.GenericPackage{
+				Package: corev1alpha1.Package{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+					Spec:       corev1alpha1.PackageSpec{Paused: tc.pkgPaused},
+				},
+			}
+			res, err := r.Reconcile(context.Background(), pkg)
+			require.NoError(t, err)
+			assert.True(t, res.IsZero())
+
+			if !tc.update {
+				c.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			c.AssertCalled(t, "Update", mock.Anything, mock.MatchedBy(func(obj *corev1alpha1.ObjectDeployment) bool {
+				return obj.Spec.Paused == tc.pkgPaused
+			}), mock.Anything)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package packages
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"package-operator.run/internal/environment"
 	"package-operator.run/internal/metrics"
 	"package-operator.run/internal/packages"
+	"package-operator.run/internal/utils"
 )
 
 // Loads/unpack and templates packages into an ObjectDeployment.
@@ -28,6 +30,8 @@ type unpackReconciler struct {
 	uncachedClient client.Client
 
 	imagePuller         imagePuller
+	imagePullSecrets    *imagePullSecretResolver
+	configSources       *packages.ConfigSourceResolver
 	packageDeployer     packageDeployer
 	packageLoadRecorder packageLoadRecorder
 
@@ -59,6 +63,12 @@ func newUnpackReconciler(
 
 		uncachedClient,
 		imagePuller,
+		&imagePullSecretResolver{
+			client:         c,
+			uncachedClient: uncachedClient,
+			cfg:            cfg.ImagePullSecrets,
+		},
+		packages.NewConfigSourceResolver(c, uncachedClient, cfg.ConfigSources.Namespace),
 		packageDeployer,
 		packageLoadRecorder,
 		cfg.GetBackoff(),
@@ -67,7 +77,7 @@ func newUnpackReconciler(
 }
 
 type imagePuller interface {
-	Pull(ctx context.Context, image string) (*packages.RawPackage, error)
+	Pull(ctx context.Context, image string, opts ...packages.PullOption) (*packages.RawPackage, error)
 }
 
 type packageDeployer interface {
@@ -76,6 +86,7 @@ type packageDeployer interface {
 		apiPkg adapters.GenericPackageAccessor,
 		rawPkg *packages.RawPackage,
 		env manifests.PackageEnvironment,
+		opts ...packages.DeployOption,
 	) error
 }
 
@@ -86,35 +97,54 @@ func (r *unpackReconciler) Reconcile(
 	defer r.backoff.GC()
 
 	specHash := pkg.GetSpecHash(r.packageHashModifier)
+	if len(pkg.GetConfigFrom()) > 0 {
+		sourceRevision, err := r.configSources.Revision(ctx, pkg)
+		if err != nil {
+			return res, r.handleConfigSourceError(pkg, err)
+		}
+		// Changes to config sources must trigger a new unpack.
+		specHash = utils.ComputeSHA256Hash([]string{specHash, sourceRevision}, nil)
+	}
+	pullSecretRevision, err := r.imagePullSecrets.Revision(ctx, pkg)
+	if err != nil {
+		return r.handlePullError(ctx, pkg, err), nil
+	}
+	if len(pullSecretRevision) > 0 {
+		// Changes to image pull secrets must trigger a new pull.
+		specHash = utils.ComputeSHA256Hash([]string{specHash, pullSecretRevision}, nil)
+	}
 	if pkg.GetUnpackedHash() == specHash {
 		// We have already unpacked this package \o/
 		return res, nil
 	}
 
+	// Secret contents are only read when the image is actually pulled.
+	keychain, err := r.imagePullSecrets.Keychain(ctx, pkg)
+	if err != nil {
+		return r.handlePullError(ctx, pkg, err), nil
+	}
+
 	pullStart := time.Now()
-	log := logr.FromContextOrDiscard(ctx)
-	rawPkg, err := r.imagePuller.Pull(ctx, pkg.GetImage())
+	var pullOpts []packages.PullOption
+	if keychain != nil {
+		pullOpts = append(pullOpts, packages.WithKeychain{Keychain: keychain})
+	}
+	rawPkg, err := r.imagePuller.Pull(ctx, pkg.GetImage(), pullOpts...)
 	if err != nil {
-		meta.SetStatusCondition(
-			pkg.GetConditions(), metav1.Condition{
-				Type:               corev1alpha1.PackageUnpacked,
-				Status:             metav1.ConditionFalse,
-				Reason:             "ImagePullBackOff",
-				Message:            err.Error(),
-				ObservedGeneration: pkg.ClientObject().GetGeneration(),
-			})
-		backoffID := string(pkg.ClientObject().GetUID())
-		r.backoff.Next(backoffID, r.backoff.Clock.Now())
-		backoff := r.backoff.Get(backoffID)
-		log.Error(err, "pulling image", "backoff", backoff)
-
-		return ctrl.Result{
-			RequeueAfter: backoff,
-		}, nil
+		return r.handlePullError(ctx, pkg, err), nil
+	}
+
+	var deployOpts []packages.DeployOption
+	if len(pkg.GetConfigFrom()) > 0 {
+		sourceConfig, err := r.configSources.Config(ctx, pkg)
+		if err != nil {
+			return res, r.handleConfigSourceError(pkg, err)
+		}
+		deployOpts = append(deployOpts, packages.WithSourceConfig{Config: sourceConfig})
 	}
 
 	env, err := r.GetEnvironment(ctx, pkg.ClientObject().GetNamespace())
-	if err := r.packageDeployer.Deploy(ctx, pkg, rawPkg, *env); err != nil {
+	if err := r.packageDeployer.Deploy(ctx, pkg, rawPkg, *env, deployOpts...); err != nil {
 		return res, fmt.Errorf("deploying package: %w", err)
 	}
 
@@ -135,8 +165,57 @@ func (r *unpackReconciler) Reconcile(
 	return
 }
 
+// Reports missing config sources on the Unpacked condition.
+// No requeue is needed, as config sources are watched.
+func (r *unpackReconciler) handleConfigSourceError(
+	pkg adapters.GenericPackageAccessor, err error,
+) error {
+	if !errors.Is(err, packages.ErrConfigSourceNotFound) &&
+		!errors.Is(err, packages.ErrConfigSourceKeyNotFound) {
+		return fmt.Errorf("resolving config sources: %w", err)
+	}
+	meta.SetStatusCondition(
+		pkg.GetConditions(), metav1.Condition{
+			Type:               corev1alpha1.PackageUnpacked,
+			Status:             metav1.ConditionFalse,
+			Reason:             "ConfigSourceNotFound",
+			Message:            err.Error(),
+			ObservedGeneration: pkg.ClientObject().GetGeneration(),
+		})
+	return nil
+}
+
+// Reports image pull errors on the Unpacked condition and backs off.
+func (r *unpackReconciler) handlePullError(
+	ctx context.Context, pkg adapters.GenericPackageAccessor, err error,
+) ctrl.Result {
+	reason := "ImagePullBackOff"
+	if errors.Is(err, packages.ErrVerificationFailed) {
+		reason = "ImageVerificationFailed"
+	}
+	meta.SetStatusCondition(
+		pkg.GetConditions(), metav1.Condition{
+			Type:               corev1alpha1.PackageUnpacked,
+			Status:             metav1.ConditionFalse,
+			Reason:             reason,
+			Message:            err.Error(),
+			ObservedGeneration: pkg.ClientObject().GetGeneration(),
+		})
+	backoffID := string(pkg.ClientObject().GetUID())
+	r.backoff.Next(backoffID, r.backoff.Clock.Now())
+	backoff := r.backoff.Get(backoffID)
+	logr.FromContextOrDiscard(ctx).Error(err, "pulling image", "backoff", backoff)
+
+	return ctrl.Result{
+		RequeueAfter: backoff,
+	}
+}
+
 type unpackReconcilerConfig struct {
 	controllers.BackoffConfig
+
+	ImagePullSecrets ImagePullSecretConfig
+	ConfigSources    ConfigSourceConfig
 }
 
 func (c *unpackReconcilerConfig) Option(opts ...unpackReconcilerOption) {
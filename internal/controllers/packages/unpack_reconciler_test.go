@@ -3,12 +3,16 @@ package packages
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/adapters"
@@ -85,6 +89,70 @@ func TestUnpackReconciler_noop(t *testing.T) {
 	assert.True(t, res.IsZero())
 }
 
+func TestUnpackReconciler_pullSecretChange(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	uc := testutil.NewClient()
+
+	ipm := &imagePullerMock{}
+	pd := &packageDeployerMock{}
+	ur := newUnpackReconciler(c, uc, ipm, pd, nil, nil, ImagePullSecretConfig{
+		Namespace:     "pko",
+		DefaultSecret: "pull-secret",
+	})
+
+	const image = "test123:latest"
+
+	c.
+		On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.PartialObjectMetadata"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(2).(*metav1.PartialObjectMetadata)
+			obj.SetResourceVersion("42")
+		}).
+		Return(nil)
+	uc.
+		On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.Secret"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			secret := args.Get(2).(*corev1.Secret)
+			secret.Type = corev1.SecretTypeDockerConfigJson
+			secret.Data = map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"dXNlcjpwYXNz"}}}`),
+			}
+		}).
+		Return(nil)
+	ipm.
+		On("Pull", mock.Anything, mock.Anything).
+		Return(&packages.RawPackage{}, nil)
+	pd.
+		On("Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			Spec: corev1alpha1.PackageSpec{
+				Image: image,
+			},
+		},
+	}
+	// Unpacked with other credentials before.
+	pkg.Package.Status.UnpackedHash = pkg.GetSpecHash(nil)
+	ur.SetEnvironment(&manifests.PackageEnvironment{})
+
+	res, err := ur.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+
+	ipm.AssertNumberOfCalls(t, "Pull", 1)
+	assert.NotEqual(t, pkg.GetSpecHash(nil), pkg.GetUnpackedHash())
+
+	// Secret contents are not read again, while the Secret is unchanged.
+	res, err = ur.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+	ipm.AssertNumberOfCalls(t, "Pull", 1)
+	uc.AssertNumberOfCalls(t, "Get", 1)
+}
+
 var errTest = errors.New("test error")
 
 func TestUnpackReconciler_pullBackoff(t *testing.T) {
@@ -121,12 +189,82 @@ func TestUnpackReconciler_pullBackoff(t *testing.T) {
 			corev1alpha1.PackageUnpacked))
 }
 
+func TestUnpackReconciler_verificationFailed(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	uc := testutil.NewClient()
+
+	ipm := &imagePullerMock{}
+	pd := &packageDeployerMock{}
+	ur := newUnpackReconciler(c, uc, ipm, pd, nil, nil)
+
+	ipm.
+		On("Pull", mock.Anything, mock.Anything).
+		Return(&packages.RawPackage{}, fmt.Errorf("%w: test", packages.ErrVerificationFailed))
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			Spec: corev1alpha1.PackageSpec{
+				Image: "test123:latest",
+			},
+		},
+	}
+
+	res, err := ur.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.Equal(t, controllers.DefaultInitialBackoff, res.RequeueAfter)
+
+	cond := meta.FindStatusCondition(*pkg.GetConditions(), corev1alpha1.PackageUnpacked)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, "ImageVerificationFailed", cond.Reason)
+	}
+	pd.AssertNotCalled(t, "Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUnpackReconciler_configSourceNotFound(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	uc := testutil.NewClient()
+
+	ipm := &imagePullerMock{}
+	pd := &packageDeployerMock{}
+	ur := newUnpackReconciler(c, uc, ipm, pd, nil, nil)
+
+	c.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(corev1.Resource("secrets"), "creds"))
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+			Spec: corev1alpha1.PackageSpec{
+				Image: "test123:latest",
+				ConfigFrom: []corev1alpha1.PackageConfigSource{
+					{Kind: corev1alpha1.PackageConfigSourceKindSecret, Name: "creds"},
+				},
+			},
+		},
+	}
+
+	res, err := ur.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+
+	cond := meta.FindStatusCondition(*pkg.GetConditions(), corev1alpha1.PackageUnpacked)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, "ConfigSourceNotFound", cond.Reason)
+	}
+	ipm.AssertNotCalled(t, "Pull", mock.Anything, mock.Anything)
+}
+
 type imagePullerMock struct {
 	mock.Mock
 }
 
 func (m *imagePullerMock) Pull(
-	ctx context.Context, image string,
+	ctx context.Context, image string, _ ...packages.PullOption,
 ) (*packages.RawPackage, error) {
 	args := m.Called(ctx, image)
 	return args.Get(0).(*packages.RawPackage), args.Error(1)
@@ -141,7 +279,8 @@ func (m *packageDeployerMock) Deploy(
 	apiPkg adapters.GenericPackageAccessor,
 	rawPkg *packages.RawPackage,
 	env manifests.PackageEnvironment,
+	opts ...packages.DeployOption,
 ) error {
-	args := m.Called(ctx, apiPkg, rawPkg, env)
+	args := m.Called(ctx, apiPkg, rawPkg, env, opts)
 	return args.Error(0)
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -14,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -55,6 +57,13 @@ type ownerStrategy interface {
 	HasController(obj metav1.Object) bool
 }
 
+// Implemented by owner strategies recording owners outside of the owned objects.
+// Owner changes are only staged on the object in memory,
+// until they are persisted after the object itself has been written.
+type ownerRecorder interface {
+	PersistOwners(ctx context.Context, obj metav1.Object) error
+}
+
 type adoptionChecker interface {
 	Check(
 		ctx context.Context, owner PhaseObjectOwner, obj client.Object,
@@ -108,6 +117,7 @@ type PhaseObjectOwner interface {
 	GetRevision() int64
 	GetConditions() *[]metav1.Condition
 	IsPaused() bool
+	GetAdoptionPolicy() *corev1alpha1.AdoptionPolicy
 }
 
 func newRecordingProbe(name string, probe probing.Prober) recordingProbe {
@@ -121,11 +131,13 @@ type recordingProbe struct {
 	name     string
 	probe    probing.Prober
 	failures []string
+	objects  []corev1alpha1.ObjectProbeStatus
 }
 
 func (p *recordingProbe) Probe(obj *unstructured.Unstructured) {
 	ok, msg := p.probe.Probe(obj)
 	if ok {
+		p.objects = append(p.objects, objectProbeStatus(obj, metav1.ConditionTrue, ""))
 		return
 	}
 	p.recordForObj(obj, msg)
@@ -136,6 +148,8 @@ func (p *recordingProbe) RecordMissingObject(obj *unstructured.Unstructured) {
 }
 
 func (p *recordingProbe) recordForObj(obj *unstructured.Unstructured, msg string) {
+	p.objects = append(p.objects, objectProbeStatus(obj, metav1.ConditionFalse, msg))
+
 	gvk := obj.GroupVersionKind()
 	msg = fmt.Sprintf("%s %s %s/%s: %s", gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName(), msg)
 
@@ -144,20 +158,44 @@ func (p *recordingProbe) recordForObj(obj *unstructured.Unstructured, msg string
 
 func (p *recordingProbe) Result() ProbingResult {
 	if len(p.failures) == 0 {
-		return ProbingResult{}
+		return ProbingResult{Objects: p.objects}
 	}
 
 	return ProbingResult{
 		PhaseName:    p.name,
 		FailedProbes: p.failures,
+		Objects:      p.objects,
 	}
 }
 
+// LastTransitionTime is set when the result is written to status.
+func objectProbeStatus(
+	obj *unstructured.Unstructured, status metav1.ConditionStatus, msg string,
+) corev1alpha1.ObjectProbeStatus {
+	gvk := obj.GroupVersionKind()
+	return corev1alpha1.ObjectProbeStatus{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Status:    status,
+		Message:   msg,
+	}
+}
+
+// Maximum number of failed probes listed in condition messages.
+// The probe result of every object is reported via status.probedObjects.
+const maxProbeFailuresInMessage = 10
+
 type ProbingResult struct {
 	PhaseName    string
 	FailedProbes []string
+	// Probe results of all probed objects, including the successful ones.
+	Objects []corev1alpha1.ObjectProbeStatus
 }
 
+// IsZero returns true if no probes failed.
 func (e *ProbingResult) IsZero() bool {
 	if e == nil || len(e.PhaseName) == 0 && len(e.FailedProbes) == 0 {
 		return true
@@ -166,7 +204,12 @@ func (e *ProbingResult) IsZero() bool {
 }
 
 func (e *ProbingResult) StringWithoutPhase() string {
-	return strings.Join(e.FailedProbes, ", ")
+	if len(e.FailedProbes) <= maxProbeFailuresInMessage {
+		return strings.Join(e.FailedProbes, ", ")
+	}
+	return fmt.Sprintf("%s and %d more",
+		strings.Join(e.FailedProbes[:maxProbeFailuresInMessage], ", "),
+		len(e.FailedProbes)-maxProbeFailuresInMessage)
 }
 
 func (e *ProbingResult) String() string {
@@ -178,12 +221,16 @@ func (r *PhaseReconciler) ReconcilePhase(
 	ctx context.Context, owner PhaseObjectOwner,
 	phase corev1alpha1.ObjectSetTemplatePhase,
 	probe probing.Prober, previous []PreviousObjectSet,
-) (actualObjects []client.Object, res ProbingResult, err error) {
+) (
+	actualObjects []client.Object, res ProbingResult,
+	drifted []corev1alpha1.DriftedObject,
+	wouldAdopt []corev1alpha1.ControlledObjectReference, err error,
+) {
 	desiredObjects := make([]unstructured.Unstructured, len(phase.Objects))
 	for i, phaseObject := range phase.Objects {
 		desired, err := r.desiredObject(ctx, owner, phaseObject)
 		if err != nil {
-			return nil, res, fmt.Errorf("%s: %w", phaseObject, err)
+			return nil, res, nil, nil, fmt.Errorf("%s: %w", phaseObject, err)
 		}
 		desiredObjects[i] = *desired
 	}
@@ -191,42 +238,99 @@ func (r *PhaseReconciler) ReconcilePhase(
 	violations, err := preflight.CheckAllInPhase(
 		ctx, r.preflightChecker, owner.ClientObject(), phase, desiredObjects)
 	if err != nil {
-		return nil, res, err
+		return nil, res, nil, nil, err
 	}
 	if len(violations) > 0 {
-		return nil, res, &preflight.Error{
+		return nil, res, nil, nil, &preflight.Error{
 			Violations: violations,
 		}
 	}
 
 	rec := newRecordingProbe(phase.Name, probe)
 
-	for i, phaseObject := range phase.Objects {
-		desiredObj := &desiredObjects[i]
-		actualObj, err := r.reconcilePhaseObject(ctx, owner, phaseObject, desiredObj, previous)
-		if apimachineryerrors.IsNotFound(err) {
-			// Don't error, just observe.
-			rec.RecordMissingObject(desiredObj)
-			continue
+	for _, wave := range phaseWaves(phase) {
+		for _, i := range wave.objects {
+			phaseObject := phase.Objects[i]
+			desiredObj := &desiredObjects[i]
+			actualObj, drift, err := r.reconcilePhaseObject(ctx, owner, phaseObject, desiredObj, previous)
+			if apimachineryerrors.IsNotFound(err) {
+				// Don't error, just observe.
+				rec.RecordMissingObject(desiredObj)
+				continue
+			}
+			var dryRunErr *AdoptionDryRunError
+			if errors.As(err, &dryRunErr) {
+				// Report the object instead of adopting it,
+				// without affecting the availability of the phase.
+				logr.FromContextOrDiscard(ctx).Info("would adopt object (dry run)",
+					"ObjectKey", dryRunErr.ObjectKey, "ObjectGVK", dryRunErr.ObjectGVK)
+				wouldAdopt = append(wouldAdopt, controlledObjectReference(desiredObj))
+				continue
+			}
+			if err != nil {
+				return nil, res, nil, nil, fmt.Errorf("%s: %w", phaseObject, err)
+			}
+			actualObjects = append(actualObjects, actualObj)
+			if len(drift) > 0 {
+				drifted = append(drifted, driftedObject(actualObj, drift))
+			}
+
+			rec.Probe(actualObj)
 		}
-		if err != nil {
-			return nil, res, fmt.Errorf("%s: %w", phaseObject, err)
+
+		for _, i := range wave.externalObjects {
+			obj := phase.ExternalObjects[i]
+			observedObj, err := r.observeExternalObject(ctx, owner, obj)
+			if err != nil {
+				return nil, res, nil, nil, fmt.Errorf("%s: %w", obj, err)
+			}
+
+			rec.Probe(observedObj)
 		}
-		actualObjects = append(actualObjects, actualObj)
 
-		rec.Probe(actualObj)
+		if len(rec.failures) > 0 {
+			// break on first failing wave
+			break
+		}
 	}
 
-	for _, obj := range phase.ExternalObjects {
-		observedObj, err := r.observeExternalObject(ctx, owner, obj)
-		if err != nil {
-			return nil, res, fmt.Errorf("%s: %w", obj, err)
-		}
+	return actualObjects, rec.Result(), drifted, wouldAdopt, nil
+}
+
+// Indexes of the objects and external objects of a phase sharing the same wave.
+type phaseWave struct {
+	wave            int32
+	objects         []int
+	externalObjects []int
+}
 
-		rec.Probe(observedObj)
+// Groups the objects of the phase into waves, ordered from lowest to highest.
+// Objects keep their order within a wave.
+func phaseWaves(phase corev1alpha1.ObjectSetTemplatePhase) []phaseWave {
+	waves := map[int32]*phaseWave{}
+	getWave := func(wave int32) *phaseWave {
+		if _, ok := waves[wave]; !ok {
+			waves[wave] = &phaseWave{wave: wave}
+		}
+		return waves[wave]
+	}
+	for i, obj := range phase.Objects {
+		w := getWave(obj.Wave)
+		w.objects = append(w.objects, i)
+	}
+	for i, obj := range phase.ExternalObjects {
+		w := getWave(obj.Wave)
+		w.externalObjects = append(w.externalObjects, i)
 	}
 
-	return actualObjects, rec.Result(), nil
+	out := make([]phaseWave, 0, len(waves))
+	for _, w := range waves {
+		out = append(out, *w)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].wave < out[j].wave
+	})
+	return out
 }
 
 func (r *PhaseReconciler) observeExternalObject(
@@ -271,14 +375,8 @@ func (r *PhaseReconciler) observeExternalObject(
 	if err := r.ownerStrategy.SetOwnerReference(ownerObj, observed); err != nil {
 		return nil, fmt.Errorf("setting owner reference: %w", err)
 	}
-	ownerPatch, err := r.ownerStrategy.OwnerPatch(observed)
-	if err != nil {
-		return nil, fmt.Errorf("determining owner patch: %w", err)
-	}
-	if err := r.writer.Patch(ctx, observed, client.RawPatch(
-		types.MergePatchType, ownerPatch,
-	)); err != nil {
-		return nil, fmt.Errorf("patching object ownership: %w", err)
+	if err := r.patchOwnership(ctx, observed); err != nil {
+		return nil, err
 	}
 
 	if err := mapConditions(ctx, owner, extObj.ConditionMappings, observed); err != nil {
@@ -288,35 +386,46 @@ func (r *PhaseReconciler) observeExternalObject(
 	return observed, nil
 }
 
+// TeardownPhase tears down the objects of the phase wave by wave, in reverse order.
+// Lower waves are only torn down after all objects of higher waves are gone,
+// so objects are removed in the opposite order they were created in.
 func (r *PhaseReconciler) TeardownPhase(
 	ctx context.Context, owner PhaseObjectOwner,
 	phase corev1alpha1.ObjectSetTemplatePhase,
 ) (cleanupDone bool, err error) {
-	var cleanupCounter int
-	objectsToCleanup := len(phase.Objects) + len(phase.ExternalObjects)
-	for _, phaseObject := range phase.Objects {
-		done, err := r.teardownPhaseObject(ctx, owner, phaseObject)
-		if err != nil {
-			return false, err
-		}
+	waves := phaseWaves(phase)
+	for w := len(waves) - 1; w >= 0; w-- {
+		wave := waves[w]
+		var cleanupCounter int
+		for _, i := range wave.objects {
+			done, err := r.teardownPhaseObject(ctx, owner, phase.Objects[i])
+			if err != nil {
+				return false, err
+			}
 
-		if done {
-			cleanupCounter++
+			if done {
+				cleanupCounter++
+			}
 		}
-	}
 
-	for _, extObj := range phase.ExternalObjects {
-		done, err := r.teardownExternalObject(ctx, owner, extObj)
-		if err != nil {
-			return false, fmt.Errorf("tearing down external object: %w", err)
+		for _, i := range wave.externalObjects {
+			done, err := r.teardownExternalObject(ctx, owner, phase.ExternalObjects[i])
+			if err != nil {
+				return false, fmt.Errorf("tearing down external object: %w", err)
+			}
+
+			if done {
+				cleanupCounter++
+			}
 		}
 
-		if done {
-			cleanupCounter++
+		if cleanupCounter < len(wave.objects)+len(wave.externalObjects) {
+			// Wait for this wave to be gone before tearing down the next one.
+			return false, nil
 		}
 	}
 
-	return cleanupCounter == objectsToCleanup, nil
+	return true, nil
 }
 
 func (r *PhaseReconciler) teardownPhaseObject(
@@ -351,7 +460,7 @@ func (r *PhaseReconciler) teardownPhaseObject(
 	if err != nil && apimachineryerrors.IsNotFound(err) {
 		// No matter who the owner of this object is,
 		// it's already gone.
-		return true, nil
+		return true, r.forgetOwner(ctx, owner, desiredObj)
 	}
 	if err != nil {
 		return false, fmt.Errorf("getting object for teardown: %w", err)
@@ -369,7 +478,25 @@ func (r *PhaseReconciler) teardownPhaseObject(
 		if err := r.writer.Update(ctx, currentObj); err != nil {
 			return false, fmt.Errorf("removing owner reference: %w", err)
 		}
-		return true, nil
+		return true, r.persistOwners(ctx, currentObj)
+	}
+
+	if releaseOnTeardown(owner, phaseObject.DeletionPolicy) {
+		log.Info("releasing managed object",
+			"apiVersion", currentObj.GetAPIVersion(),
+			"kind", currentObj.GroupVersionKind().Kind,
+			"namespace", currentObj.GetNamespace(),
+			"name", currentObj.GetName(),
+			"deletionPolicy", phaseObject.DeletionPolicy)
+
+		// Remove ourselves as owner, so the object is not garbage collected,
+		// and strip our metadata, so it is no longer cached or attributed to the package.
+		r.ownerStrategy.RemoveOwner(owner.ClientObject(), currentObj)
+		removePackageOperatorMetadata(currentObj)
+		if err := r.writer.Update(ctx, currentObj); err != nil {
+			return false, fmt.Errorf("releasing object for teardown: %w", err)
+		}
+		return true, r.persistOwners(ctx, currentObj)
 	}
 
 	log.Info("deleting managed object",
@@ -380,7 +507,7 @@ func (r *PhaseReconciler) teardownPhaseObject(
 
 	err = r.writer.Delete(ctx, currentObj)
 	if err != nil && apimachineryerrors.IsNotFound(err) {
-		return true, nil
+		return true, r.forgetOwner(ctx, owner, desiredObj)
 	}
 	if err != nil {
 		return false, fmt.Errorf("deleting object for teardown: %w", err)
@@ -389,6 +516,34 @@ func (r *PhaseReconciler) teardownPhaseObject(
 	return false, nil
 }
 
+// Removes labels and annotations Package Operator sets on managed objects.
+func removePackageOperatorMetadata(obj client.Object) {
+	labels := obj.GetLabels()
+	delete(labels, constants.DynamicCacheLabel)
+	delete(labels, manifestsv1alpha1.PackageLabel)
+	delete(labels, manifestsv1alpha1.PackageInstanceLabel)
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	delete(annotations, corev1alpha1.ObjectSetRevisionAnnotation)
+	obj.SetAnnotations(annotations)
+}
+
+// Returns true if the deletion policy requires the object to stay in place,
+// when the owner is tearing it down.
+func releaseOnTeardown(owner PhaseObjectOwner, policy corev1alpha1.DeletionPolicy) bool {
+	switch policy {
+	case corev1alpha1.DeletionPolicyOrphan:
+		return true
+	case corev1alpha1.DeletionPolicyRetain:
+		// Owners are torn down without being deleted when archived,
+		// in which case the object was removed in a newer revision.
+		return !owner.ClientObject().GetDeletionTimestamp().IsZero()
+	default:
+		return false
+	}
+}
+
 func (r *PhaseReconciler) teardownExternalObject(
 	ctx context.Context, owner PhaseObjectOwner,
 	extObj corev1alpha1.ObjectSetObject,
@@ -415,7 +570,7 @@ func (r *PhaseReconciler) teardownExternalObject(
 	if err := r.dynamicCache.Get(ctx, key, observed); apimachineryerrors.IsNotFound(err) {
 		if err := r.uncachedClient.Get(ctx, key, obj); apimachineryerrors.IsNotFound(err) {
 			// external object does not exist therefore no action is needed
-			return true, nil
+			return true, r.forgetOwner(ctx, owner, obj)
 		} else if err != nil {
 			return false, fmt.Errorf("retrieving external object: %w", err)
 		}
@@ -432,7 +587,7 @@ func (r *PhaseReconciler) teardownExternalObject(
 		return false, fmt.Errorf("removing owner reference: %w", err)
 	}
 
-	return true, nil
+	return true, r.persistOwners(ctx, obj)
 }
 
 func (r *PhaseReconciler) reconcilePhaseObject(
@@ -440,35 +595,36 @@ func (r *PhaseReconciler) reconcilePhaseObject(
 	phaseObject corev1alpha1.ObjectSetObject,
 	desiredObj *unstructured.Unstructured,
 	previous []PreviousObjectSet,
-) (actualObj *unstructured.Unstructured, err error) {
+) (actualObj *unstructured.Unstructured, drift []string, err error) {
 	// Set owner reference
 	if err := r.ownerStrategy.SetControllerReference(owner.ClientObject(), desiredObj); err != nil {
-		return nil, fmt.Errorf("set controller reference: %w", err)
+		return nil, nil, fmt.Errorf("set controller reference: %w", err)
 	}
 
 	// Ensure to watch this type of object.
 	if err := r.dynamicCache.Watch(
 		ctx, owner.ClientObject(), desiredObj); err != nil {
-		return nil, fmt.Errorf("watching new resource: %w", err)
+		return nil, nil, fmt.Errorf("watching new resource: %w", err)
 	}
 
 	if owner.IsPaused() {
 		actualObj = desiredObj.DeepCopy()
 		if err := r.dynamicCache.Get(ctx, client.ObjectKeyFromObject(desiredObj), actualObj); err != nil {
-			return nil, fmt.Errorf("looking up object while paused: %w", err)
+			return nil, nil, fmt.Errorf("looking up object while paused: %w", err)
 		}
-		return actualObj, nil
+		return actualObj, nil, nil
 	}
 
-	if actualObj, err = r.reconcileObject(ctx, owner, desiredObj, previous, phaseObject.CollisionProtection); err != nil {
-		return nil, err
+	actualObj, drift, err = r.reconcileObject(ctx, owner, desiredObj, previous, phaseObject.CollisionProtection)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if err = mapConditions(ctx, owner, phaseObject.ConditionMappings, actualObj); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return actualObj, nil
+	return actualObj, drift, nil
 }
 
 func mapConditions(
@@ -626,6 +782,16 @@ func (e *ObjectNotOwnedByPreviousRevisionError) Error() string {
 	return fmt.Sprintf("refusing adoption, object %s %s not owned by previous revision", e.ObjectGVK, e.ObjectKey)
 }
 
+// This error is returned when a Phase would adopt an object
+// matching its adoption policy, but the policy is in dry run mode.
+type AdoptionDryRunError struct {
+	CommonObjectPhaseError
+}
+
+func (e *AdoptionDryRunError) Error() string {
+	return fmt.Sprintf("adoption dry run, object %s %s would be adopted", e.ObjectGVK, e.ObjectKey)
+}
+
 // This error is returned when a Phase tries to adopt an object
 // where the revision number is not increasing.
 type RevisionCollisionError struct {
@@ -640,17 +806,17 @@ func (r *PhaseReconciler) reconcileObject(
 	ctx context.Context, owner PhaseObjectOwner,
 	desiredObj *unstructured.Unstructured, previous []PreviousObjectSet,
 	collisionProtection corev1alpha1.CollisionProtection,
-) (actualObj *unstructured.Unstructured, err error) {
+) (actualObj *unstructured.Unstructured, drift []string, err error) {
 	objKey := client.ObjectKeyFromObject(desiredObj)
 	currentObj := desiredObj.DeepCopy()
 	err = r.dynamicCache.Get(ctx, objKey, currentObj)
 	if err != nil && !apimachineryerrors.IsNotFound(err) {
-		return nil, fmt.Errorf("getting %s: %w", desiredObj.GroupVersionKind(), err)
+		return nil, nil, fmt.Errorf("getting %s: %w", desiredObj.GroupVersionKind(), err)
 	}
 	if apimachineryerrors.IsNotFound(err) {
 		err = r.uncachedClient.Get(ctx, objKey, currentObj)
 		if err != nil && !apimachineryerrors.IsNotFound(err) {
-			return nil, fmt.Errorf("getting %s: %w", desiredObj.GroupVersionKind(), err)
+			return nil, nil, fmt.Errorf("getting %s: %w", desiredObj.GroupVersionKind(), err)
 		}
 	}
 	if apimachineryerrors.IsNotFound(err) {
@@ -661,13 +827,16 @@ func (r *PhaseReconciler) reconcileObject(
 			// object already exists, but was not in our cache.
 			// get object via uncached client directly from the API server.
 			if err := r.uncachedClient.Get(ctx, objKey, currentObj); err != nil {
-				return nil, fmt.Errorf("getting %s from uncached client: %w", desiredObj.GroupVersionKind(), err)
+				return nil, nil, fmt.Errorf("getting %s from uncached client: %w", desiredObj.GroupVersionKind(), err)
 			}
 		}
 		if err != nil {
-			return nil, fmt.Errorf("creating: %w", err)
+			return nil, nil, fmt.Errorf("creating: %w", err)
+		}
+		if err := r.persistOwners(ctx, desiredObj); err != nil {
+			return nil, nil, err
 		}
-		return desiredObj, nil
+		return desiredObj, nil, nil
 	}
 
 	// An object already exists - this is the complicated part.
@@ -679,7 +848,7 @@ func (r *PhaseReconciler) reconcileObject(
 	// Check if we can even work on this object or need to adopt it.
 	needsAdoption, err := r.adoptionChecker.Check(ctx, owner, currentObj, previous, collisionProtection)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Take over object ownership by patching metadata.
@@ -693,18 +862,87 @@ func (r *PhaseReconciler) reconcileObject(
 		setObjectRevision(updatedObj, owner.GetRevision())
 		r.ownerStrategy.ReleaseController(updatedObj)
 		if err := r.ownerStrategy.SetControllerReference(owner.ClientObject(), updatedObj); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	// Only issue updates when this instance is already controlled by this instance.
 	if r.ownerStrategy.IsController(owner.ClientObject(), updatedObj) {
+		observeOnly := isObserveOnly(currentObj)
+		// Objects we are just taking over are expected to differ,
+		// unless they are never going to be patched into shape.
+		if !needsAdoption || observeOnly {
+			drift = detectDrift(desiredObj, currentObj)
+		}
+		if len(drift) > 0 {
+			logr.FromContextOrDiscard(ctx).Info("drift detected",
+				"ObjectKey", client.ObjectKeyFromObject(desiredObj),
+				"ObjectGVK", desiredObj.GetObjectKind().GroupVersionKind(),
+				"Fields", drift, "ObserveOnly", observeOnly)
+		}
+
+		if observeOnly {
+			// Report drift, but leave the object as is, apart from taking ownership.
+			if needsAdoption {
+				if err := r.patchOwnership(ctx, updatedObj); err != nil {
+					return nil, nil, err
+				}
+			}
+			return updatedObj, drift, nil
+		}
+
 		if err := r.patcher.Patch(ctx, desiredObj, currentObj, updatedObj); err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if err := r.persistOwners(ctx, updatedObj); err != nil {
+			return nil, nil, err
 		}
 	}
 
-	return updatedObj, nil
+	return updatedObj, drift, nil
+}
+
+// Persists owner changes staged on obj, for owner strategies recording owners outside of the objects.
+// Must only be called after obj itself has been written successfully.
+func (r *PhaseReconciler) persistOwners(ctx context.Context, obj client.Object) error {
+	recorder, ok := r.ownerStrategy.(ownerRecorder)
+	if !ok {
+		return nil
+	}
+	if err := recorder.PersistOwners(ctx, obj); err != nil {
+		return fmt.Errorf("persisting owners: %w", err)
+	}
+	return nil
+}
+
+// Removes owner from the recorded owners of an object that no longer exists,
+// so a later object of the same name does not inherit stale owners.
+// Owner strategies recording owners on the object itself have nothing to clean up.
+func (r *PhaseReconciler) forgetOwner(ctx context.Context, owner PhaseObjectOwner, obj client.Object) error {
+	if _, ok := r.ownerStrategy.(ownerRecorder); !ok {
+		return nil
+	}
+	r.ownerStrategy.RemoveOwner(owner.ClientObject(), obj)
+	return r.persistOwners(ctx, obj)
+}
+
+// Patches owner references and the revision annotation of obj without touching other fields.
+// Owner strategies not recording anything on the object itself return an empty patch, which is skipped.
+// Owner changes recorded outside of the object are persisted after the patch.
+func (r *PhaseReconciler) patchOwnership(ctx context.Context, obj client.Object) error {
+	ownerPatch, err := r.ownerStrategy.OwnerPatch(obj)
+	if err != nil {
+		return fmt.Errorf("determining owner patch: %w", err)
+	}
+	if len(ownerPatch) == 0 || string(ownerPatch) == "{}" {
+		return r.persistOwners(ctx, obj)
+	}
+	if err := r.writer.Patch(ctx, obj, client.RawPatch(
+		types.MergePatchType, ownerPatch,
+	)); err != nil {
+		return fmt.Errorf("patching object ownership: %w", err)
+	}
+	return r.persistOwners(ctx, obj)
 }
 
 type defaultPatcher struct {
@@ -803,10 +1041,10 @@ func (c *defaultAdoptionChecker) Check(
 	if err != nil {
 		return false, fmt.Errorf("getting revision of object: %w", err)
 	}
-	// Never ever adopt objects of newer revisions.
+	// Never ever adopt objects of newer revisions,
+	// unless rolling back from the revision controlling them.
 	if currentRevision > owner.GetRevision() {
-		// owned by newer revision.
-		return false, nil
+		return c.isControlledByRollbackSource(owner, obj, previous), nil
 	}
 
 	// Forced adoption is enabled:
@@ -835,14 +1073,29 @@ func (c *defaultAdoptionChecker) Check(
 	}
 
 	if !c.isControlledByPreviousRevision(obj, previous) {
-		return false, &ObjectNotOwnedByPreviousRevisionError{
-			CommonObjectPhaseError: CommonObjectPhaseError{
-				OwnerKey:  client.ObjectKeyFromObject(owner.ClientObject()),
-				OwnerGVK:  owner.ClientObject().GetObjectKind().GroupVersionKind(),
-				ObjectKey: client.ObjectKeyFromObject(obj),
-				ObjectGVK: obj.GetObjectKind().GroupVersionKind(),
-			},
+		commonErr := CommonObjectPhaseError{
+			OwnerKey:  client.ObjectKeyFromObject(owner.ClientObject()),
+			OwnerGVK:  owner.ClientObject().GetObjectKind().GroupVersionKind(),
+			ObjectKey: client.ObjectKeyFromObject(obj),
+			ObjectGVK: obj.GetObjectKind().GroupVersionKind(),
 		}
+
+		// Unmanaged objects may be adopted when explicitly opted-in via the adoption policy.
+		if !c.ownerStrategy.HasController(obj) {
+			policy := owner.GetAdoptionPolicy()
+			matches, err := adoptionPolicyMatches(policy, owner.ClientObject(), obj)
+			if err != nil {
+				return false, err
+			}
+			if matches && policy.DryRun {
+				return false, &AdoptionDryRunError{CommonObjectPhaseError: commonErr}
+			}
+			if matches {
+				return true, nil
+			}
+		}
+
+		return false, &ObjectNotOwnedByPreviousRevisionError{CommonObjectPhaseError: commonErr}
 	}
 
 	if currentRevision == owner.GetRevision() {
@@ -864,6 +1117,53 @@ func (c *defaultAdoptionChecker) Check(
 	return true, nil
 }
 
+// Checks if the object opted-in to be adopted by the owner,
+// by matching the policies selector or by naming the owners package in the adopt-by annotation.
+// Packages are named "<namespace>/<name>", ClusterPackages just "<name>".
+func adoptionPolicyMatches(
+	policy *corev1alpha1.AdoptionPolicy, owner, obj client.Object,
+) (bool, error) {
+	if policy == nil {
+		return false, nil
+	}
+
+	if adoptBy, ok := obj.GetAnnotations()[corev1alpha1.ObjectSetAdoptByAnnotation]; ok {
+		if pkgInstance, ok := owner.GetLabels()[manifestsv1alpha1.PackageInstanceLabel]; ok {
+			if len(owner.GetNamespace()) > 0 {
+				pkgInstance = owner.GetNamespace() + "/" + pkgInstance
+			}
+			if adoptBy == pkgInstance {
+				return true, nil
+			}
+		}
+	}
+
+	if policy.Selector == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(policy.Selector)
+	if err != nil {
+		return false, fmt.Errorf("parsing adoption selector: %w", err)
+	}
+	return selector.Matches(k8slabels.Set(obj.GetLabels())), nil
+}
+
+// Checks if the object is controlled by the failed revision the owner is rolled back from.
+func (c *defaultAdoptionChecker) isControlledByRollbackSource(
+	owner PhaseObjectOwner, obj client.Object, previous []PreviousObjectSet,
+) bool {
+	source, ok := owner.ClientObject().GetAnnotations()[corev1alpha1.ObjectSetRollbackFromAnnotation]
+	if !ok {
+		return false
+	}
+	for _, prev := range previous {
+		if prev.ClientObject().GetName() == source {
+			return c.isControlledByPreviousRevision(obj, []PreviousObjectSet{prev})
+		}
+	}
+	return false
+}
+
 func (c *defaultAdoptionChecker) isControlledByPreviousRevision(
 	obj client.Object, previous []PreviousObjectSet,
 ) bool {
@@ -3,8 +3,10 @@ package controllers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -15,13 +17,17 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
 	"package-operator.run/internal/constants"
+	"package-operator.run/internal/ownerhandling"
 	"package-operator.run/internal/preflight"
 	"package-operator.run/internal/testutil"
 )
@@ -264,6 +270,63 @@ func TestPhaseReconciler_TeardownPhase(t *testing.T) { //nolint:maintidx
 		ownerStrategy.AssertCalled(t, "IsController", ownerObj, currentObj)
 	})
 
+	t.Run("waves in reverse order", func(t *testing.T) {
+		t.Parallel()
+		testClient := testutil.NewClient()
+		dynamicCache := &dynamicCacheMock{}
+		uncachedClient := testutil.NewClient()
+		ownerStrategy := &ownerStrategyMock{}
+		preflightChecker := &preflightCheckerMock{}
+
+		r := &PhaseReconciler{
+			writer:           testClient,
+			dynamicCache:     dynamicCache,
+			uncachedClient:   uncachedClient,
+			ownerStrategy:    ownerStrategy,
+			preflightChecker: preflightChecker,
+		}
+
+		owner := &phaseObjectOwnerMock{}
+		ownerObj := &unstructured.Unstructured{}
+		owner.On("ClientObject").Return(ownerObj)
+		owner.On("GetRevision").Return(int64(5))
+
+		preflightChecker.
+			On("Check", mock.Anything, mock.Anything, mock.Anything).
+			Return([]preflight.Violation{}, nil)
+		dynamicCache.
+			On("Watch", mock.Anything, ownerObj, mock.Anything).
+			Return(nil)
+		uncachedClient.
+			On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+		ownerStrategy.
+			On("IsController", ownerObj, mock.Anything).
+			Return(true)
+		testClient.
+			On("Delete", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		newObj := func(name string) unstructured.Unstructured {
+			obj := unstructured.Unstructured{}
+			obj.SetName(name)
+			return obj
+		}
+		done, err := r.TeardownPhase(context.Background(), owner, corev1alpha1.ObjectSetTemplatePhase{
+			Objects: []corev1alpha1.ObjectSetObject{
+				{Object: newObj("first")},
+				{Object: newObj("last"), Wave: 1},
+			},
+		})
+		require.NoError(t, err)
+		assert.False(t, done)
+
+		// The first wave is only deleted after the last wave is gone.
+		testClient.AssertNumberOfCalls(t, "Delete", 1)
+		deleted := testClient.Calls[0].Arguments.Get(1).(*unstructured.Unstructured)
+		assert.Equal(t, "last", deleted.GetName())
+	})
+
 	t.Run("not controller", func(t *testing.T) {
 		t.Parallel()
 
@@ -447,6 +510,109 @@ func TestPhaseReconciler_TeardownPhase(t *testing.T) { //nolint:maintidx
 	})
 }
 
+func TestPhaseReconciler_TeardownPhase_deletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		policy       corev1alpha1.DeletionPolicy
+		ownerDeleted bool
+		released     bool
+	}{
+		{name: "delete", policy: corev1alpha1.DeletionPolicyDelete, ownerDeleted: true},
+		{name: "orphan archived", policy: corev1alpha1.DeletionPolicyOrphan, released: true},
+		{name: "orphan deleted", policy: corev1alpha1.DeletionPolicyOrphan, ownerDeleted: true, released: true},
+		{name: "retain archived", policy: corev1alpha1.DeletionPolicyRetain},
+		{name: "retain deleted", policy: corev1alpha1.DeletionPolicyRetain, ownerDeleted: true, released: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			testClient := testutil.NewClient()
+			dynamicCache := &dynamicCacheMock{}
+			uncachedClient := testutil.NewClient()
+			ownerStrategy := &ownerStrategyMock{}
+			preflightChecker := &preflightCheckerMock{}
+			r := &PhaseReconciler{
+				writer:           testClient,
+				dynamicCache:     dynamicCache,
+				uncachedClient:   uncachedClient,
+				ownerStrategy:    ownerStrategy,
+				preflightChecker: preflightChecker,
+			}
+
+			owner := &phaseObjectOwnerMock{}
+			ownerObj := &unstructured.Unstructured{}
+			if test.ownerDeleted {
+				ownerObj.SetDeletionTimestamp(ptr.To(metav1.Now()))
+			}
+			owner.On("ClientObject").Return(ownerObj)
+			owner.On("GetRevision").Return(int64(5))
+
+			preflightChecker.
+				On("Check", mock.Anything, mock.Anything, mock.Anything).
+				Return([]preflight.Violation{}, nil)
+			ownerStrategy.
+				On("SetControllerReference", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+			dynamicCache.
+				On("Watch", mock.Anything, ownerObj, mock.Anything).
+				Return(nil)
+
+			currentObj := &unstructured.Unstructured{}
+			currentObj.SetLabels(map[string]string{
+				constants.DynamicCacheLabel:            "True",
+				manifestsv1alpha1.PackageLabel:         "test",
+				manifestsv1alpha1.PackageInstanceLabel: "test",
+				"app":                                  "test",
+			})
+			currentObj.SetAnnotations(map[string]string{
+				corev1alpha1.ObjectSetRevisionAnnotation: "5",
+				"note":                                   "test",
+			})
+			uncachedClient.
+				On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					out := args.Get(2).(*unstructured.Unstructured)
+					*out = *currentObj
+				}).
+				Return(nil)
+
+			ownerStrategy.On("IsController", ownerObj, mock.Anything).Return(true)
+			ownerStrategy.On("RemoveOwner", ownerObj, mock.Anything)
+			testClient.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			testClient.On("Delete", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			done, err := r.TeardownPhase(context.Background(), owner, corev1alpha1.ObjectSetTemplatePhase{
+				Objects: []corev1alpha1.ObjectSetObject{
+					{
+						Object:         unstructured.Unstructured{},
+						DeletionPolicy: test.policy,
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			if !test.released {
+				// wait for delete confirm
+				assert.False(t, done)
+				testClient.AssertCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+				testClient.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.True(t, done)
+			ownerStrategy.AssertCalled(t, "RemoveOwner", ownerObj, mock.Anything)
+			testClient.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+			testClient.AssertCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+			updated := testClient.Calls[0].Arguments.Get(1).(*unstructured.Unstructured)
+			assert.Equal(t, map[string]string{"app": "test"}, updated.GetLabels())
+			assert.Equal(t, map[string]string{"note": "test"}, updated.GetAnnotations())
+		})
+	}
+}
+
 func TestPhaseReconciler_reconcileObject_create(t *testing.T) {
 	t.Parallel()
 
@@ -472,7 +638,7 @@ func TestPhaseReconciler_reconcileObject_create(t *testing.T) {
 
 	ctx := context.Background()
 	desired := &unstructured.Unstructured{}
-	actual, err := r.reconcileObject(ctx, owner, desired, nil, corev1alpha1.CollisionProtectionPrevent)
+	actual, _, err := r.reconcileObject(ctx, owner, desired, nil, corev1alpha1.CollisionProtectionPrevent)
 	require.NoError(t, err)
 
 	assert.Same(t, desired, actual)
@@ -528,7 +694,7 @@ func TestPhaseReconciler_reconcileObject_update(t *testing.T) {
 	obj := &unstructured.Unstructured{}
 	// set owner refs so we don't run into the panic
 	obj.SetOwnerReferences([]metav1.OwnerReference{{}})
-	actual, err := r.reconcileObject(ctx, owner, obj, nil, corev1alpha1.CollisionProtectionPrevent)
+	actual, _, err := r.reconcileObject(ctx, owner, obj, nil, corev1alpha1.CollisionProtectionPrevent)
 	require.NoError(t, err)
 
 	assert.Equal(t, &unstructured.Unstructured{
@@ -550,6 +716,263 @@ func TestPhaseReconciler_reconcileObject_update(t *testing.T) {
 	}, actual)
 }
 
+func TestPhaseReconciler_reconcileObject_drift(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		observeOnly bool
+		adopt       bool
+	}{
+		{name: "corrected"},
+		{name: "observe-only", observeOnly: true},
+		{name: "observe-only adopted", observeOnly: true, adopt: true},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dynamicCacheMock := &dynamicCacheMock{}
+			acMock := &adoptionCheckerMock{}
+			ownerStrategy := &ownerStrategyMock{}
+			patcher := &patcherMock{}
+			writer := testutil.NewClient()
+			r := &PhaseReconciler{
+				writer:          writer,
+				dynamicCache:    dynamicCacheMock,
+				adoptionChecker: acMock,
+				ownerStrategy:   ownerStrategy,
+				patcher:         patcher,
+			}
+			owner := &phaseObjectOwnerMock{}
+			owner.On("ClientObject").Return(&unstructured.Unstructured{})
+			owner.On("GetRevision").Return(int64(2))
+
+			acMock.
+				On("Check", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(test.adopt, nil)
+			ownerStrategy.On("ReleaseController", mock.Anything)
+			ownerStrategy.
+				On("SetControllerReference", mock.Anything, mock.Anything).
+				Return(nil)
+			ownerStrategy.
+				On("OwnerPatch", mock.Anything).
+				Return([]byte(`{"metadata":{}}`), nil)
+			writer.
+				On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+			dynamicCacheMock.
+				On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					obj := args.Get(2).(*unstructured.Unstructured)
+					_ = unstructured.SetNestedField(obj.Object, "hot-patched", "data", "key")
+					if test.observeOnly {
+						obj.SetLabels(map[string]string{
+							corev1alpha1.ObjectSetObserveOnlyLabel: "True",
+						})
+					}
+				}).
+				Return(nil)
+			ownerStrategy.
+				On("IsController", mock.Anything, mock.Anything).
+				Return(true)
+			patcher.
+				On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+
+			desired := &unstructured.Unstructured{
+				Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"data": map[string]any{
+						"key": "value",
+					},
+				},
+			}
+			_, drift, err := r.reconcileObject(
+				context.Background(), owner, desired, nil, corev1alpha1.CollisionProtectionPrevent)
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"data.key"}, drift)
+			if test.observeOnly {
+				patcher.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				patcher.AssertCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			if test.adopt {
+				// ownership is still taken over.
+				writer.AssertNumberOfCalls(t, "Patch", 1)
+			} else {
+				writer.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+// Returns a PhaseReconciler recording owners in ObjectOwnerships,
+// together with the strategy and the client the ObjectOwnerships are written to.
+func newOwnershipPhaseReconciler(
+	t *testing.T, dynamicCache dynamicCache, patcher patcher,
+) (*PhaseReconciler, *ownerhandling.OwnerStrategyOwnership, client.Client) {
+	t.Helper()
+
+	c := fake.NewClientBuilder().WithScheme(testScheme).Build()
+	strategy, err := ownerhandling.NewOwnership(
+		logr.Discard(), testScheme, c, c, &controllertest.FakeInformer{Synced: true})
+	require.NoError(t, err)
+
+	r := &PhaseReconciler{
+		scheme:          testScheme,
+		writer:          testutil.NewClient(),
+		dynamicCache:    dynamicCache,
+		ownerStrategy:   strategy,
+		adoptionChecker: &defaultAdoptionChecker{ownerStrategy: strategy, scheme: testScheme},
+		patcher:         patcher,
+	}
+	return r, strategy, c
+}
+
+func newOwnershipTestObjectSet(name string, uid types.UID) *corev1alpha1.ObjectSet {
+	return &corev1alpha1.ObjectSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+			UID:       uid,
+		},
+	}
+}
+
+func newOwnershipTestConfigMap() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{
+				"name":      "cm",
+				"namespace": "test",
+			},
+		},
+	}
+}
+
+func TestPhaseReconciler_reconcilePhaseObject_ownershipForeignController(t *testing.T) {
+	t.Parallel()
+
+	for _, collisionProtection := range []corev1alpha1.CollisionProtection{
+		corev1alpha1.CollisionProtectionPrevent,
+		corev1alpha1.CollisionProtectionIfNoController,
+	} {
+		collisionProtection := collisionProtection
+		t.Run(string(collisionProtection), func(t *testing.T) {
+			t.Parallel()
+
+			dynamicCache := &dynamicCacheMock{}
+			patcher := &patcherMock{}
+			r, strategy, c := newOwnershipPhaseReconciler(t, dynamicCache, patcher)
+
+			ownerObj := newOwnershipTestObjectSet("rev1", "1")
+			owner := &phaseObjectOwnerMock{}
+			owner.On("ClientObject").Return(ownerObj)
+			owner.On("GetRevision").Return(int64(1))
+			owner.On("IsPaused").Return(false)
+			owner.On("GetAdoptionPolicy").Return(nil)
+
+			// Object on the cluster, controlled by someone else.
+			current := newOwnershipTestConfigMap()
+			current.SetResourceVersion("1")
+			current.SetOwnerReferences([]metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "foreign",
+				UID:        "foreign",
+				Controller: ptr.To(true),
+			}})
+			dynamicCache.
+				On("Watch", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+			dynamicCache.
+				On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					obj := args.Get(2).(*unstructured.Unstructured)
+					obj.Object = current.DeepCopy().Object
+				}).
+				Return(nil)
+
+			_, _, err := r.reconcilePhaseObject(context.Background(), owner,
+				corev1alpha1.ObjectSetObject{CollisionProtection: collisionProtection},
+				newOwnershipTestConfigMap(), nil)
+			var notOwnedErr *ObjectNotOwnedByPreviousRevisionError
+			require.ErrorAs(t, err, &notOwnedErr)
+
+			patcher.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			assert.False(t, strategy.IsOwner(ownerObj, current))
+
+			ownerships := &corev1alpha1.ObjectOwnershipList{}
+			require.NoError(t, c.List(context.Background(), ownerships))
+			assert.Empty(t, ownerships.Items)
+		})
+	}
+}
+
+func TestPhaseReconciler_reconcilePhaseObject_ownershipHandOver(t *testing.T) {
+	t.Parallel()
+
+	dynamicCache := &dynamicCacheMock{}
+	patcher := &patcherMock{}
+	r, strategy, c := newOwnershipPhaseReconciler(t, dynamicCache, patcher)
+	ctx := context.Background()
+
+	rev1 := newOwnershipTestObjectSet("rev1", "1")
+	rev2 := newOwnershipTestObjectSet("rev2", "2")
+
+	// Object on the cluster, controlled by the previous revision.
+	current := newOwnershipTestConfigMap()
+	current.SetResourceVersion("1")
+	current.SetAnnotations(map[string]string{
+		corev1alpha1.ObjectSetRevisionAnnotation: "1",
+	})
+	created := newOwnershipTestConfigMap()
+	require.NoError(t, strategy.SetControllerReference(rev1, created))
+	require.NoError(t, strategy.PersistOwners(ctx, created))
+
+	owner := &phaseObjectOwnerMock{}
+	owner.On("ClientObject").Return(rev2)
+	owner.On("GetRevision").Return(int64(2))
+	owner.On("IsPaused").Return(false)
+
+	dynamicCache.
+		On("Watch", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	dynamicCache.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(2).(*unstructured.Unstructured)
+			obj.Object = current.DeepCopy().Object
+		}).
+		Return(nil)
+	patcher.
+		On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	_, _, err := r.reconcilePhaseObject(ctx, owner,
+		corev1alpha1.ObjectSetObject{CollisionProtection: corev1alpha1.CollisionProtectionPrevent},
+		newOwnershipTestConfigMap(), []PreviousObjectSet{newPreviousObjectSetMockWithoutRemotes(rev1)})
+	require.NoError(t, err)
+
+	patcher.AssertCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	assert.True(t, strategy.IsController(rev2, current))
+	assert.False(t, strategy.IsController(rev1, current))
+	assert.True(t, strategy.IsOwner(rev1, current))
+
+	ownerships := &corev1alpha1.ObjectOwnershipList{}
+	require.NoError(t, c.List(ctx, ownerships))
+	if assert.Len(t, ownerships.Items, 1) {
+		assert.Len(t, ownerships.Items[0].Spec.Owners, 2)
+	}
+}
+
 func TestPhaseReconciler_desiredObject(t *testing.T) {
 	t.Parallel()
 
@@ -744,6 +1167,46 @@ func Test_defaultAdoptionChecker_Check(t *testing.T) {
 			},
 			needsAdoption: false,
 		},
+		{
+			// Object is owned by the newer revision the owner is rolled back from.
+			name: "owned by newer revision rolled back from",
+			mockPrepare: func(
+				osm *ownerStrategyMock,
+				owner *phaseObjectOwnerMock,
+			) {
+				ownerObj := &unstructured.Unstructured{
+					Object: map[string]any{},
+				}
+				ownerObj.SetAnnotations(map[string]string{
+					corev1alpha1.ObjectSetRollbackFromAnnotation: "failed",
+				})
+				owner.On("ClientObject").Return(ownerObj)
+				osm.
+					On("IsController", ownerObj, mock.Anything).
+					Return(false)
+				osm.
+					On("IsController", mock.AnythingOfType("*unstructured.Unstructured"), mock.Anything).
+					Return(true)
+				owner.
+					On("GetRevision").Return(int64(34))
+			},
+			previous: []PreviousObjectSet{
+				newPreviousObjectSetMockWithoutRemotes(
+					&unstructured.Unstructured{Object: map[string]any{
+						"metadata": map[string]any{"name": "failed"},
+					}}),
+			},
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"metadata": map[string]any{
+						"annotations": map[string]any{
+							corev1alpha1.ObjectSetRevisionAnnotation: "100",
+						},
+					},
+				},
+			},
+			needsAdoption: true,
+		},
 		{
 			// Object owner is not in previous revision list.
 			name: "object not owned by previous revision",
@@ -754,11 +1217,15 @@ func Test_defaultAdoptionChecker_Check(t *testing.T) {
 				osm.
 					On("IsController", mock.Anything, mock.Anything).
 					Return(false)
+				osm.
+					On("HasController", mock.Anything).
+					Return(false)
 				ownerObj := &unstructured.Unstructured{
 					Object: map[string]any{},
 				}
 				owner.On("ClientObject").Return(ownerObj)
 				owner.On("GetRevision").Return(int64(1))
+				owner.On("GetAdoptionPolicy").Return(nil)
 			},
 			previous: []PreviousObjectSet{
 				newPreviousObjectSetMockWithoutRemotes(
@@ -770,6 +1237,225 @@ func Test_defaultAdoptionChecker_Check(t *testing.T) {
 			errorAs:       &ObjectNotOwnedByPreviousRevisionError{},
 			needsAdoption: false,
 		},
+		{
+			name: "adoption policy selector match",
+			mockPrepare: func(
+				osm *ownerStrategyMock,
+				owner *phaseObjectOwnerMock,
+			) {
+				osm.
+					On("IsController", mock.Anything, mock.Anything).
+					Return(false)
+				osm.
+					On("HasController", mock.Anything).
+					Return(false)
+				owner.On("ClientObject").Return(&unstructured.Unstructured{})
+				owner.On("GetRevision").Return(int64(1))
+				owner.On("GetAdoptionPolicy").Return(&corev1alpha1.AdoptionPolicy{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "test"},
+					},
+				})
+			},
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"metadata": map[string]any{
+						"labels": map[string]any{
+							"app": "test",
+						},
+					},
+				},
+			},
+			needsAdoption: true,
+		},
+		{
+			name: "adoption policy selector mismatch",
+			mockPrepare: func(
+				osm *ownerStrategyMock,
+				owner *phaseObjectOwnerMock,
+			) {
+				osm.
+					On("IsController", mock.Anything, mock.Anything).
+					Return(false)
+				osm.
+					On("HasController", mock.Anything).
+					Return(false)
+				owner.On("ClientObject").Return(&unstructured.Unstructured{})
+				owner.On("GetRevision").Return(int64(1))
+				owner.On("GetAdoptionPolicy").Return(&corev1alpha1.AdoptionPolicy{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "test"},
+					},
+				})
+			},
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"metadata": map[string]any{
+						"labels": map[string]any{
+							"app": "other",
+						},
+					},
+				},
+			},
+			errorAs:       &ObjectNotOwnedByPreviousRevisionError{},
+			needsAdoption: false,
+		},
+		{
+			name: "adoption policy adopt-by annotation",
+			mockPrepare: func(
+				osm *ownerStrategyMock,
+				owner *phaseObjectOwnerMock,
+			) {
+				osm.
+					On("IsController", mock.Anything, mock.Anything).
+					Return(false)
+				osm.
+					On("HasController", mock.Anything).
+					Return(false)
+				ownerObj := &unstructured.Unstructured{}
+				ownerObj.SetLabels(map[string]string{
+					manifestsv1alpha1.PackageInstanceLabel: "my-pkg",
+				})
+				owner.On("ClientObject").Return(ownerObj)
+				owner.On("GetRevision").Return(int64(1))
+				owner.On("GetAdoptionPolicy").Return(&corev1alpha1.AdoptionPolicy{})
+			},
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"metadata": map[string]any{
+						"annotations": map[string]any{
+							corev1alpha1.ObjectSetAdoptByAnnotation: "my-pkg",
+						},
+					},
+				},
+			},
+			needsAdoption: true,
+		},
+		{
+			name: "adoption policy adopt-by annotation namespaced",
+			mockPrepare: func(
+				osm *ownerStrategyMock,
+				owner *phaseObjectOwnerMock,
+			) {
+				osm.
+					On("IsController", mock.Anything, mock.Anything).
+					Return(false)
+				osm.
+					On("HasController", mock.Anything).
+					Return(false)
+				ownerObj := &unstructured.Unstructured{}
+				ownerObj.SetNamespace("my-ns")
+				ownerObj.SetLabels(map[string]string{
+					manifestsv1alpha1.PackageInstanceLabel: "my-pkg",
+				})
+				owner.On("ClientObject").Return(ownerObj)
+				owner.On("GetRevision").Return(int64(1))
+				owner.On("GetAdoptionPolicy").Return(&corev1alpha1.AdoptionPolicy{})
+			},
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"metadata": map[string]any{
+						"annotations": map[string]any{
+							corev1alpha1.ObjectSetAdoptByAnnotation: "my-ns/my-pkg",
+						},
+					},
+				},
+			},
+			needsAdoption: true,
+		},
+		{
+			name: "adoption policy adopt-by annotation namespaced without namespace",
+			mockPrepare: func(
+				osm *ownerStrategyMock,
+				owner *phaseObjectOwnerMock,
+			) {
+				osm.
+					On("IsController", mock.Anything, mock.Anything).
+					Return(false)
+				osm.
+					On("HasController", mock.Anything).
+					Return(false)
+				ownerObj := &unstructured.Unstructured{}
+				ownerObj.SetNamespace("my-ns")
+				ownerObj.SetLabels(map[string]string{
+					manifestsv1alpha1.PackageInstanceLabel: "my-pkg",
+				})
+				owner.On("ClientObject").Return(ownerObj)
+				owner.On("GetRevision").Return(int64(1))
+				owner.On("GetAdoptionPolicy").Return(&corev1alpha1.AdoptionPolicy{})
+			},
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"metadata": map[string]any{
+						"annotations": map[string]any{
+							corev1alpha1.ObjectSetAdoptByAnnotation: "my-pkg",
+						},
+					},
+				},
+			},
+			errorAs:       &ObjectNotOwnedByPreviousRevisionError{},
+			needsAdoption: false,
+		},
+		{
+			name: "adoption policy ignores controlled objects",
+			mockPrepare: func(
+				osm *ownerStrategyMock,
+				owner *phaseObjectOwnerMock,
+			) {
+				osm.
+					On("IsController", mock.Anything, mock.Anything).
+					Return(false)
+				osm.
+					On("HasController", mock.Anything).
+					Return(true)
+				owner.On("ClientObject").Return(&unstructured.Unstructured{})
+				owner.On("GetRevision").Return(int64(1))
+			},
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"metadata": map[string]any{
+						"labels": map[string]any{
+							"app": "test",
+						},
+					},
+				},
+			},
+			errorAs:       &ObjectNotOwnedByPreviousRevisionError{},
+			needsAdoption: false,
+		},
+		{
+			name: "adoption policy dry run",
+			mockPrepare: func(
+				osm *ownerStrategyMock,
+				owner *phaseObjectOwnerMock,
+			) {
+				osm.
+					On("IsController", mock.Anything, mock.Anything).
+					Return(false)
+				osm.
+					On("HasController", mock.Anything).
+					Return(false)
+				owner.On("ClientObject").Return(&unstructured.Unstructured{})
+				owner.On("GetRevision").Return(int64(1))
+				owner.On("GetAdoptionPolicy").Return(&corev1alpha1.AdoptionPolicy{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "test"},
+					},
+					DryRun: true,
+				})
+			},
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"metadata": map[string]any{
+						"labels": map[string]any{
+							"app": "test",
+						},
+					},
+				},
+			},
+			errorAs:       &AdoptionDryRunError{},
+			needsAdoption: false,
+		},
 		{
 			// both the object and the owner have the same revision number,
 			// but the owner is not the same.
@@ -1576,12 +2262,158 @@ func TestPhaseReconciler_ReconcilePhase_preflightError(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	_, _, err := pr.ReconcilePhase(
+	_, _, _, _, err := pr.ReconcilePhase(
 		ctx, owner, phase, nil, nil)
 	var pErr *preflight.Error
 	require.ErrorAs(t, err, &pErr)
 }
 
+type proberStub func(obj *unstructured.Unstructured) (bool, string)
+
+func (p proberStub) Probe(obj *unstructured.Unstructured) (bool, string) {
+	return p(obj)
+}
+
+func TestPhaseReconciler_ReconcilePhase_adoptionDryRun(t *testing.T) {
+	t.Parallel()
+
+	dynamicCache := &dynamicCacheMock{}
+	acMock := &adoptionCheckerMock{}
+	ownerStrategy := &ownerStrategyMock{}
+	pcm := &preflightCheckerMock{}
+	pr := &PhaseReconciler{
+		scheme:           testScheme,
+		dynamicCache:     dynamicCache,
+		adoptionChecker:  acMock,
+		ownerStrategy:    ownerStrategy,
+		preflightChecker: pcm,
+	}
+
+	ownerObj := &unstructured.Unstructured{}
+	owner := &phaseObjectOwnerMock{}
+	owner.On("ClientObject").Return(ownerObj)
+	owner.On("GetRevision").Return(int64(12))
+	owner.On("IsPaused").Return(false)
+
+	pcm.
+		On("Check", mock.Anything, mock.Anything, mock.Anything).
+		Return([]preflight.Violation{}, nil)
+	ownerStrategy.
+		On("SetControllerReference", mock.Anything, mock.Anything).
+		Return(nil)
+	dynamicCache.
+		On("Watch", mock.Anything, ownerObj, mock.Anything).
+		Return(nil)
+	dynamicCache.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	acMock.
+		On("Check", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(false, &AdoptionDryRunError{})
+
+	obj := unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	obj.SetName("deploy")
+	obj.SetNamespace("test")
+	phase := corev1alpha1.ObjectSetTemplatePhase{
+		Name: "phase",
+		Objects: []corev1alpha1.ObjectSetObject{
+			{Object: obj},
+		},
+	}
+
+	ctx := context.Background()
+	actualObjects, res, _, wouldAdopt, err := pr.ReconcilePhase(
+		ctx, owner, phase, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, actualObjects)
+	assert.True(t, res.IsZero())
+	assert.Empty(t, res.Objects)
+	assert.Equal(t, []corev1alpha1.ControlledObjectReference{
+		{Group: "apps", Kind: "Deployment", Name: "deploy", Namespace: "test"},
+	}, wouldAdopt)
+}
+
+func Test_recordingProbe(t *testing.T) {
+	t.Parallel()
+
+	rec := newRecordingProbe("phase", proberStub(func(obj *unstructured.Unstructured) (bool, string) {
+		return obj.GetName() == "available", "message"
+	}))
+
+	newObj := func(name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+		obj.SetName(name)
+		obj.SetNamespace("test")
+		return obj
+	}
+	rec.Probe(newObj("available"))
+	rec.Probe(newObj("unavailable"))
+	rec.RecordMissingObject(newObj("missing"))
+
+	res := rec.Result()
+	assert.False(t, res.IsZero())
+	assert.Equal(t, "phase", res.PhaseName)
+	assert.Equal(t, []string{
+		"apps Deployment test/unavailable: message",
+		"apps Deployment test/missing: not found",
+	}, res.FailedProbes)
+	assert.Equal(t, []corev1alpha1.ObjectProbeStatus{
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "available", Namespace: "test",
+			Status: metav1.ConditionTrue,
+		},
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "unavailable", Namespace: "test",
+			Status: metav1.ConditionFalse, Message: "message",
+		},
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "missing", Namespace: "test",
+			Status: metav1.ConditionFalse, Message: "not found",
+		},
+	}, res.Objects)
+}
+
+func TestProbingResult_StringWithoutPhase(t *testing.T) {
+	t.Parallel()
+
+	res := ProbingResult{PhaseName: "phase"}
+	for i := range 12 {
+		res.FailedProbes = append(res.FailedProbes, fmt.Sprintf("probe %d", i))
+	}
+	assert.Equal(t,
+		"probe 0, probe 1, probe 2, probe 3, probe 4, probe 5, probe 6, probe 7, probe 8, probe 9 and 2 more",
+		res.StringWithoutPhase())
+
+	res.FailedProbes = res.FailedProbes[:2]
+	assert.Equal(t, "probe 0, probe 1", res.StringWithoutPhase())
+}
+
+func Test_phaseWaves(t *testing.T) {
+	t.Parallel()
+
+	phase := corev1alpha1.ObjectSetTemplatePhase{
+		Objects: []corev1alpha1.ObjectSetObject{
+			{Wave: 1},
+			{},
+			{Wave: -1},
+			{Wave: 1},
+		},
+		ExternalObjects: []corev1alpha1.ObjectSetObject{
+			{Wave: 2},
+			{},
+		},
+	}
+
+	assert.Equal(t, []phaseWave{
+		{wave: -1, objects: []int{2}},
+		{wave: 0, objects: []int{1}, externalObjects: []int{1}},
+		{wave: 1, objects: []int{0, 3}},
+		{wave: 2, externalObjects: []int{0}},
+	}, phaseWaves(phase))
+}
+
 func hasDynamicCacheLabel(obj corev1alpha1.ObjectSetObject) bool {
 	labels := obj.Object.GetLabels()
 
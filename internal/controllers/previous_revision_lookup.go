@@ -44,6 +44,11 @@ func (l *PreviousRevisionLookup) Lookup(
 	ctx context.Context, owner PreviousOwner,
 ) ([]PreviousObjectSet, error) {
 	previous := owner.GetPrevious()
+	// Rolled back revisions take over the objects of the failed revision.
+	if source, ok := owner.ClientObject().GetAnnotations()[corev1alpha1.ObjectSetRollbackFromAnnotation]; ok {
+		previous = append(previous[:len(previous):len(previous)],
+			corev1alpha1.PreviousRevisionReference{Name: source})
+	}
 	previousSets := make([]PreviousObjectSet, len(previous))
 	for i, prev := range previous {
 		set := l.newPreviousObjectSet(l.scheme)
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// UpdateObjectProbeStatus returns the current probe results to be reported in status.
+// The LastTransitionTime of objects with unchanged status is taken from previous,
+// otherwise it's set to now, unless the result already carries a transition time.
+func UpdateObjectProbeStatus(
+	previous, current []corev1alpha1.ObjectProbeStatus, now metav1.Time,
+) []corev1alpha1.ObjectProbeStatus {
+	if len(current) == 0 {
+		return nil
+	}
+
+	out := make([]corev1alpha1.ObjectProbeStatus, len(current))
+	for i, status := range current {
+		prev := findObjectProbeStatus(previous, status)
+		switch {
+		case prev != nil && prev.Status == status.Status:
+			status.LastTransitionTime = prev.LastTransitionTime
+		case status.LastTransitionTime.IsZero():
+			status.LastTransitionTime = now
+		}
+		out[i] = status
+	}
+	return out
+}
+
+func findObjectProbeStatus(
+	list []corev1alpha1.ObjectProbeStatus, status corev1alpha1.ObjectProbeStatus,
+) *corev1alpha1.ObjectProbeStatus {
+	for i := range list {
+		if list[i].Group == status.Group &&
+			list[i].Kind == status.Kind &&
+			list[i].Name == status.Name &&
+			list[i].Namespace == status.Namespace {
+			return &list[i]
+		}
+	}
+	return nil
+}
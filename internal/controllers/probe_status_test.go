@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestUpdateObjectProbeStatus(t *testing.T) {
+	t.Parallel()
+
+	before := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	remote := metav1.NewTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC))
+
+	previous := []corev1alpha1.ObjectProbeStatus{
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "unchanged", Namespace: "test",
+			Status: metav1.ConditionFalse, Message: "old message", LastTransitionTime: before,
+		},
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "changed", Namespace: "test",
+			Status: metav1.ConditionFalse, LastTransitionTime: before,
+		},
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "removed", Namespace: "test",
+			Status: metav1.ConditionTrue, LastTransitionTime: before,
+		},
+	}
+	current := []corev1alpha1.ObjectProbeStatus{
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "unchanged", Namespace: "test",
+			Status: metav1.ConditionFalse, Message: "new message",
+		},
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "changed", Namespace: "test",
+			Status: metav1.ConditionTrue,
+		},
+		{
+			Version: "v1", Kind: "ConfigMap", Name: "new", Namespace: "test",
+			Status: metav1.ConditionTrue,
+		},
+		{
+			Version: "v1", Kind: "ConfigMap", Name: "remote", Namespace: "test",
+			Status: metav1.ConditionTrue, LastTransitionTime: remote,
+		},
+	}
+
+	assert.Equal(t, []corev1alpha1.ObjectProbeStatus{
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "unchanged", Namespace: "test",
+			Status: metav1.ConditionFalse, Message: "new message", LastTransitionTime: before,
+		},
+		{
+			Group: "apps", Version: "v1", Kind: "Deployment", Name: "changed", Namespace: "test",
+			Status: metav1.ConditionTrue, LastTransitionTime: now,
+		},
+		{
+			Version: "v1", Kind: "ConfigMap", Name: "new", Namespace: "test",
+			Status: metav1.ConditionTrue, LastTransitionTime: now,
+		},
+		{
+			Version: "v1", Kind: "ConfigMap", Name: "remote", Namespace: "test",
+			Status: metav1.ConditionTrue, LastTransitionTime: remote,
+		},
+	}, UpdateObjectProbeStatus(previous, current, now))
+
+	assert.Nil(t, UpdateObjectProbeStatus(previous, nil, now))
+}
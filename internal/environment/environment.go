@@ -105,7 +105,7 @@ func (m *Manager) Start(ctx context.Context) error {
 func (m *Manager) do(ctx context.Context) error {
 	log := logr.FromContextOrDiscard(ctx)
 
-	env, err := m.probe(ctx)
+	env, err := m.Probe(ctx)
 	if err != nil {
 		return err
 	}
@@ -118,7 +118,8 @@ func (m *Manager) do(ctx context.Context) error {
 	return nil
 }
 
-func (m *Manager) probe(ctx context.Context) (
+// Probe detects the environment of the cluster once.
+func (m *Manager) Probe(ctx context.Context) (
 	env *manifests.PackageEnvironment, err error,
 ) {
 	env = &manifests.PackageEnvironment{}
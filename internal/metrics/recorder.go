@@ -24,8 +24,9 @@ type Recorder struct {
 	packageLoadDuration *prometheus.GaugeVec
 	packageRevision     *prometheus.GaugeVec
 
-	objectSetCreated   *prometheus.GaugeVec
-	objectSetSucceeded *prometheus.GaugeVec
+	objectSetCreated        *prometheus.GaugeVec
+	objectSetSucceeded      *prometheus.GaugeVec
+	objectSetDriftedObjects *prometheus.GaugeVec
 }
 
 func NewRecorder() *Recorder {
@@ -80,6 +81,12 @@ func NewRecorder() *Recorder {
 			Help: "ObjectSet Unix success timestamp.",
 		}, []string{"pko_name", "pko_namespace", "pko_package_instance"},
 	)
+	objectSetDriftedObjects := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "package_operator_object_set_drifted_objects",
+			Help: "Number of objects differing from their desired state.",
+		}, []string{"pko_name", "pko_namespace", "pko_package_instance"},
+	)
 
 	return &Recorder{
 		dynamicCacheInformers: dynamicCacheInformers,
@@ -90,8 +97,9 @@ func NewRecorder() *Recorder {
 		packageLoadDuration: packageLoadDuration,
 		packageRevision:     packageRevision,
 
-		objectSetCreated:   objectSetCreated,
-		objectSetSucceeded: objectSetSucceeded,
+		objectSetCreated:        objectSetCreated,
+		objectSetSucceeded:      objectSetSucceeded,
+		objectSetDriftedObjects: objectSetDriftedObjects,
 	}
 }
 
@@ -101,7 +109,7 @@ func (r *Recorder) Register() {
 		r.dynamicCacheInformers, r.dynamicCacheObjects,
 		r.packageAvailability, r.packageCreated, r.packageLoadDuration, r.packageRevision,
 
-		r.objectSetCreated, r.objectSetSucceeded,
+		r.objectSetCreated, r.objectSetSucceeded, r.objectSetDriftedObjects,
 	)
 }
 
@@ -156,6 +164,7 @@ func (r *Recorder) RecordPackageLoadMetric(pkg GenericPackage, d time.Duration)
 type GenericObjectSet interface {
 	ClientObject() client.Object
 	GetConditions() *[]metav1.Condition
+	GetStatusDriftedObjects() []corev1alpha1.DriftedObject
 }
 
 func (r *Recorder) RecordObjectSetMetrics(objectSet GenericObjectSet) {
@@ -170,6 +179,7 @@ func (r *Recorder) RecordObjectSetMetrics(objectSet GenericObjectSet) {
 	if !obj.GetDeletionTimestamp().IsZero() ||
 		meta.IsStatusConditionTrue(*objectSet.GetConditions(), corev1alpha1.ObjectSetArchived) {
 		r.objectSetSucceeded.DeleteLabelValues(obj.GetName(), obj.GetNamespace(), instance)
+		r.objectSetDriftedObjects.DeleteLabelValues(obj.GetName(), obj.GetNamespace(), instance)
 	} else {
 		succeededCond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetSucceeded)
 		if succeededCond != nil {
@@ -177,6 +187,9 @@ func (r *Recorder) RecordObjectSetMetrics(objectSet GenericObjectSet) {
 				WithLabelValues(obj.GetName(), obj.GetNamespace(), instance).
 				Set(float64(succeededCond.LastTransitionTime.Unix()))
 		}
+		r.objectSetDriftedObjects.
+			WithLabelValues(obj.GetName(), obj.GetNamespace(), instance).
+			Set(float64(len(objectSet.GetStatusDriftedObjects())))
 	}
 
 	if !obj.GetDeletionTimestamp().IsZero() {
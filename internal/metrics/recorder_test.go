@@ -29,6 +29,11 @@ func (m *genericObjectSetMock) GetConditions() *[]metav1.Condition {
 	return args.Get(0).(*[]metav1.Condition)
 }
 
+func (m *genericObjectSetMock) GetStatusDriftedObjects() []corev1alpha1.DriftedObject {
+	args := m.Called()
+	return args.Get(0).([]corev1alpha1.DriftedObject)
+}
+
 func (m *genericObjectSetMock) GetRevision() int64 {
 	args := m.Called()
 	return args.Get(0).(int64)
@@ -182,11 +187,27 @@ func TestRecorder_RecordObjectSetMetrics(t *testing.T) {
 	tests := []struct {
 		name       string
 		conditions []metav1.Condition
+		drifted    []corev1alpha1.DriftedObject
 	}{
 		{
 			name:       "no success condition",
 			conditions: []metav1.Condition{},
 		},
+		{
+			name: "with drifted objects",
+			conditions: []metav1.Condition{
+				{
+					Type:               corev1alpha1.ObjectSetSucceeded,
+					LastTransitionTime: metav1.NewTime(successTimestamp),
+				},
+			},
+			drifted: []corev1alpha1.DriftedObject{
+				{
+					Object: corev1alpha1.ControlledObjectReference{Kind: "ConfigMap", Name: "cm"},
+					Fields: []string{"data.key"},
+				},
+			},
+		},
 		{
 			name: "with success condition",
 			conditions: []metav1.Condition{
@@ -212,6 +233,7 @@ func TestRecorder_RecordObjectSetMetrics(t *testing.T) {
 			osMock := &genericObjectSetMock{}
 			osMock.On("ClientObject").Return(obj)
 			osMock.On("GetConditions").Return(&test.conditions)
+			osMock.On("GetStatusDriftedObjects").Return(test.drifted)
 
 			recorder := NewRecorder()
 			recorder.RecordObjectSetMetrics(osMock)
@@ -232,6 +254,12 @@ func TestRecorder_RecordObjectSetMetrics(t *testing.T) {
 					0.01,
 				)
 			}
+
+			assert.InDelta(t,
+				float64(len(test.drifted)),
+				testutil.ToFloat64(recorder.objectSetDriftedObjects),
+				0.01,
+			)
 		})
 	}
 }
@@ -3,7 +3,6 @@ package ownerhandling
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -24,7 +23,7 @@ import (
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 )
 
-var _ ownerStrategy = (*OwnerStrategyAnnotation)(nil)
+var _ OwnerStrategy = (*OwnerStrategyAnnotation)(nil)
 
 const ownerStrategyAnnotationKey = "package-operator.run/owners"
 
@@ -389,20 +388,13 @@ func (e *AnnotationEnqueueRequestForOwner) getOwnerReconcileRequest(object metav
 	return requests
 }
 
-var ErrMultipleKinds = errors.New("multiple kinds error: expected exactly one kind")
-
 // parseOwnerTypeGroupKind parses the OwnerType into a Group and Kind and caches the result.
 func (e *AnnotationEnqueueRequestForOwner) parseOwnerTypeGroupKind(scheme *runtime.Scheme) error {
-	// Get the kinds of the type
-	kinds, _, err := scheme.ObjectKinds(e.OwnerType)
+	gk, err := ownerTypeGroupKind(scheme, e.OwnerType)
 	if err != nil {
 		return err
 	}
-	// Expect only 1 kind.  If there is more than one kind this is probably an edge case such as ListOptions.
-	if len(kinds) != 1 {
-		return fmt.Errorf("%w. For ownerType %T, found %s kinds", ErrMultipleKinds, e.OwnerType, kinds)
-	}
 	// Cache the Group and Kind for the OwnerType
-	e.ownerGK = schema.GroupKind{Group: kinds[0].Group, Kind: kinds[0].Kind}
+	e.ownerGK = gk
 	return nil
 }
@@ -1,18 +1,25 @@
 package ownerhandling
 
 import (
+	"errors"
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
 
-type ownerStrategy interface {
+// OwnerStrategy is implemented by all owner handling strategies.
+type OwnerStrategy interface {
 	HasController(obj metav1.Object) bool
 	IsOwner(owner, obj metav1.Object) bool
 	IsController(owner, obj metav1.Object) bool
 	ReleaseController(obj metav1.Object)
 	RemoveOwner(owner, obj metav1.Object)
+	SetOwnerReference(owner, obj metav1.Object) error
 	SetControllerReference(owner, obj metav1.Object) error
 	EnqueueRequestForOwner(ownerType client.Object, mapper meta.RESTMapper, isController bool) handler.EventHandler
 	OwnerPatch(owner metav1.Object) ([]byte, error)
@@ -24,3 +31,19 @@ func remove[T any](s []T, i int) []T {
 	s[i] = s[len(s)-1]
 	return s[:len(s)-1]
 }
+
+var ErrMultipleKinds = errors.New("multiple kinds error: expected exactly one kind")
+
+// Returns the Group and Kind of the given owner type.
+func ownerTypeGroupKind(scheme *runtime.Scheme, ownerType client.Object) (schema.GroupKind, error) {
+	// Get the kinds of the type
+	kinds, _, err := scheme.ObjectKinds(ownerType)
+	if err != nil {
+		return schema.GroupKind{}, err
+	}
+	// Expect only 1 kind.  If there is more than one kind this is probably an edge case such as ListOptions.
+	if len(kinds) != 1 {
+		return schema.GroupKind{}, fmt.Errorf("%w. For ownerType %T, found %s kinds", ErrMultipleKinds, ownerType, kinds)
+	}
+	return schema.GroupKind{Group: kinds[0].Group, Kind: kinds[0].Kind}, nil
+}
@@ -18,7 +18,7 @@ import (
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 )
 
-var _ ownerStrategy = (*OwnerStrategyNative)(nil)
+var _ OwnerStrategy = (*OwnerStrategyNative)(nil)
 
 // NativeOwner handling strategy uses .metadata.ownerReferences.
 type OwnerStrategyNative struct {
@@ -0,0 +1,759 @@
+package ownerhandling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/utils"
+)
+
+var _ OwnerStrategy = (*OwnerStrategyOwnership)(nil)
+
+var ErrOwnershipIndexNotSynced = errors.New("ObjectOwnership index has not synced yet")
+
+const (
+	// Changes staged on different instances of the same object that are kept at once.
+	// Every reconcile stages changes on new instances, so older changes are dropped
+	// to bound memory used by changes that are never persisted.
+	maxStagedPerObject = 4
+	// Interval in which ObjectOwnerships are checked for owners that no longer exist.
+	ownershipGCInterval = 10 * time.Minute
+	// Duration for which deleted ObjectOwnerships are remembered,
+	// to ignore stale events about them that arrive after their deletion.
+	ownershipDeletionTTL = 5 * time.Minute
+)
+
+// OwnershipOwner handling strategy records owners in ObjectOwnership objects.
+// Owner metadata is never written to the owned objects,
+// so this strategy works for objects that strip unknown annotations or ownerReferences.
+// Owned objects still need the dynamic cache label to produce events,
+// when the label is stripped the object leaves the cache and the resulting delete event
+// enqueues its owners, which restore the label.
+//
+// Like owner metadata on objects, owner changes only apply to the object instance they are made on,
+// until they are persisted with PersistOwners after the object itself has been written.
+// All ObjectOwnerships are kept in an in-memory index, that is fed by an informer
+// and updated with the result of every write, so changes are visible immediately.
+// As owned objects are not changed when their owners change,
+// controllers have to watch ObjectOwnerships as well, see RecordsObjectOwnerships.
+//
+// When started, ObjectOwnerships are periodically cleaned of owners that no longer exist.
+type OwnerStrategyOwnership struct {
+	log       logr.Logger
+	scheme    *runtime.Scheme
+	writer    client.Writer
+	reader    client.Reader
+	hasSynced func() bool
+
+	mux    sync.RWMutex
+	index  map[corev1alpha1.ControlledObjectReference]*corev1alpha1.ObjectOwnership
+	staged map[corev1alpha1.ControlledObjectReference][]stagedOwners
+	// Recently deleted ObjectOwnerships.
+	deleted map[corev1alpha1.ControlledObjectReference]ownershipDeletion
+}
+
+// Deletion of an ObjectOwnership at a resourceVersion.
+type ownershipDeletion struct {
+	resourceVersion string
+	deletedAt       time.Time
+}
+
+// Mutates the owners of an object.
+type ownersMutation func(owners []corev1alpha1.ObjectOwner) ([]corev1alpha1.ObjectOwner, error)
+
+// Owner changes made on a single object instance.
+type stagedOwners struct {
+	obj    metav1.Object
+	owners []corev1alpha1.ObjectOwner
+	// Mutations to replay on the indexed owners when persisting.
+	mutations []ownersMutation
+}
+
+type ownershipInformer interface {
+	AddEventHandler(handler toolscache.ResourceEventHandler) (toolscache.ResourceEventHandlerRegistration, error)
+	HasSynced() bool
+}
+
+// NewOwnership creates a new ownership strategy,
+// maintaining its index from the given ObjectOwnership informer.
+// The reader is used to check whether owners still exist and should not be cached.
+func NewOwnership(
+	log logr.Logger, scheme *runtime.Scheme,
+	writer client.Writer, reader client.Reader,
+	informer ownershipInformer,
+) (*OwnerStrategyOwnership, error) {
+	s := &OwnerStrategyOwnership{
+		log:       log,
+		scheme:    scheme,
+		writer:    writer,
+		reader:    reader,
+		hasSynced: informer.HasSynced,
+		index:     map[corev1alpha1.ControlledObjectReference]*corev1alpha1.ObjectOwnership{},
+		staged:    map[corev1alpha1.ControlledObjectReference][]stagedOwners{},
+		deleted:   map[corev1alpha1.ControlledObjectReference]ownershipDeletion{},
+	}
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    s.store,
+		UpdateFunc: func(_, newObj any) { s.store(newObj) },
+		DeleteFunc: s.forget,
+	}); err != nil {
+		return nil, fmt.Errorf("adding ObjectOwnership event handler: %w", err)
+	}
+	return s, nil
+}
+
+// RecordsObjectOwnerships returns true if the given strategy records owners in ObjectOwnerships.
+// Controllers using such a strategy have to watch ObjectOwnerships
+// with the handler returned by EnqueueRequestForOwner to notice ownership changes.
+func RecordsObjectOwnerships(strategy any) bool {
+	_, ok := strategy.(*OwnerStrategyOwnership)
+	return ok
+}
+
+func (s *OwnerStrategyOwnership) HasController(obj metav1.Object) bool {
+	for _, owner := range s.getOwners(obj) {
+		if owner.Controller {
+			return true
+		}
+	}
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			return true
+		}
+	}
+	return false
+}
+
+// OwnerPatch returns an empty patch, because owners are not recorded on the object itself.
+// Use PersistOwners to record owner changes.
+func (s *OwnerStrategyOwnership) OwnerPatch(metav1.Object) ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (s *OwnerStrategyOwnership) EnqueueRequestForOwner(
+	ownerType client.Object, _ meta.RESTMapper, isController bool,
+) handler.EventHandler {
+	ownerGK, err := ownerTypeGroupKind(s.scheme, ownerType)
+	if err != nil {
+		// This (passing a type that is not in the scheme) HAS to be a
+		// programmer error and can't be recovered at runtime anyways.
+		panic(err)
+	}
+	return &OwnershipEnqueueRequestForOwner{
+		OwnerType:     ownerType,
+		IsController:  isController,
+		ownerGK:       ownerGK,
+		ownerStrategy: s,
+	}
+}
+
+func (s *OwnerStrategyOwnership) SetOwnerReference(owner, obj metav1.Object) error {
+	return s.stage(obj, false, func(owners []corev1alpha1.ObjectOwner) ([]corev1alpha1.ObjectOwner, error) {
+		ref := s.ownerForCompare(owner)
+		if i := s.indexOf(owners, ref); i != -1 {
+			// Keep controller flag, so setting an owner reference does not release control.
+			ref.Controller = owners[i].Controller
+			owners[i] = ref
+			return owners, nil
+		}
+		return append(owners, ref), nil
+	})
+}
+
+// SetControllerReference stages owner as controller of obj.
+// Objects that have not been read from the cluster are not controlled by anyone yet,
+// so controllers recorded for an existing object of the same name are not in the way.
+// Whether such objects may be taken over is up to the caller.
+func (s *OwnerStrategyOwnership) SetControllerReference(owner, obj metav1.Object) error {
+	return s.stage(obj, len(obj.GetResourceVersion()) == 0, func(
+		owners []corev1alpha1.ObjectOwner,
+	) ([]corev1alpha1.ObjectOwner, error) {
+		ref := s.ownerForCompare(owner)
+		ref.Controller = true
+
+		// Ensure that there is no controller already.
+		for _, o := range owners {
+			if !s.referSameObject(ref, o) && o.Controller {
+				return nil, &controllerutil.AlreadyOwnedError{
+					Object: obj,
+					Owner: metav1.OwnerReference{
+						APIVersion: o.APIVersion,
+						Kind:       o.Kind,
+						Name:       o.Name,
+						Controller: ptr.To(o.Controller),
+						UID:        o.UID,
+					},
+				}
+			}
+		}
+
+		if i := s.indexOf(owners, ref); i != -1 {
+			owners[i] = ref
+			return owners, nil
+		}
+		return append(owners, ref), nil
+	})
+}
+
+func (s *OwnerStrategyOwnership) IsOwner(owner, obj metav1.Object) bool {
+	return s.indexOf(s.getOwners(obj), s.ownerForCompare(owner)) != -1
+}
+
+func (s *OwnerStrategyOwnership) IsController(owner, obj metav1.Object) bool {
+	owners := s.getOwners(obj)
+	i := s.indexOf(owners, s.ownerForCompare(owner))
+	return i != -1 && owners[i].Controller
+}
+
+func (s *OwnerStrategyOwnership) RemoveOwner(owner, obj metav1.Object) {
+	err := s.stage(obj, false, func(owners []corev1alpha1.ObjectOwner) ([]corev1alpha1.ObjectOwner, error) {
+		if i := s.indexOf(owners, s.ownerForCompare(owner)); i != -1 {
+			return remove(owners, i), nil
+		}
+		return owners, nil
+	})
+	if err != nil {
+		s.log.Error(err, "removing owner", "object", s.objectReference(obj))
+	}
+}
+
+func (s *OwnerStrategyOwnership) ReleaseController(obj metav1.Object) {
+	if err := s.stage(obj, false, releaseControllers); err != nil {
+		s.log.Error(err, "releasing controller", "object", s.objectReference(obj))
+	}
+}
+
+func releaseControllers(owners []corev1alpha1.ObjectOwner) ([]corev1alpha1.ObjectOwner, error) {
+	for i := range owners {
+		owners[i].Controller = false
+	}
+	return owners, nil
+}
+
+// PersistOwners records the owner changes made on obj in its ObjectOwnership.
+// Changes are replayed on the latest recorded owners,
+// ObjectOwnerships without owners are deleted.
+// Call after obj itself has been written successfully.
+func (s *OwnerStrategyOwnership) PersistOwners(ctx context.Context, obj metav1.Object) error {
+	ref := s.objectReference(obj)
+
+	s.mux.Lock()
+	i := s.stagedIndexOf(ref, obj)
+	if i == -1 {
+		s.mux.Unlock()
+		return nil
+	}
+	mutations := s.staged[ref][i].mutations
+	s.dropStaged(ref, i)
+	s.mux.Unlock()
+
+	return s.update(ctx, ref, func(owners []corev1alpha1.ObjectOwner) ([]corev1alpha1.ObjectOwner, error) {
+		for _, mutate := range mutations {
+			var err error
+			if owners, err = mutate(owners); err != nil {
+				return nil, err
+			}
+		}
+		return owners, nil
+	})
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable,
+// so only the leader collects garbage.
+func (s *OwnerStrategyOwnership) NeedLeaderElection() bool {
+	return true
+}
+
+// Start periodically removes owners that no longer exist from all ObjectOwnerships.
+func (s *OwnerStrategyOwnership) Start(ctx context.Context) error {
+	t := time.NewTicker(ownershipGCInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			if err := s.collectGarbage(ctx); err != nil {
+				s.log.Error(err, "removing owners that no longer exist")
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Removes owners that no longer exist from all indexed ObjectOwnerships.
+func (s *OwnerStrategyOwnership) collectGarbage(ctx context.Context) error {
+	if !s.hasSynced() {
+		return nil
+	}
+
+	s.mux.RLock()
+	ownerships := make([]*corev1alpha1.ObjectOwnership, 0, len(s.index))
+	for _, ownership := range s.index {
+		ownerships = append(ownerships, ownership.DeepCopy())
+	}
+	s.mux.RUnlock()
+
+	exists := map[types.UID]bool{}
+	for _, ownership := range ownerships {
+		gone := sets.New[types.UID]()
+		for _, owner := range ownership.Spec.Owners {
+			ok, checked := exists[owner.UID]
+			if !checked {
+				var err error
+				if ok, err = s.ownerExists(ctx, owner); err != nil {
+					return err
+				}
+				exists[owner.UID] = ok
+			}
+			if !ok {
+				gone.Insert(owner.UID)
+			}
+		}
+		if gone.Len() == 0 {
+			continue
+		}
+
+		if err := s.update(ctx, ownership.Spec.Object, func(
+			owners []corev1alpha1.ObjectOwner,
+		) ([]corev1alpha1.ObjectOwner, error) {
+			return slices.DeleteFunc(owners, func(o corev1alpha1.ObjectOwner) bool {
+				return gone.Has(o.UID)
+			}), nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns true if the given owner still exists with the same UID.
+func (s *OwnerStrategyOwnership) ownerExists(ctx context.Context, owner corev1alpha1.ObjectOwner) (bool, error) {
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		return false, nil
+	}
+
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(gv.WithKind(owner.Kind))
+	err = s.reader.Get(ctx, client.ObjectKey{Name: owner.Name, Namespace: owner.Namespace}, obj)
+	switch {
+	case apimachineryerrors.IsNotFound(err) || meta.IsNoMatchError(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("getting owner %s %s: %w", owner.Kind, owner.Name, err)
+	}
+	return obj.GetUID() == owner.UID, nil
+}
+
+// Returns a copy of the owners of obj, including changes staged on obj.
+func (s *OwnerStrategyOwnership) getOwners(obj metav1.Object) []corev1alpha1.ObjectOwner {
+	ref := s.objectReference(obj)
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	if i := s.stagedIndexOf(ref, obj); i != -1 {
+		return slices.Clone(s.staged[ref][i].owners)
+	}
+	if ownership, ok := s.index[ref]; ok {
+		return slices.Clone(ownership.Spec.Owners)
+	}
+	return nil
+}
+
+// Applies mutate to the owners of obj and stages the result on obj, without persisting it.
+// If releaseIndexed is set and nothing has been staged on obj yet,
+// controllers recorded in the index are released before applying mutate.
+// Changes that do not alter the recorded owners are not staged.
+func (s *OwnerStrategyOwnership) stage(obj metav1.Object, releaseIndexed bool, mutate ownersMutation) error {
+	if !s.hasSynced() {
+		return ErrOwnershipIndexNotSynced
+	}
+
+	ref := s.objectReference(obj)
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var (
+		recorded  []corev1alpha1.ObjectOwner
+		mutations []ownersMutation
+	)
+	if ownership, ok := s.index[ref]; ok {
+		recorded = ownership.Spec.Owners
+	}
+	current := slices.Clone(recorded)
+	i := s.stagedIndexOf(ref, obj)
+	switch {
+	case i != -1:
+		current = slices.Clone(s.staged[ref][i].owners)
+		mutations = s.staged[ref][i].mutations
+	case releaseIndexed:
+		current, _ = releaseControllers(current)
+		mutations = []ownersMutation{releaseControllers}
+	}
+
+	owners, err := mutate(current)
+	if err != nil {
+		return err
+	}
+	if i == -1 && slices.Equal(owners, recorded) {
+		return nil
+	}
+	mutations = append(slices.Clip(mutations), mutate)
+
+	if i != -1 {
+		s.staged[ref][i].owners = owners
+		s.staged[ref][i].mutations = mutations
+		return nil
+	}
+	staged := append(s.staged[ref], stagedOwners{obj: obj, owners: owners, mutations: mutations})
+	if len(staged) > maxStagedPerObject {
+		staged = slices.Delete(staged, 0, 1)
+	}
+	s.staged[ref] = staged
+	return nil
+}
+
+// Returns the index of the changes staged on obj or -1.
+// Must be called with the lock held.
+func (s *OwnerStrategyOwnership) stagedIndexOf(ref corev1alpha1.ControlledObjectReference, obj metav1.Object) int {
+	for i := range s.staged[ref] {
+		if s.staged[ref][i].obj == obj {
+			return i
+		}
+	}
+	return -1
+}
+
+// Drops the changes staged at the given index.
+// Must be called with the lock held.
+func (s *OwnerStrategyOwnership) dropStaged(ref corev1alpha1.ControlledObjectReference, i int) {
+	staged := slices.Delete(s.staged[ref], i, i+1)
+	if len(staged) == 0 {
+		delete(s.staged, ref)
+		return
+	}
+	s.staged[ref] = staged
+}
+
+// Returns a copy of the ObjectOwnership recorded for ref.
+func (s *OwnerStrategyOwnership) lookup(
+	ref corev1alpha1.ControlledObjectReference,
+) (*corev1alpha1.ObjectOwnership, bool) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	ownership, ok := s.index[ref]
+	if !ok {
+		return nil, false
+	}
+	return ownership.DeepCopy(), true
+}
+
+// Applies mutate to the recorded owners of the referenced object and persists the result.
+// ObjectOwnerships without owners are deleted.
+// Concurrent changes to the same ObjectOwnership are rejected by the API server
+// with a conflict, as writes are based on the resourceVersion of the indexed object.
+func (s *OwnerStrategyOwnership) update(
+	ctx context.Context, ref corev1alpha1.ControlledObjectReference,
+	mutate ownersMutation,
+) error {
+	if !s.hasSynced() {
+		return ErrOwnershipIndexNotSynced
+	}
+
+	ownership, ok := s.lookup(ref)
+	if !ok {
+		ownership = &corev1alpha1.ObjectOwnership{
+			ObjectMeta: metav1.ObjectMeta{Name: ownershipName(ref)},
+			Spec:       corev1alpha1.ObjectOwnershipSpec{Object: ref},
+		}
+	}
+	owners, err := mutate(ownership.Spec.Owners)
+	if err != nil {
+		return err
+	}
+	ownership.Spec.Owners = owners
+
+	switch {
+	case len(owners) == 0 && len(ownership.ResourceVersion) == 0:
+		// Nothing to record.
+		return nil
+
+	case len(owners) == 0:
+		err = client.IgnoreNotFound(s.writer.Delete(ctx, ownership, client.Preconditions{
+			UID:             &ownership.UID,
+			ResourceVersion: &ownership.ResourceVersion,
+		}))
+
+	case len(ownership.ResourceVersion) == 0:
+		err = s.writer.Create(ctx, ownership)
+
+	default:
+		err = s.writer.Update(ctx, ownership)
+	}
+	if err != nil {
+		return fmt.Errorf("persisting ObjectOwnership %s: %w", ownership.Name, err)
+	}
+
+	if len(owners) == 0 {
+		s.forget(ownership)
+		return nil
+	}
+	s.store(ownership)
+	return nil
+}
+
+// Adds or replaces the given ObjectOwnership in the index.
+// Informer events and write results may arrive out of order,
+// so ObjectOwnerships older than the indexed or last deleted one are ignored.
+func (s *OwnerStrategyOwnership) store(obj any) {
+	ownership, ok := obj.(*corev1alpha1.ObjectOwnership)
+	if !ok {
+		return
+	}
+	ref := ownership.Spec.Object
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if indexed, ok := s.index[ref]; ok {
+		if newer, ok := newerResourceVersion(ownership.ResourceVersion, indexed.ResourceVersion); ok && !newer {
+			return
+		}
+	}
+	if deletion, ok := s.deleted[ref]; ok {
+		if newer, ok := newerResourceVersion(ownership.ResourceVersion, deletion.resourceVersion); ok && !newer {
+			return
+		}
+		delete(s.deleted, ref)
+	}
+	s.index[ref] = ownership.DeepCopy()
+}
+
+// Removes the given ObjectOwnership from the index,
+// unless a newer ObjectOwnership for the same object has been indexed already.
+func (s *OwnerStrategyOwnership) forget(obj any) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	ownership, ok := obj.(*corev1alpha1.ObjectOwnership)
+	if !ok {
+		return
+	}
+	ref := ownership.Spec.Object
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	now := time.Now()
+	for r, deletion := range s.deleted {
+		if now.Sub(deletion.deletedAt) > ownershipDeletionTTL {
+			delete(s.deleted, r)
+		}
+	}
+
+	if indexed, ok := s.index[ref]; ok {
+		if newer, _ := newerResourceVersion(indexed.ResourceVersion, ownership.ResourceVersion); newer {
+			return
+		}
+	}
+	delete(s.index, ref)
+	if deletion, ok := s.deleted[ref]; ok {
+		if newer, ok := newerResourceVersion(ownership.ResourceVersion, deletion.resourceVersion); ok && !newer {
+			return
+		}
+	}
+	s.deleted[ref] = ownershipDeletion{resourceVersion: ownership.ResourceVersion, deletedAt: now}
+}
+
+// Returns true if resourceVersion a is newer than b and false if it is the same or older.
+// ResourceVersions are compared as the increasing integers the API server hands out,
+// ok is false if either version is not an integer and the versions cannot be compared.
+func newerResourceVersion(a, b string) (newer, ok bool) {
+	av, err := strconv.ParseUint(a, 10, 64)
+	if err != nil {
+		return false, false
+	}
+	bv, err := strconv.ParseUint(b, 10, 64)
+	if err != nil {
+		return false, false
+	}
+	return av > bv, true
+}
+
+func (s *OwnerStrategyOwnership) objectReference(obj metav1.Object) corev1alpha1.ControlledObjectReference {
+	gvk := s.groupVersionKind(obj)
+	return corev1alpha1.ControlledObjectReference{
+		Group:     gvk.Group,
+		Kind:      gvk.Kind,
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+}
+
+func (s *OwnerStrategyOwnership) ownerForCompare(owner metav1.Object) corev1alpha1.ObjectOwner {
+	gvk := s.groupVersionKind(owner)
+	return corev1alpha1.ObjectOwner{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       owner.GetName(),
+		Namespace:  owner.GetNamespace(),
+		UID:        owner.GetUID(),
+	}
+}
+
+func (s *OwnerStrategyOwnership) groupVersionKind(obj metav1.Object) schema.GroupVersionKind {
+	ro, ok := obj.(runtime.Object)
+	if !ok {
+		panic(fmt.Sprintf("%T is not a runtime.Object", obj))
+	}
+	gvk, err := apiutil.GVKForObject(ro, s.scheme)
+	if err != nil {
+		panic(err)
+	}
+	return gvk
+}
+
+func (s *OwnerStrategyOwnership) indexOf(owners []corev1alpha1.ObjectOwner, owner corev1alpha1.ObjectOwner) int {
+	for i := range owners {
+		if s.referSameObject(owner, owners[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Returns true if a and b point to the same object.
+func (s *OwnerStrategyOwnership) referSameObject(a, b corev1alpha1.ObjectOwner) bool {
+	aGV, err := schema.ParseGroupVersion(a.APIVersion)
+	if err != nil {
+		return false
+	}
+
+	bGV, err := schema.ParseGroupVersion(b.APIVersion)
+	if err != nil {
+		return false
+	}
+
+	return aGV.Group == bGV.Group && a.Kind == b.Kind &&
+		a.Namespace == b.Namespace && a.Name == b.Name
+}
+
+// Deterministic name of the ObjectOwnership for the given object.
+func ownershipName(ref corev1alpha1.ControlledObjectReference) string {
+	return strings.ToLower(ref.Kind) + "-" + utils.ComputeSHA256Hash(ref, nil)
+}
+
+// OwnershipEnqueueRequestForOwner enqueues owners of the object that triggered the event,
+// by looking them up in the in-memory ObjectOwnership index.
+// For events of ObjectOwnerships themselves, the owners recorded in them are enqueued.
+type OwnershipEnqueueRequestForOwner struct {
+	// OwnerType is the type of the Owner object to look for in ObjectOwnerships.  Only Group and Kind are compared.
+	OwnerType client.Object
+
+	// IsController if set will only look at owners with Controller: true.
+	IsController bool
+
+	ownerGK       schema.GroupKind
+	ownerStrategy *OwnerStrategyOwnership
+}
+
+// Create implements EventHandler.
+func (e *OwnershipEnqueueRequestForOwner) Create(
+	_ context.Context, evt event.CreateEvent, q workqueue.RateLimitingInterface,
+) {
+	for _, req := range e.getOwnerReconcileRequest(evt.Object) {
+		q.Add(req)
+	}
+}
+
+// Update implements EventHandler.
+func (e *OwnershipEnqueueRequestForOwner) Update(
+	_ context.Context, evt event.UpdateEvent, q workqueue.RateLimitingInterface,
+) {
+	// Owners of owned objects are not recorded on the object, so the old and new object have the same owners.
+	// For ObjectOwnerships, previous owners have to learn that they lost ownership.
+	if _, ok := evt.ObjectOld.(*corev1alpha1.ObjectOwnership); ok {
+		for _, req := range e.getOwnerReconcileRequest(evt.ObjectOld) {
+			q.Add(req)
+		}
+	}
+	for _, req := range e.getOwnerReconcileRequest(evt.ObjectNew) {
+		q.Add(req)
+	}
+}
+
+// Delete implements EventHandler.
+func (e *OwnershipEnqueueRequestForOwner) Delete(
+	_ context.Context, evt event.DeleteEvent, q workqueue.RateLimitingInterface,
+) {
+	for _, req := range e.getOwnerReconcileRequest(evt.Object) {
+		q.Add(req)
+	}
+}
+
+// Generic implements EventHandler.
+func (e *OwnershipEnqueueRequestForOwner) Generic(
+	_ context.Context, evt event.GenericEvent, q workqueue.RateLimitingInterface,
+) {
+	for _, req := range e.getOwnerReconcileRequest(evt.Object) {
+		q.Add(req)
+	}
+}
+
+func (e *OwnershipEnqueueRequestForOwner) getOwnerReconcileRequest(object client.Object) []reconcile.Request {
+	var owners []corev1alpha1.ObjectOwner
+	if ownership, ok := object.(*corev1alpha1.ObjectOwnership); ok {
+		owners = ownership.Spec.Owners
+	} else {
+		owners = e.ownerStrategy.getOwners(object)
+	}
+	requests := make([]reconcile.Request, 0, len(owners))
+	for _, owner := range owners {
+		ownerGV, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err != nil {
+			continue
+		}
+
+		if ownerGV.Group != e.ownerGK.Group ||
+			owner.Kind != e.ownerGK.Kind {
+			continue
+		}
+
+		if e.IsController && !owner.Controller {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      owner.Name,
+				Namespace: owner.Namespace,
+			},
+		})
+	}
+	return requests
+}
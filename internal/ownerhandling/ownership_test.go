@@ -0,0 +1,509 @@
+package ownerhandling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/testutil"
+)
+
+func newOwnershipTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := testutil.NewTestSchemeWithCoreV1AppsV1()
+	require.NoError(t, corev1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+func newOwnershipTestStrategy(t *testing.T) (*OwnerStrategyOwnership, client.Client) {
+	t.Helper()
+
+	scheme := newOwnershipTestScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	s, err := NewOwnership(logr.Discard(), scheme, c, c, &controllertest.FakeInformer{Synced: true})
+	require.NoError(t, err)
+	return s, c
+}
+
+func listObjectOwnerships(t *testing.T, c client.Client) []corev1alpha1.ObjectOwnership {
+	t.Helper()
+
+	list := &corev1alpha1.ObjectOwnershipList{}
+	require.NoError(t, c.List(context.Background(), list))
+	return list.Items
+}
+
+func TestOwnerStrategyOwnership_SetControllerReference(t *testing.T) {
+	t.Parallel()
+
+	s, c := newOwnershipTestStrategy(t)
+	cm1 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cm1",
+			Namespace: "cmtestns",
+			UID:       types.UID("1234"),
+		},
+	}
+	obj := testutil.NewSecret()
+
+	require.NoError(t, s.SetControllerReference(cm1, obj))
+	assert.True(t, s.HasController(obj))
+	assert.True(t, s.IsOwner(cm1, obj))
+	assert.True(t, s.IsController(cm1, obj))
+
+	// The object itself must not be touched.
+	assert.Empty(t, obj.Annotations)
+	assert.Empty(t, obj.OwnerReferences)
+
+	// Changes only apply to the same instance until persisted.
+	assert.False(t, s.HasController(obj.DeepCopy()))
+	assert.Empty(t, listObjectOwnerships(t, c))
+
+	require.NoError(t, s.PersistOwners(context.Background(), obj))
+	assert.True(t, s.IsController(cm1, obj.DeepCopy()))
+
+	ownerships := listObjectOwnerships(t, c)
+	if assert.Len(t, ownerships, 1) {
+		assert.Equal(t, corev1alpha1.ControlledObjectReference{
+			Kind:      "Secret",
+			Name:      "secret1",
+			Namespace: "testns",
+		}, ownerships[0].Spec.Object)
+		assert.Equal(t, []corev1alpha1.ObjectOwner{
+			{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       "cm1",
+				Namespace:  "cmtestns",
+				UID:        types.UID("1234"),
+				Controller: true,
+			},
+		}, ownerships[0].Spec.Owners)
+	}
+
+	cm2 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cm2",
+			Namespace: "cmtestns",
+			UID:       types.UID("56789"),
+		},
+	}
+	// Objects read from the cluster have to be released first.
+	existing := obj.DeepCopy()
+	existing.ResourceVersion = "1"
+	err := s.SetControllerReference(cm2, existing)
+	var alreadyOwnedErr *controllerutil.AlreadyOwnedError
+	require.ErrorAs(t, err, &alreadyOwnedErr)
+
+	s.ReleaseController(existing)
+	assert.False(t, s.HasController(existing))
+
+	require.NoError(t, s.SetControllerReference(cm2, existing))
+	assert.True(t, s.IsOwner(cm1, existing))
+	assert.False(t, s.IsController(cm1, existing))
+	assert.True(t, s.IsController(cm2, existing))
+	assert.True(t, s.IsController(cm1, obj.DeepCopy()))
+
+	// New objects are not controlled by anyone yet.
+	desired := testutil.NewSecret()
+	require.NoError(t, s.SetControllerReference(cm2, desired))
+	assert.True(t, s.IsController(cm2, desired))
+	assert.False(t, s.IsController(cm1, desired))
+
+	err = s.SetControllerReference(cm1, desired)
+	require.ErrorAs(t, err, &alreadyOwnedErr)
+}
+
+func TestOwnerStrategyOwnership_PersistOwners(t *testing.T) {
+	t.Parallel()
+
+	s, c := newOwnershipTestStrategy(t)
+	cm1 := testutil.NewConfigMap()
+	cm2 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cm2",
+			Namespace: "cmtestns",
+			UID:       types.UID("56789"),
+		},
+	}
+	obj := testutil.NewSecret()
+	require.NoError(t, s.SetControllerReference(cm1, obj))
+	require.NoError(t, s.PersistOwners(context.Background(), obj))
+
+	// Hand over control on two instances of the object,
+	// changes are replayed on the latest recorded owners.
+	existing1 := obj.DeepCopy()
+	existing1.ResourceVersion = "1"
+	s.ReleaseController(existing1)
+	require.NoError(t, s.SetControllerReference(cm2, existing1))
+
+	existing2 := obj.DeepCopy()
+	existing2.ResourceVersion = "1"
+	require.NoError(t, s.SetOwnerReference(cm2, existing2))
+
+	require.NoError(t, s.PersistOwners(context.Background(), existing1))
+	require.NoError(t, s.PersistOwners(context.Background(), existing2))
+	assert.True(t, s.IsController(cm2, obj))
+	assert.True(t, s.IsOwner(cm1, obj))
+
+	// Nothing staged, nothing to persist.
+	require.NoError(t, s.PersistOwners(context.Background(), existing1))
+
+	ownerships := listObjectOwnerships(t, c)
+	if assert.Len(t, ownerships, 1) {
+		assert.ElementsMatch(t, []corev1alpha1.ObjectOwner{
+			s.ownerForCompare(cm1),
+			func() corev1alpha1.ObjectOwner {
+				o := s.ownerForCompare(cm2)
+				o.Controller = true
+				return o
+			}(),
+		}, ownerships[0].Spec.Owners)
+	}
+}
+
+func TestOwnerStrategyOwnership_stagedBounded(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newOwnershipTestStrategy(t)
+	owner := testutil.NewConfigMap()
+
+	objs := make([]*corev1.Secret, maxStagedPerObject+1)
+	for i := range objs {
+		objs[i] = testutil.NewSecret()
+		require.NoError(t, s.SetControllerReference(owner, objs[i]))
+	}
+
+	// Changes on the oldest instance have been dropped.
+	assert.False(t, s.IsController(owner, objs[0]))
+	for _, obj := range objs[1:] {
+		assert.True(t, s.IsController(owner, obj))
+	}
+}
+
+func TestOwnerStrategyOwnership_collectGarbage(t *testing.T) {
+	t.Parallel()
+
+	scheme := newOwnershipTestScheme(t)
+	existing := testutil.NewConfigMap()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	s, err := NewOwnership(logr.Discard(), scheme, c, c, &controllertest.FakeInformer{Synced: true})
+	require.NoError(t, err)
+
+	gone := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cm2",
+			Namespace: "cmtestns",
+			UID:       types.UID("56789"),
+		},
+	}
+	recreated := existing.DeepCopy()
+	recreated.UID = types.UID("1111")
+
+	obj1 := testutil.NewSecret()
+	require.NoError(t, s.SetControllerReference(existing, obj1))
+	require.NoError(t, s.SetOwnerReference(gone, obj1))
+	require.NoError(t, s.PersistOwners(context.Background(), obj1))
+
+	obj2 := testutil.NewSecret()
+	obj2.Name = "secret2"
+	require.NoError(t, s.SetControllerReference(recreated, obj2))
+	require.NoError(t, s.PersistOwners(context.Background(), obj2))
+
+	require.NoError(t, s.collectGarbage(context.Background()))
+
+	assert.True(t, s.IsController(existing, obj1))
+	assert.False(t, s.IsOwner(gone, obj1))
+	assert.False(t, s.IsOwner(recreated, obj2))
+
+	ownerships := listObjectOwnerships(t, c)
+	if assert.Len(t, ownerships, 1) {
+		assert.Equal(t, "secret1", ownerships[0].Spec.Object.Name)
+	}
+}
+
+func TestOwnerStrategyOwnership_SetOwnerReference(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newOwnershipTestStrategy(t)
+	owner := testutil.NewConfigMap()
+	obj := testutil.NewSecret()
+
+	require.NoError(t, s.SetControllerReference(owner, obj))
+	require.NoError(t, s.SetOwnerReference(owner, obj))
+
+	// Setting an owner reference must not release control.
+	assert.True(t, s.IsController(owner, obj))
+}
+
+func TestOwnerStrategyOwnership_RemoveOwner(t *testing.T) {
+	t.Parallel()
+
+	s, c := newOwnershipTestStrategy(t)
+	cm1 := testutil.NewConfigMap()
+	cm2 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cm2",
+			Namespace: "cmtestns",
+			UID:       types.UID("56789"),
+		},
+	}
+	obj := testutil.NewSecret()
+
+	require.NoError(t, s.SetControllerReference(cm1, obj))
+	require.NoError(t, s.SetOwnerReference(cm2, obj))
+	require.NoError(t, s.PersistOwners(context.Background(), obj))
+
+	s.RemoveOwner(cm1, obj)
+	require.NoError(t, s.PersistOwners(context.Background(), obj))
+	assert.False(t, s.IsOwner(cm1, obj))
+	assert.True(t, s.IsOwner(cm2, obj))
+	assert.Len(t, listObjectOwnerships(t, c), 1)
+
+	// Removing the last owner deletes the ObjectOwnership.
+	s.RemoveOwner(cm2, obj)
+	require.NoError(t, s.PersistOwners(context.Background(), obj))
+	assert.False(t, s.IsOwner(cm2, obj))
+	assert.Empty(t, listObjectOwnerships(t, c))
+}
+
+func TestOwnerStrategyOwnership_informer(t *testing.T) {
+	t.Parallel()
+
+	owner := testutil.NewConfigMap()
+	obj := testutil.NewSecret()
+
+	// Record ownership with one instance and feed it to another via its informer.
+	s1, c := newOwnershipTestStrategy(t)
+	require.NoError(t, s1.SetControllerReference(owner, obj))
+	require.NoError(t, s1.PersistOwners(context.Background(), obj))
+	ownerships := listObjectOwnerships(t, c)
+	require.Len(t, ownerships, 1)
+
+	informer := &controllertest.FakeInformer{Synced: true}
+	s2, err := NewOwnership(logr.Discard(), s1.scheme, c, c, informer)
+	require.NoError(t, err)
+	assert.False(t, s2.IsController(owner, obj))
+
+	informer.Add(&ownerships[0])
+	assert.True(t, s2.IsController(owner, obj))
+
+	s2.RemoveOwner(owner, obj)
+	require.NoError(t, s2.PersistOwners(context.Background(), obj))
+	assert.False(t, s2.IsOwner(owner, obj))
+	assert.Empty(t, listObjectOwnerships(t, c))
+
+	informer.Delete(&ownerships[0])
+	assert.False(t, s2.IsOwner(owner, obj))
+}
+
+func TestOwnerStrategyOwnership_informerOutOfOrder(t *testing.T) {
+	t.Parallel()
+
+	owner1 := testutil.NewConfigMap()
+	owner2 := testutil.NewConfigMap()
+	owner2.Name = "cm2"
+	owner2.UID = types.UID("cm2uid")
+
+	// Record three versions of the same ObjectOwnership, switching controllers in between.
+	s1, c := newOwnershipTestStrategy(t)
+	versions := make([]*corev1alpha1.ObjectOwnership, 0, 3)
+	for _, owner := range []*corev1.ConfigMap{owner1, owner2, owner1} {
+		obj := testutil.NewSecret()
+		require.NoError(t, s1.SetControllerReference(owner, obj))
+		require.NoError(t, s1.PersistOwners(context.Background(), obj))
+		ownerships := listObjectOwnerships(t, c)
+		require.Len(t, ownerships, 1)
+		versions = append(versions, &ownerships[0])
+	}
+	v1, v2, v3 := versions[0], versions[1], versions[2]
+
+	informer := &controllertest.FakeInformer{Synced: true}
+	s2, err := NewOwnership(logr.Discard(), s1.scheme, c, c, informer)
+	require.NoError(t, err)
+	obj := testutil.NewSecret()
+
+	// Older versions do not replace newer ones.
+	informer.Add(v2)
+	informer.Update(v2, v1)
+	assert.True(t, s2.IsController(owner2, obj))
+	assert.False(t, s2.IsController(owner1, obj))
+
+	// Stale events do not resurrect deleted ObjectOwnerships.
+	informer.Delete(v2)
+	informer.Add(v1)
+	informer.Add(v2)
+	assert.False(t, s2.IsOwner(owner1, obj))
+	assert.False(t, s2.IsOwner(owner2, obj))
+
+	// Newer versions are indexed again and stale deletions do not remove them.
+	informer.Add(v3)
+	informer.Delete(v2)
+	assert.True(t, s2.IsController(owner1, obj))
+	assert.False(t, s2.IsController(owner2, obj))
+}
+
+func TestOwnerStrategyOwnership_notSynced(t *testing.T) {
+	t.Parallel()
+
+	scheme := newOwnershipTestScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	s, err := NewOwnership(logr.Discard(), scheme, c, c, &controllertest.FakeInformer{})
+	require.NoError(t, err)
+
+	err = s.SetControllerReference(testutil.NewConfigMap(), testutil.NewSecret())
+	require.ErrorIs(t, err, ErrOwnershipIndexNotSynced)
+	assert.Empty(t, listObjectOwnerships(t, c))
+}
+
+func TestOwnerStrategyOwnership_OwnerPatch(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newOwnershipTestStrategy(t)
+	obj := testutil.NewSecret()
+	require.NoError(t, s.SetControllerReference(testutil.NewConfigMap(), obj))
+
+	patch, err := s.OwnerPatch(obj)
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(patch))
+}
+
+func TestRecordsObjectOwnerships(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newOwnershipTestStrategy(t)
+	assert.True(t, RecordsObjectOwnerships(s))
+	assert.False(t, RecordsObjectOwnerships(NewNative(s.scheme)))
+}
+
+func TestOwnershipEnqueueRequestForOwner_GetOwnerReconcileRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		isOwnerController bool
+		ownerType         client.Object
+		isController      bool
+		requestExpected   bool
+	}{
+		{
+			name:              "owner is controller, enqueue is controller",
+			isOwnerController: true,
+			ownerType:         &corev1.ConfigMap{},
+			isController:      true,
+			requestExpected:   true,
+		},
+		{
+			name:              "owner is not controller, enqueue is controller",
+			isOwnerController: false,
+			ownerType:         &corev1.ConfigMap{},
+			isController:      true,
+			requestExpected:   false,
+		},
+		{
+			name:              "owner is not controller, enqueue is not controller",
+			isOwnerController: false,
+			ownerType:         &corev1.ConfigMap{},
+			isController:      false,
+			requestExpected:   true,
+		},
+		{
+			name:              "different owner type",
+			isOwnerController: true,
+			ownerType:         &appsv1.Deployment{},
+			isController:      false,
+			requestExpected:   false,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, _ := newOwnershipTestStrategy(t)
+			owner := testutil.NewConfigMap()
+			obj := testutil.NewSecret()
+			if test.isOwnerController {
+				require.NoError(t, s.SetControllerReference(owner, obj))
+			} else {
+				require.NoError(t, s.SetOwnerReference(owner, obj))
+			}
+
+			h, ok := s.EnqueueRequestForOwner(test.ownerType, nil, test.isController).(*OwnershipEnqueueRequestForOwner)
+			require.True(t, ok)
+
+			r := h.getOwnerReconcileRequest(obj)
+			if test.requestExpected {
+				assert.Equal(t, []reconcile.Request{
+					{
+						NamespacedName: client.ObjectKey{
+							Name:      owner.Name,
+							Namespace: owner.Namespace,
+						},
+					},
+				}, r)
+			} else {
+				assert.Empty(t, r)
+			}
+		})
+	}
+}
+
+func TestOwnershipEnqueueRequestForOwner_ObjectOwnership(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newOwnershipTestStrategy(t)
+	cm1 := testutil.NewConfigMap()
+	cm2 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cm2",
+			Namespace: "cmtestns",
+			UID:       types.UID("56789"),
+		},
+	}
+	oldOwnership := &corev1alpha1.ObjectOwnership{
+		Spec: corev1alpha1.ObjectOwnershipSpec{
+			Owners: []corev1alpha1.ObjectOwner{s.ownerForCompare(cm1)},
+		},
+	}
+	newOwnership := &corev1alpha1.ObjectOwnership{
+		Spec: corev1alpha1.ObjectOwnershipSpec{
+			Owners: []corev1alpha1.ObjectOwner{s.ownerForCompare(cm2)},
+		},
+	}
+
+	h := s.EnqueueRequestForOwner(&corev1.ConfigMap{}, nil, false)
+	q := &controllertest.Queue{Interface: workqueue.New()}
+	h.Update(context.Background(), event.UpdateEvent{
+		ObjectOld: oldOwnership,
+		ObjectNew: newOwnership,
+	}, q)
+
+	// Previous and new owners are enqueued.
+	require.Equal(t, 2, q.Len())
+	for _, owner := range []*corev1.ConfigMap{cm1, cm2} {
+		item, _ := q.Get()
+		assert.Equal(t, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(owner),
+		}, item)
+	}
+}
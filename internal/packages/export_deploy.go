@@ -7,9 +7,36 @@ import (
 // PackageDeployer loads package contents from file, wraps it into an ObjectDeployment and deploys it.
 type PackageDeployer = packagedeploy.PackageDeployer
 
+type (
+	// DeployOption configures a single package deployment.
+	DeployOption = packagedeploy.DeployOption
+	// DeployConfig holds settings for a single package deployment.
+	DeployConfig = packagedeploy.DeployConfig
+	// WithSourceConfig merges configuration resolved from the config sources of the package.
+	WithSourceConfig = packagedeploy.WithSourceConfig
+	// ConfigSourceResolver resolves the Secrets and ConfigMaps referenced in .spec.configFrom of a package.
+	ConfigSourceResolver = packagedeploy.ConfigSourceResolver
+	// Dependency is a (Cluster)Package that has to be installed next to the depending package.
+	Dependency = packagedeploy.Dependency
+)
+
 var (
 	// Returns a new namespace-scoped loader for the Package API.
 	NewPackageDeployer = packagedeploy.NewPackageDeployer
 	// Returns a new cluster-scoped loader for the ClusterPackage API.
 	NewClusterPackageDeployer = packagedeploy.NewClusterPackageDeployer
+	// Replaces the tag/digest part of the given image reference with the given digest.
+	ImageWithDigest = packagedeploy.ImageWithDigest
+	// Ensures that the given dependencies are installed next to the given package.
+	ReconcileDependencies = packagedeploy.ReconcileDependencies
+	// Decodes the value of the PackageDependenciesAnnotation.
+	ParseDependencies = packagedeploy.ParseDependencies
+	// Returned when a dependency is installed with a different image than required.
+	ErrDependencyConflict = packagedeploy.ErrDependencyConflict
+	// Returns a new resolver for the config sources of packages.
+	NewConfigSourceResolver = packagedeploy.NewConfigSourceResolver
+	// Returned when a required config source does not exist.
+	ErrConfigSourceNotFound = packagedeploy.ErrConfigSourceNotFound
+	// Returned when a required key is missing in a config source.
+	ErrConfigSourceKeyNotFound = packagedeploy.ErrConfigSourceKeyNotFound
 )
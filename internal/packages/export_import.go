@@ -15,9 +15,41 @@ var (
 
 	// Creates a new registry instance to de-duplicate parallel container image pulls.
 	NewRegistry = packageimport.NewRegistry
+
+	// Creates a new Cache in the given directory, limiting its size to maxSize bytes.
+	NewCache = packageimport.NewCache
+
+	// Creates a new keychain from the given image pull Secrets.
+	NewPullSecretKeychain = packageimport.NewPullSecretKeychain
+	// ErrUnsupportedPullSecretType is returned when an image pull secret is not of type
+	// kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg.
+	ErrUnsupportedPullSecretType = packageimport.ErrUnsupportedPullSecretType
 )
 
 type (
 	// Registry de-duplicates multiple parallel container image pulls.
 	Registry = packageimport.Registry
+	// RegistryOption configures a Registry.
+	RegistryOption = packageimport.RegistryOption
+	// RegistryConfig holds settings for a Registry.
+	RegistryConfig = packageimport.RegistryConfig
+	// WithCache configures the Registry to store pulled packages in the given cache.
+	WithCache = packageimport.WithCache
+	// WithVerifier configures the Registry to verify images before unpacking them.
+	WithVerifier = packageimport.WithVerifier
+	// ImageVerifier checks the provenance of an image before its contents are used.
+	ImageVerifier = packageimport.ImageVerifier
+	// Cache stores the contents of pulled package images on disk, keyed by image digest.
+	Cache = packageimport.Cache
+	// PullOption configures a single image pull.
+	PullOption = packageimport.PullOption
+	// PullConfig holds settings for a single image pull.
+	PullConfig = packageimport.PullConfig
+	// WithKeychain configures the keychain to resolve registry credentials from.
+	WithKeychain = packageimport.WithKeychain
+	// Keychain resolves registry credentials for image pulls.
+	Keychain = packageimport.Keychain
+	// PullSecretKeychain resolves registry credentials from
+	// kubernetes.io/dockerconfigjson and kubernetes.io/dockercfg Secrets.
+	PullSecretKeychain = packageimport.PullSecretKeychain
 )
@@ -2,9 +2,18 @@ package packages
 
 import "package-operator.run/internal/packages/internal/packagekickstart"
 
-type KickstartResult = packagekickstart.KickstartResult
+type (
+	KickstartResult     = packagekickstart.KickstartResult
+	KickstartOption     = packagekickstart.KickstartOption
+	HelmChart           = packagekickstart.HelmChart
+	WithHelmChart       = packagekickstart.WithHelmChart
+	HelmImportOption    = packagekickstart.HelmImportOption
+	WithHelmKubeVersion = packagekickstart.WithHelmKubeVersion
+	WithHelmAPIVersions = packagekickstart.WithHelmAPIVersions
+)
 
 var (
 	Kickstart            = packagekickstart.Kickstart
 	ImportOLMBundleImage = packagekickstart.ImportOLMBundleImage
+	ImportHelmChart      = packagekickstart.ImportHelmChart
 )
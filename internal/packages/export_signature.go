@@ -0,0 +1,26 @@
+package packages
+
+import "package-operator.run/internal/packages/internal/packagesignature"
+
+var (
+	// Creates a new Verifier enforcing the given policy.
+	NewVerifier = packagesignature.NewVerifier
+	// Loads a VerificationPolicy from the given YAML file.
+	LoadVerificationPolicy = packagesignature.LoadVerificationPolicy
+	// ErrVerificationFailed is returned when an image has no signature
+	// satisfying the verification policy that applies to it.
+	ErrVerificationFailed = packagesignature.ErrVerificationFailed
+
+	// Loads a PEM encoded ECDSA, RSA or Ed25519 private key to sign images with.
+	LoadSigningKey = packagesignature.LoadSigningKey
+	// Sign signs the given image digest with the given key and pushes
+	// the signature next to the image, where cosign expects it.
+	SignImage = packagesignature.Sign
+)
+
+type (
+	// Verifier checks cosign signatures of images against a VerificationPolicy.
+	Verifier = packagesignature.Verifier
+	// VerificationPolicy configures which images have to be signed by whom.
+	VerificationPolicy = packagesignature.VerificationPolicy
+)
@@ -17,6 +17,14 @@ type (
 	PackageScopeValidator = packagevalidation.PackageScopeValidator
 	// Runs the template test suites.
 	TemplateTestValidator = packagevalidation.TemplateTestValidator
+	// TemplateTestValidatorOption configures a TemplateTestValidator.
+	TemplateTestValidatorOption = packagevalidation.TemplateTestValidatorOption
+	// Regenerates existing test fixtures from the rendered templates.
+	WithUpdateFixtures = packagevalidation.WithUpdateFixtures
+	// Kind of check a TemplateTestResult was produced by.
+	TemplateTestKind = packagevalidation.TemplateTestKind
+	// Outcome of a single template test case or kubeconform check.
+	TemplateTestResult = packagevalidation.TemplateTestResult
 	// Validates that the PackageManifestLock is consistent with PackageManifest.
 	LockfileConsistencyValidator = packagevalidation.LockfileConsistencyValidator
 	// Validates that images referenced in the lockfile are still present in the registry.
@@ -28,6 +36,8 @@ type (
 	ObjectValidatorList = packagevalidation.ObjectValidatorList
 	// Validates that the PKO phase-annotation is set on all objects.
 	ObjectPhaseAnnotationValidator = packagevalidation.ObjectPhaseAnnotationValidator
+	// Validates that the PKO wave-annotation is an integer, if set.
+	ObjectWaveAnnotationValidator = packagevalidation.ObjectWaveAnnotationValidator
 	// Validates that Objects with the same name/namespace/kind/group must only exist once over all phases.
 	// APIVersion does not matter for the check.
 	ObjectDuplicateValidator = packagevalidation.ObjectDuplicateValidator
@@ -63,3 +73,10 @@ var (
 	// Creates a new TemplateTestValidator instance.
 	NewTemplateTestValidator = packagevalidation.NewTemplateTestValidator
 )
+
+const (
+	// Test case from .test.template of the PackageManifest.
+	TemplateTestKindTemplate = packagevalidation.TemplateTestKindTemplate
+	// Kubeconform check of a package file.
+	TemplateTestKindKubeconform = packagevalidation.TemplateTestKindKubeconform
+)
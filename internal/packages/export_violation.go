@@ -23,6 +23,9 @@ var (
 	ViolationReasonPackageManifestLockDuplicated = packagetypes.ViolationReasonPackageManifestLockDuplicated
 	ViolationReasonInvalidYAML                   = packagetypes.ViolationReasonInvalidYAML
 	ViolationReasonMissingPhaseAnnotation        = packagetypes.ViolationReasonMissingPhaseAnnotation
+	ViolationReasonInvalidWaveAnnotation         = packagetypes.ViolationReasonInvalidWaveAnnotation
+	ViolationReasonInvalidHookAnnotation         = packagetypes.ViolationReasonInvalidHookAnnotation
+	ViolationReasonInvalidDeletionPolicy         = packagetypes.ViolationReasonInvalidDeletionPolicy
 	ViolationReasonMissingGVK                    = packagetypes.ViolationReasonMissingGVK
 	ViolationReasonDuplicateObject               = packagetypes.ViolationReasonDuplicateObject
 	ViolationReasonLabelsInvalid                 = packagetypes.ViolationReasonLabelsInvalid
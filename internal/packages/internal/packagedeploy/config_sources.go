@@ -0,0 +1,172 @@
+package packagedeploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+)
+
+var (
+	// ErrConfigSourceNotFound is returned when a required config source does not exist.
+	ErrConfigSourceNotFound = errors.New("config source not found")
+	// ErrConfigSourceKeyNotFound is returned when a required key is missing in a config source.
+	ErrConfigSourceKeyNotFound = errors.New("key not found in config source")
+	errConfigSourceKind        = errors.New("unsupported config source kind")
+)
+
+// ConfigSourceResolver resolves the Secrets and ConfigMaps referenced in .spec.configFrom of a package.
+type ConfigSourceResolver struct {
+	// Reads object metadata from the cache to detect changes of config sources.
+	client client.Reader
+	// Reads config source contents, so Secret data is never cached.
+	uncachedClient client.Reader
+	// ClusterPackage config sources are looked up in this namespace.
+	namespace string
+}
+
+// NewConfigSourceResolver returns a new ConfigSourceResolver.
+// Config source metadata is read via client, contents via uncachedClient.
+// ClusterPackage config sources are looked up in the given namespace.
+func NewConfigSourceResolver(client, uncachedClient client.Reader, namespace string) *ConfigSourceResolver {
+	return &ConfigSourceResolver{
+		client:         client,
+		uncachedClient: uncachedClient,
+		namespace:      namespace,
+	}
+}
+
+// Revision returns a string identifying the observed state of all config sources of the package.
+// The result changes whenever one of the sources is created, updated or deleted.
+func (r *ConfigSourceResolver) Revision(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (string, error) {
+	revisions := make([]string, len(pkg.GetConfigFrom()))
+	for i, src := range pkg.GetConfigFrom() {
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind(string(src.Kind)))
+		err := r.client.Get(ctx, r.sourceKey(pkg, src), obj)
+		switch {
+		case apimachineryerrors.IsNotFound(err) && src.Optional:
+		case apimachineryerrors.IsNotFound(err):
+			return "", fmt.Errorf("%w: %s %s", ErrConfigSourceNotFound, src.Kind, src.Name)
+		case err != nil:
+			return "", fmt.Errorf("getting config source %s %s: %w", src.Kind, src.Name, err)
+		}
+		revisions[i] = fmt.Sprintf("%s/%s@%s", src.Kind, src.Name, obj.GetResourceVersion())
+	}
+	return strings.Join(revisions, ","), nil
+}
+
+// Config returns the configuration merged from all config sources of the package.
+// Values are parsed as YAML or JSON to keep numbers, booleans, lists and objects intact,
+// values that can't be parsed are used as plain strings.
+func (r *ConfigSourceResolver) Config(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (map[string]any, error) {
+	config := map[string]any{}
+	for _, src := range pkg.GetConfigFrom() {
+		data, err := r.sourceData(ctx, pkg, src)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(src.Key) == 0 {
+			for k, v := range data {
+				config[k] = parseConfigValue(v)
+			}
+			continue
+		}
+
+		value, ok := data[src.Key]
+		if !ok {
+			if src.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("%w: %q in %s %s", ErrConfigSourceKeyNotFound, src.Key, src.Kind, src.Name)
+		}
+		destination := src.Destination
+		if len(destination) == 0 {
+			destination = src.Key
+		}
+		setConfigValue(config, strings.Split(destination, "."), parseConfigValue(value))
+	}
+	return config, nil
+}
+
+func (r *ConfigSourceResolver) sourceData(
+	ctx context.Context, pkg adapters.GenericPackageAccessor, src corev1alpha1.PackageConfigSource,
+) (map[string]string, error) {
+	var (
+		obj  client.Object
+		data func() map[string]string
+	)
+	switch src.Kind {
+	case corev1alpha1.PackageConfigSourceKindSecret:
+		secret := &corev1.Secret{}
+		obj, data = secret, func() map[string]string {
+			out := make(map[string]string, len(secret.Data))
+			for k, v := range secret.Data {
+				out[k] = string(v)
+			}
+			return out
+		}
+	case corev1alpha1.PackageConfigSourceKindConfigMap:
+		cm := &corev1.ConfigMap{}
+		obj, data = cm, func() map[string]string { return cm.Data }
+	default:
+		return nil, fmt.Errorf("%w: %s", errConfigSourceKind, src.Kind)
+	}
+
+	err := r.uncachedClient.Get(ctx, r.sourceKey(pkg, src), obj)
+	switch {
+	case apimachineryerrors.IsNotFound(err) && src.Optional:
+		return nil, nil
+	case apimachineryerrors.IsNotFound(err):
+		return nil, fmt.Errorf("%w: %s %s", ErrConfigSourceNotFound, src.Kind, src.Name)
+	case err != nil:
+		return nil, fmt.Errorf("getting config source %s %s: %w", src.Kind, src.Name, err)
+	}
+	return data(), nil
+}
+
+func (r *ConfigSourceResolver) sourceKey(
+	pkg adapters.GenericPackageAccessor, src corev1alpha1.PackageConfigSource,
+) types.NamespacedName {
+	namespace := pkg.ClientObject().GetNamespace()
+	if len(namespace) == 0 {
+		namespace = r.namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: src.Name}
+}
+
+func parseConfigValue(value string) any {
+	var parsed any
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil || parsed == nil {
+		return value
+	}
+	return parsed
+}
+
+// Sets value at the given path, creating intermediate maps as needed.
+func setConfigValue(config map[string]any, path []string, value any) {
+	for _, p := range path[:len(path)-1] {
+		next, ok := config[p].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			config[p] = next
+		}
+		config = next
+	}
+	config[path[len(path)-1]] = value
+}
@@ -0,0 +1,133 @@
+package packagedeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func TestConfigSourceResolver_Revision(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	r := NewConfigSourceResolver(c, nil, "pko")
+
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Namespace: "pko", Name: "creds"},
+			mock.AnythingOfType("*v1.PartialObjectMetadata"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(2).(*metav1.PartialObjectMetadata)
+			assert.Equal(t, "Secret", obj.Kind)
+			obj.ResourceVersion = "42"
+		}).
+		Return(nil)
+	c.
+		On("Get", mock.Anything, client.ObjectKey{Namespace: "pko", Name: "missing"},
+			mock.AnythingOfType("*v1.PartialObjectMetadata"), mock.Anything).
+		Return(apimachineryerrors.NewNotFound(corev1.Resource("configmaps"), "missing"))
+
+	pkg := &adapters.GenericClusterPackage{
+		ClusterPackage: corev1alpha1.ClusterPackage{
+			Spec: corev1alpha1.PackageSpec{
+				ConfigFrom: []corev1alpha1.PackageConfigSource{
+					{Kind: corev1alpha1.PackageConfigSourceKindSecret, Name: "creds"},
+					{Kind: corev1alpha1.PackageConfigSourceKindConfigMap, Name: "missing", Optional: true},
+				},
+			},
+		},
+	}
+	rev, err := r.Revision(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.Equal(t, "Secret/creds@42,ConfigMap/missing@", rev)
+
+	pkg.Spec.ConfigFrom[1].Optional = false
+	_, err = r.Revision(context.Background(), pkg)
+	require.ErrorIs(t, err, ErrConfigSourceNotFound)
+}
+
+func TestConfigSourceResolver_Config(t *testing.T) {
+	t.Parallel()
+	uc := testutil.NewClient()
+	r := NewConfigSourceResolver(nil, uc, "")
+
+	uc.
+		On("Get", mock.Anything, client.ObjectKey{Namespace: "test", Name: "settings"},
+			mock.AnythingOfType("*v1.ConfigMap"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			cm := args.Get(2).(*corev1.ConfigMap)
+			cm.Data = map[string]string{
+				"host":     "db.example.com",
+				"logLevel": "debug",
+				"replicas": "3",
+				"debug":    "true",
+				"port":     `"8080"`,
+				"tags":     `["a", "b"]`,
+				"limits":   "cpu: 100m\nmemory: 64Mi",
+				"greeting": "hello: [world",
+			}
+		}).
+		Return(nil)
+	uc.
+		On("Get", mock.Anything, client.ObjectKey{Namespace: "test", Name: "creds"},
+			mock.AnythingOfType("*v1.Secret"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			secret := args.Get(2).(*corev1.Secret)
+			secret.Data = map[string][]byte{
+				"password": []byte("hunter2"),
+			}
+		}).
+		Return(nil)
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+			Spec: corev1alpha1.PackageSpec{
+				ConfigFrom: []corev1alpha1.PackageConfigSource{
+					{Kind: corev1alpha1.PackageConfigSourceKindConfigMap, Name: "settings"},
+					{
+						Kind:        corev1alpha1.PackageConfigSourceKindSecret,
+						Name:        "creds",
+						Key:         "password",
+						Destination: "database.password",
+					},
+					{
+						Kind:     corev1alpha1.PackageConfigSourceKindSecret,
+						Name:     "creds",
+						Key:      "token",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+	config, err := r.Config(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"host":     "db.example.com",
+		"logLevel": "debug",
+		"replicas": float64(3),
+		"debug":    true,
+		"port":     "8080",
+		"tags":     []any{"a", "b"},
+		"limits":   map[string]any{"cpu": "100m", "memory": "64Mi"},
+		// Unparsable values are kept as strings.
+		"greeting": "hello: [world",
+		"database": map[string]any{
+			"password": "hunter2",
+		},
+	}, config)
+
+	pkg.Spec.ConfigFrom[2].Optional = false
+	_, err = r.Config(context.Background(), pkg)
+	require.ErrorIs(t, err, ErrConfigSourceKeyNotFound)
+}
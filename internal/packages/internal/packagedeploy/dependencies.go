@@ -0,0 +1,235 @@
+package packagedeploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/apis/manifests"
+)
+
+// ErrDependencyConflict is returned when a dependency is already installed
+// with a different image than the one locked in the PackageManifestLock.
+var ErrDependencyConflict = errors.New("dependency conflict")
+
+// Dependency is a (Cluster)Package that has to be installed next to the depending package.
+type Dependency struct {
+	// Name of the dependency (Cluster)Package.
+	Name string
+	// Image of the dependency, pinned to a digest.
+	Image string
+}
+
+// Returns the dependencies locked in the given PackageManifestLock.
+func dependenciesFromLock(lock *manifests.PackageManifestLock) ([]Dependency, error) {
+	if lock == nil {
+		return nil, nil
+	}
+	deps := make([]Dependency, len(lock.Spec.Dependencies))
+	for i, dep := range lock.Spec.Dependencies {
+		image, err := ImageWithDigest(dep.Image, dep.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("dependency %s: %w", dep.Name, err)
+		}
+		deps[i] = Dependency{Name: dep.Name, Image: image}
+	}
+	return deps, nil
+}
+
+// FormatDependencies encodes dependencies for the PackageDependenciesAnnotation.
+func FormatDependencies(deps []Dependency) string {
+	entries := make([]string, len(deps))
+	for i, dep := range deps {
+		entries[i] = dep.Name + "=" + dep.Image
+	}
+	return strings.Join(entries, ",")
+}
+
+// ParseDependencies decodes the value of the PackageDependenciesAnnotation.
+// Entries without image only carry the dependency name.
+func ParseDependencies(annotation string) []Dependency {
+	if len(annotation) == 0 {
+		return nil
+	}
+	entries := strings.Split(annotation, ",")
+	deps := make([]Dependency, len(entries))
+	for i, entry := range entries {
+		name, image, _ := strings.Cut(entry, "=")
+		deps[i] = Dependency{Name: name, Image: image}
+	}
+	return deps
+}
+
+// ReconcileDependencies ensures that all given dependencies
+// are installed as (Cluster)Packages next to the given package,
+// pinned to their image digest. Dependencies without image are skipped.
+//
+// Dependencies created by Package Operator are owned by all dependent packages
+// and garbage collected when the last dependent package is deleted.
+// Dependencies that already exist with a different image are left untouched
+// and reported as ErrDependencyConflict, unless the given package is their only owner.
+func ReconcileDependencies(
+	ctx context.Context, c client.Client, scheme *runtime.Scheme,
+	apiPkg adapters.GenericPackageAccessor, deps []Dependency,
+) error {
+	var conflicts []string
+	for _, dep := range deps {
+		if len(dep.Image) == 0 {
+			continue
+		}
+
+		msg, err := reconcileDependency(ctx, c, scheme, apiPkg, dep.Name, dep.Image)
+		if err != nil {
+			return fmt.Errorf("dependency %s: %w", dep.Name, err)
+		}
+		if len(msg) > 0 {
+			conflicts = append(conflicts, msg)
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%w: %s", ErrDependencyConflict, strings.Join(conflicts, ", "))
+	}
+	return nil
+}
+
+func reconcileDependency(
+	ctx context.Context, c client.Client, scheme *runtime.Scheme,
+	apiPkg adapters.GenericPackageAccessor, depName, image string,
+) (conflict string, err error) {
+	newPackage := adapters.NewGenericPackage
+	if len(apiPkg.ClientObject().GetNamespace()) == 0 {
+		newPackage = adapters.NewGenericClusterPackage
+	}
+
+	actual := newPackage(scheme)
+	key := client.ObjectKey{
+		Name:      depName,
+		Namespace: apiPkg.ClientObject().GetNamespace(),
+	}
+	err = c.Get(ctx, key, actual.ClientObject())
+	if apimachineryerrors.IsNotFound(err) {
+		desired := newDependencyPackage(apiPkg, key, image)
+		if err := controllerutil.SetOwnerReference(apiPkg.ClientObject(), desired, scheme); err != nil {
+			return "", err
+		}
+		if err := c.Create(ctx, desired); err != nil {
+			return "", fmt.Errorf("creating dependency: %w", err)
+		}
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("getting dependency: %w", err)
+	}
+
+	pinned, err := isPinnedTo(actual.GetImage(), image)
+	if err != nil {
+		return "", err
+	}
+	owners := packageOwners(actual.ClientObject())
+	switch {
+	case pinned && (len(owners) == 0 || isOwnedBy(owners, apiPkg.ClientObject())):
+		// Up-to-date or installed independently, nothing to manage.
+		return "", nil
+
+	case pinned:
+		// Shared with other dependent packages.
+		if err := controllerutil.SetOwnerReference(
+			apiPkg.ClientObject(), actual.ClientObject(), scheme); err != nil {
+			return "", err
+		}
+
+	case len(owners) == 1 && isOwnedBy(owners, apiPkg.ClientObject()):
+		// Only used by this package, so it follows the lock file.
+		setPackageImage(actual.ClientObject(), image)
+
+	default:
+		return fmt.Sprintf("%s is installed with image %q, but %q is required",
+			depName, actual.GetImage(), image), nil
+	}
+
+	if err := c.Update(ctx, actual.ClientObject()); err != nil {
+		return "", fmt.Errorf("updating dependency: %w", err)
+	}
+	return "", nil
+}
+
+func newDependencyPackage(
+	apiPkg adapters.GenericPackageAccessor, key client.ObjectKey, image string,
+) client.Object {
+	spec := corev1alpha1.PackageSpec{
+		Image:            image,
+		ImagePullSecrets: apiPkg.GetImagePullSecrets(),
+	}
+	if len(key.Namespace) == 0 {
+		return &corev1alpha1.ClusterPackage{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name},
+			Spec:       spec,
+		}
+	}
+	return &corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Spec:       spec,
+	}
+}
+
+func setPackageImage(obj client.Object, image string) {
+	switch pkg := obj.(type) {
+	case *corev1alpha1.Package:
+		pkg.Spec.Image = image
+	case *corev1alpha1.ClusterPackage:
+		pkg.Spec.Image = image
+	default:
+		panic(fmt.Sprintf("unsupported package type %T", obj))
+	}
+}
+
+// packageOwners returns all owner references to Packages or ClusterPackages.
+func packageOwners(obj client.Object) []metav1.OwnerReference {
+	var owners []metav1.OwnerReference
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.APIVersion == corev1alpha1.GroupVersion.String() &&
+			(ref.Kind == "Package" || ref.Kind == "ClusterPackage") {
+			owners = append(owners, ref)
+		}
+	}
+	return owners
+}
+
+func isOwnedBy(owners []metav1.OwnerReference, owner client.Object) bool {
+	for _, ref := range owners {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// isPinnedTo checks whether the actual image reference
+// points to the same repository and digest as the desired reference.
+func isPinnedTo(actual, desired string) (bool, error) {
+	desiredRef, err := name.NewDigest(desired)
+	if err != nil {
+		return false, fmt.Errorf("parse image reference: %w", err)
+	}
+	actualRef, err := name.ParseReference(actual)
+	if err != nil {
+		// An unparsable image can never match.
+		return false, nil //nolint:nilerr
+	}
+	actualDigest, ok := actualRef.(name.Digest)
+	if !ok {
+		return false, nil
+	}
+	return actualDigest.Context().String() == desiredRef.Context().String() &&
+		actualDigest.DigestStr() == desiredRef.DigestStr(), nil
+}
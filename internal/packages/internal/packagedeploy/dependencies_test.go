@@ -0,0 +1,205 @@
+package packagedeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/testutil"
+)
+
+const (
+	testDepImage  = "quay.io/package-operator/dep"
+	testDepPinned = testDepImage + "@" + "sha256:52a6b1268e32ed5b6f59da8222f7627979bfb739f32aae3fb5b5ed31b8bf80c4"
+)
+
+func testDependencies(t *testing.T) []Dependency {
+	t.Helper()
+
+	deps, err := dependenciesFromLock(&manifests.PackageManifestLock{
+		Spec: manifests.PackageManifestLockSpec{
+			Dependencies: []manifests.PackageManifestLockDependency{
+				{Name: "dep", Image: testDepImage + ":v1.0.0", Digest: testDgst, Version: "v1.0.0"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return deps
+}
+
+func testDependentPackage() *adapters.GenericPackage {
+	return &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test", Namespace: "test", UID: "1234",
+			},
+			Spec: corev1alpha1.PackageSpec{
+				ImagePullSecrets: []corev1alpha1.ImagePullSecretReference{{Name: "pull-secret"}},
+			},
+		},
+	}
+}
+
+func TestReconcileDependencies_create(t *testing.T) {
+	t.Parallel()
+
+	c := testutil.NewClient()
+	c.On("Get", mock.Anything, client.ObjectKey{Name: "dep", Namespace: "test"}, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+	var created *corev1alpha1.Package
+	c.On("Create", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			created = args.Get(1).(*corev1alpha1.Package)
+		}).
+		Return(nil)
+
+	err := ReconcileDependencies(
+		context.Background(), c, testScheme, testDependentPackage(), testDependencies(t))
+	require.NoError(t, err)
+
+	require.NotNil(t, created)
+	assert.Equal(t, testDepPinned, created.Spec.Image)
+	assert.Equal(t, []corev1alpha1.ImagePullSecretReference{{Name: "pull-secret"}}, created.Spec.ImagePullSecrets)
+	if assert.Len(t, created.OwnerReferences, 1) {
+		assert.Equal(t, "test", created.OwnerReferences[0].Name)
+		assert.Nil(t, created.OwnerReferences[0].Controller)
+	}
+}
+
+func TestReconcileDependencies_existing(t *testing.T) {
+	t.Parallel()
+
+	otherOwner := metav1.OwnerReference{
+		APIVersion: corev1alpha1.GroupVersion.String(),
+		Kind:       "Package",
+		Name:       "other",
+		UID:        "5678",
+	}
+	ownOwner := metav1.OwnerReference{
+		APIVersion: corev1alpha1.GroupVersion.String(),
+		Kind:       "Package",
+		Name:       "test",
+		UID:        "1234",
+	}
+
+	tests := []struct {
+		name           string
+		image          string
+		owners         []metav1.OwnerReference
+		expectUpdate   bool
+		expectConflict bool
+		expectedOwners int
+	}{
+		{
+			name:  "independent, pinned",
+			image: testDepPinned,
+		},
+		{
+			name:           "independent, other image",
+			image:          testDepImage + ":v1.0.0",
+			expectConflict: true,
+		},
+		{
+			name:           "shared, pinned",
+			image:          testDepPinned,
+			owners:         []metav1.OwnerReference{otherOwner},
+			expectUpdate:   true,
+			expectedOwners: 2,
+		},
+		{
+			name:           "shared, other image",
+			image:          testDepImage + "@sha256:00e48c32b3cdcf9e2c66467f2beb0ef33b43b54e2b56415db4ee431512c406ea",
+			owners:         []metav1.OwnerReference{ownOwner, otherOwner},
+			expectConflict: true,
+		},
+		{
+			name:           "owned, other image",
+			image:          testDepImage + "@sha256:00e48c32b3cdcf9e2c66467f2beb0ef33b43b54e2b56415db4ee431512c406ea",
+			owners:         []metav1.OwnerReference{ownOwner},
+			expectUpdate:   true,
+			expectedOwners: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.NewClient()
+			c.On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					pkg := args.Get(2).(*corev1alpha1.Package)
+					pkg.Name = "dep"
+					pkg.Namespace = "test"
+					pkg.Spec.Image = test.image
+					pkg.OwnerReferences = test.owners
+				}).
+				Return(nil)
+			var updated *corev1alpha1.Package
+			c.On("Update", mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					updated = args.Get(1).(*corev1alpha1.Package)
+				}).
+				Return(nil)
+
+			err := ReconcileDependencies(
+				context.Background(), c, testScheme, testDependentPackage(), testDependencies(t))
+			if test.expectConflict {
+				require.ErrorIs(t, err, ErrDependencyConflict)
+				c.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+
+			if !test.expectUpdate {
+				c.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NotNil(t, updated)
+			assert.Equal(t, testDepPinned, updated.Spec.Image)
+			assert.Len(t, updated.OwnerReferences, test.expectedOwners)
+		})
+	}
+}
+
+func TestFormatParseDependencies(t *testing.T) {
+	t.Parallel()
+
+	deps := testDependencies(t)
+	annotation := FormatDependencies(deps)
+	assert.Equal(t, "dep="+testDepPinned, annotation)
+	assert.Equal(t, deps, ParseDependencies(annotation))
+
+	// Annotations written by previous versions only contain names.
+	assert.Equal(t, []Dependency{{Name: "dep-1"}, {Name: "dep-2"}}, ParseDependencies("dep-1,dep-2"))
+	assert.Empty(t, ParseDependencies(""))
+}
+
+func Test_isPinnedTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		actual   string
+		expected bool
+	}{
+		{actual: testDepPinned, expected: true},
+		{actual: testDepImage + ":v1.0.0", expected: false},
+		{actual: "quay.io/other@sha256:52a6b1268e32ed5b6f59da8222f7627979bfb739f32aae3fb5b5ed31b8bf80c4", expected: false},
+		{actual: "%invalid", expected: false},
+	}
+	for _, test := range tests {
+		pinned, err := isPinnedTo(test.actual, testDepPinned)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, pinned, test.actual)
+	}
+}
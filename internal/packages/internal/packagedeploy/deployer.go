@@ -120,12 +120,43 @@ func ImageWithDigest(reference string, digest string) (string, error) {
 	return ref.Context().Digest(digest).String(), nil
 }
 
+// DeployOption configures a single package deployment.
+type DeployOption interface {
+	ConfigureDeploy(c *DeployConfig)
+}
+
+// DeployConfig holds settings for a single package deployment.
+type DeployConfig struct {
+	// Configuration resolved from the config sources of the package.
+	// Inline configuration of the package takes precedence.
+	// Source configuration is not recorded in the package-config annotation of the ObjectDeployment.
+	SourceConfig map[string]any
+}
+
+// Option applies the given options to the config.
+func (c *DeployConfig) Option(opts ...DeployOption) {
+	for _, opt := range opts {
+		opt.ConfigureDeploy(c)
+	}
+}
+
+// WithSourceConfig merges configuration resolved from the config sources of the package.
+type WithSourceConfig struct{ Config map[string]any }
+
+func (w WithSourceConfig) ConfigureDeploy(c *DeployConfig) {
+	c.SourceConfig = w.Config
+}
+
 func (l *PackageDeployer) Deploy(
 	ctx context.Context,
 	apiPkg adapters.GenericPackageAccessor,
 	rawPkg *packagetypes.RawPackage,
 	env manifests.PackageEnvironment,
+	opts ...DeployOption,
 ) error {
+	var cfg DeployConfig
+	cfg.Option(opts...)
+
 	pkg, err := l.structuralLoader.LoadComponent(ctx, rawPkg, apiPkg.GetComponent())
 	if err != nil {
 		setInvalidConditionBasedOnLoadError(apiPkg, err)
@@ -138,29 +169,108 @@ func (l *PackageDeployer) Deploy(
 		return nil
 	}
 
+	pkgInstance, err := l.renderInstance(ctx, apiPkg, pkg, env, cfg)
+	var invalidErr *invalidPackageError
+	if errors.As(err, &invalidErr) {
+		setInvalidConditionBasedOnLoadError(apiPkg, invalidErr.err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependenciesFromLock(pkg.ManifestLock)
+	if err != nil {
+		return err
+	}
+	if err := ReconcileDependencies(ctx, l.client, l.scheme, apiPkg, deps); err != nil {
+		if errors.Is(err, ErrDependencyConflict) {
+			setInvalidConditionBasedOnLoadError(apiPkg, err)
+			return nil
+		}
+		return fmt.Errorf("reconciling dependencies: %w", err)
+	}
+
+	desiredDeploy, err := l.desiredObjectDeployment(ctx, apiPkg, deps, pkgInstance)
+	if err != nil {
+		return fmt.Errorf("creating desired ObjectDeployment: %w", err)
+	}
+
+	chunker := determineChunkingStrategyForPackage(apiPkg)
+	if err := l.deploymentReconciler.Reconcile(ctx, desiredDeploy, chunker); err != nil {
+		return fmt.Errorf("reconciling ObjectDeployment: %w", err)
+	}
+
+	// Load success
+	meta.RemoveStatusCondition(apiPkg.GetConditions(), corev1alpha1.PackageInvalid)
+	return nil
+}
+
+// Render renders the given package like Deploy would for the given (Cluster)Package
+// and returns the template of the ObjectSet it would create, without deploying anything.
+// Constraints and dependencies of the package are not checked.
+func (l *PackageDeployer) Render(
+	ctx context.Context,
+	apiPkg adapters.GenericPackageAccessor,
+	rawPkg *packagetypes.RawPackage,
+	env manifests.PackageEnvironment,
+	opts ...DeployOption,
+) (corev1alpha1.ObjectSetTemplateSpec, error) {
+	var cfg DeployConfig
+	cfg.Option(opts...)
+
+	pkg, err := l.structuralLoader.LoadComponent(ctx, rawPkg, apiPkg.GetComponent())
+	if err != nil {
+		return corev1alpha1.ObjectSetTemplateSpec{}, err
+	}
+
+	pkgInstance, err := l.renderInstance(ctx, apiPkg, pkg, env, cfg)
+	if err != nil {
+		return corev1alpha1.ObjectSetTemplateSpec{}, err
+	}
+	return desiredTemplateSpec(apiPkg, pkgInstance), nil
+}
+
+// invalidPackageError is returned when the package can not be rendered
+// with the configuration of the (Cluster)Package.
+type invalidPackageError struct{ err error }
+
+func (e *invalidPackageError) Error() string { return e.err.Error() }
+func (e *invalidPackageError) Unwrap() error { return e.err }
+
+// Renders the package with the configuration and environment of the given (Cluster)Package.
+func (l *PackageDeployer) renderInstance(
+	ctx context.Context,
+	apiPkg adapters.GenericPackageAccessor,
+	pkg *packagetypes.Package,
+	env manifests.PackageEnvironment,
+	cfg DeployConfig,
+) (*packagetypes.PackageInstance, error) {
 	// prepare package render/template context
 	tmplCtx := apiPkg.TemplateContext()
 	configuration := map[string]any{}
+	mergeConfig(configuration, cfg.SourceConfig)
 	if tmplCtx.Config != nil {
-		if err := json.Unmarshal(tmplCtx.Config.Raw, &configuration); err != nil {
-			return fmt.Errorf("unmarshal config: %w", err)
+		inlineConfiguration := map[string]any{}
+		if err := json.Unmarshal(tmplCtx.Config.Raw, &inlineConfiguration); err != nil {
+			return nil, fmt.Errorf("unmarshal config: %w", err)
 		}
+		mergeConfig(configuration, inlineConfiguration)
 	}
 	validationErrors, err := packagemanifestvalidation.AdmitPackageConfiguration(
 		ctx, configuration, pkg.Manifest, field.NewPath("spec", "config"))
 	if err != nil {
-		return fmt.Errorf("validate Package configuration: %w", err)
+		return nil, fmt.Errorf("validate Package configuration: %w", err)
 	}
 	if len(validationErrors) > 0 {
-		setInvalidConditionBasedOnLoadError(apiPkg, validationErrors.ToAggregate())
-		return nil
+		return nil, &invalidPackageError{err: validationErrors.ToAggregate()}
 	}
 	images := map[string]string{}
 	if pkg.ManifestLock != nil {
 		for _, packageImage := range pkg.ManifestLock.Spec.Images {
 			resolvedImage, err := ImageWithDigest(packageImage.Image, packageImage.Digest)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			images[packageImage.Name] = resolvedImage
 		}
@@ -176,27 +286,32 @@ func (l *PackageDeployer) Deploy(
 			Environment: env,
 		}, l.packageValidators, packagevalidation.DefaultObjectValidators)
 	if err != nil {
-		setInvalidConditionBasedOnLoadError(apiPkg, err)
-		return nil
-	}
-
-	desiredDeploy, err := l.desiredObjectDeployment(ctx, apiPkg, pkgInstance)
-	if err != nil {
-		return fmt.Errorf("creating desired ObjectDeployment: %w", err)
+		return nil, &invalidPackageError{err: err}
 	}
+	return pkgInstance, nil
+}
 
-	chunker := determineChunkingStrategyForPackage(apiPkg)
-	if err := l.deploymentReconciler.Reconcile(ctx, desiredDeploy, chunker); err != nil {
-		return fmt.Errorf("reconciling ObjectDeployment: %w", err)
+// Deep merges src into dst, values of src take precedence.
+// Nested maps of src are copied, so src is never modified through dst.
+func mergeConfig(dst, src map[string]any) {
+	for k, v := range src {
+		srcMap, ok := v.(map[string]any)
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		dstMap, ok := dst[k].(map[string]any)
+		if !ok {
+			dstMap = map[string]any{}
+			dst[k] = dstMap
+		}
+		mergeConfig(dstMap, srcMap)
 	}
-
-	// Load success
-	meta.RemoveStatusCondition(apiPkg.GetConditions(), corev1alpha1.PackageInvalid)
-	return nil
 }
 
 func (l *PackageDeployer) desiredObjectDeployment(
-	_ context.Context, pkg adapters.GenericPackageAccessor, pkgInstance *packagetypes.PackageInstance,
+	_ context.Context, pkg adapters.GenericPackageAccessor,
+	deps []Dependency, pkgInstance *packagetypes.PackageInstance,
 ) (deploy adapters.ObjectDeploymentAccessor, err error) {
 	labels := map[string]string{
 		manifestsv1alpha1.PackageLabel:         pkgInstance.Manifest.Name,
@@ -207,12 +322,28 @@ func (l *PackageDeployer) desiredObjectDeployment(
 	if err != nil {
 		return nil, fmt.Errorf("marshalling config for package-config annotation: %w", err)
 	}
+	// Everything needed to render this revision again, e.g. when rolling back to it.
+	specJSON, err := json.Marshal(corev1alpha1.PackageSpec{
+		Image:          pkg.GetImage(),
+		Config:         pkg.TemplateContext().Config,
+		ConfigFrom:     pkg.GetConfigFrom(),
+		Component:      pkg.GetComponent(),
+		DeletionPolicy: pkg.GetDeletionPolicy(),
+		Adoption:       pkg.GetAdoptionPolicy(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling spec for package-spec annotation: %w", err)
+	}
 	annotations := map[string]string{
 		manifestsv1alpha1.PackageSourceImageAnnotation: pkg.GetImage(),
 		manifestsv1alpha1.PackageConfigAnnotation:      string(configJSON),
+		manifestsv1alpha1.PackageSpecAnnotation:        string(specJSON),
 		constants.ChangeCauseAnnotation: fmt.Sprintf(
 			"Installing %s package.", pkgInstance.Manifest.Name),
 	}
+	if len(deps) > 0 {
+		annotations[manifestsv1alpha1.PackageDependenciesAnnotation] = FormatDependencies(deps)
+	}
 
 	deploy = l.newObjectDeployment(l.scheme)
 	deploy.ClientObject().SetLabels(labels)
@@ -221,8 +352,10 @@ func (l *PackageDeployer) desiredObjectDeployment(
 	deploy.ClientObject().SetName(pkg.ClientObject().GetName())
 	deploy.ClientObject().SetNamespace(pkg.ClientObject().GetNamespace())
 
-	deploy.SetTemplateSpec(packagerender.RenderObjectSetTemplateSpec(pkgInstance))
+	deploy.SetTemplateSpec(desiredTemplateSpec(pkg, pkgInstance))
 	deploy.SetSelector(labels)
+	deploy.SetPaused(pkg.IsPaused())
+	deploy.SetStrategy(pkg.GetStrategy())
 
 	if err := controllerutil.SetControllerReference(
 		pkg.ClientObject(), deploy.ClientObject(), l.scheme); err != nil {
@@ -232,6 +365,34 @@ func (l *PackageDeployer) desiredObjectDeployment(
 	return deploy, nil
 }
 
+// Returns the ObjectSet template for the given package instance.
+func desiredTemplateSpec(
+	pkg adapters.GenericPackageAccessor, pkgInstance *packagetypes.PackageInstance,
+) corev1alpha1.ObjectSetTemplateSpec {
+	templateSpec := packagerender.RenderObjectSetTemplateSpec(pkgInstance)
+	defaultDeletionPolicy(templateSpec.Phases, pkg.GetDeletionPolicy())
+	templateSpec.Adoption = pkg.GetAdoptionPolicy()
+	return templateSpec
+}
+
+// Applies the deletion policy of the package to all objects not specifying their own.
+// "Delete" is the default of ObjectSetObjects and is not written,
+// so existing revisions stay unchanged.
+func defaultDeletionPolicy(
+	phases []corev1alpha1.ObjectSetTemplatePhase, policy corev1alpha1.DeletionPolicy,
+) {
+	if len(policy) == 0 || policy == corev1alpha1.DeletionPolicyDelete {
+		return
+	}
+	for i := range phases {
+		for j := range phases[i].Objects {
+			if len(phases[i].Objects[j].DeletionPolicy) == 0 {
+				phases[i].Objects[j].DeletionPolicy = policy
+			}
+		}
+	}
+}
+
 func setInvalidConditionBasedOnLoadError(pkg adapters.GenericPackageAccessor, err error) {
 	reason := "LoadError"
 
@@ -248,6 +248,56 @@ func TestPackageDeployer_Deploy_Error(t *testing.T) {
 	}
 }
 
+func TestMergeConfig(t *testing.T) {
+	t.Parallel()
+
+	src := map[string]any{
+		"database": map[string]any{
+			"host":     "db.example.com",
+			"password": "from-secret",
+		},
+		"replicas": "2",
+	}
+	dst := map[string]any{}
+	mergeConfig(dst, src)
+	mergeConfig(dst, map[string]any{
+		"database": map[string]any{
+			"host": "inline.example.com",
+		},
+		"replicas": int64(3),
+	})
+
+	assert.Equal(t, map[string]any{
+		"database": map[string]any{
+			"host":     "inline.example.com",
+			"password": "from-secret",
+		},
+		"replicas": int64(3),
+	}, dst)
+	// src must not be modified by merging into dst.
+	assert.Equal(t, "db.example.com", src["database"].(map[string]any)["host"])
+}
+
+func TestDefaultDeletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	phases := []corev1alpha1.ObjectSetTemplatePhase{
+		{
+			Objects: []corev1alpha1.ObjectSetObject{
+				{},
+				{DeletionPolicy: corev1alpha1.DeletionPolicyDelete},
+			},
+		},
+	}
+
+	defaultDeletionPolicy(phases, corev1alpha1.DeletionPolicyDelete)
+	assert.Empty(t, phases[0].Objects[0].DeletionPolicy)
+
+	defaultDeletionPolicy(phases, corev1alpha1.DeletionPolicyRetain)
+	assert.Equal(t, corev1alpha1.DeletionPolicyRetain, phases[0].Objects[0].DeletionPolicy)
+	assert.Equal(t, corev1alpha1.DeletionPolicyDelete, phases[0].Objects[1].DeletionPolicy)
+}
+
 func TestImageWithDigestOk(t *testing.T) {
 	t.Parallel()
 
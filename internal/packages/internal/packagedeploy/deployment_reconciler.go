@@ -103,6 +103,10 @@ func (r *DeploymentReconciler) Reconcile(
 			desiredDeploy.ClientObject().GetAnnotations(),
 		)
 		annotations[constants.ChangeCauseAnnotation] = getChangeCause(actualDeploy, desiredDeploy)
+		if _, ok := desiredDeploy.ClientObject().GetAnnotations()[manifestsv1alpha1.PackageDependenciesAnnotation]; !ok {
+			// Dependencies have been removed from the package.
+			delete(annotations, manifestsv1alpha1.PackageDependenciesAnnotation)
+		}
 		actualDeploy.ClientObject().SetAnnotations(annotations)
 
 		labels := labels.Merge(
@@ -112,6 +116,8 @@ func (r *DeploymentReconciler) Reconcile(
 		actualDeploy.ClientObject().SetLabels(labels)
 
 		actualDeploy.SetTemplateSpec(templateSpec)
+		actualDeploy.SetPaused(desiredDeploy.IsPaused())
+		actualDeploy.SetStrategy(desiredDeploy.GetStrategy())
 
 		err := r.client.Update(ctx, actualDeploy.ClientObject())
 		if err == nil {
@@ -0,0 +1,188 @@
+package packageimport
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	corev1 "k8s.io/api/core/v1"
+
+	"package-operator.run/internal/utils"
+)
+
+// ErrUnsupportedPullSecretType is returned when an image pull secret is not of type
+// kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg.
+var ErrUnsupportedPullSecretType = errors.New("unsupported image pull secret type")
+
+var errMalformedDockerAuth = errors.New("expected base64 encoded <username>:<password>")
+
+// Keychain resolves registry credentials for image pulls.
+type Keychain interface {
+	authn.Keychain
+	// ID returns a stable identifier for the credentials held by this keychain.
+	// Parallel pulls of the same image are only de-duplicated when their keychain IDs match.
+	ID() string
+}
+
+var _ Keychain = (*PullSecretKeychain)(nil)
+
+// PullSecretKeychain resolves registry credentials from
+// kubernetes.io/dockerconfigjson and kubernetes.io/dockercfg Secrets.
+type PullSecretKeychain struct {
+	entries []pullSecretEntry
+	id      string
+}
+
+type pullSecretEntry struct {
+	// Normalized registry host with optional repository path prefix.
+	Key  string
+	Auth dockerConfigEntry
+}
+
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+type dockerConfigEntry struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+// Creates a new keychain from the given image pull Secrets.
+// Secrets earlier in the list take precedence over later ones
+// when they contain credentials for the same registry.
+func NewPullSecretKeychain(secrets ...corev1.Secret) (*PullSecretKeychain, error) {
+	k := &PullSecretKeychain{}
+	for _, secret := range secrets {
+		auths, err := dockerConfigFromSecret(secret)
+		if err != nil {
+			return nil, fmt.Errorf("secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+
+		keys := make([]string, 0, len(auths))
+		for key := range auths {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			k.entries = append(k.entries, pullSecretEntry{
+				Key:  normalizeRegistryKey(key),
+				Auth: auths[key],
+			})
+		}
+	}
+	k.id = utils.ComputeSHA256Hash(k.entries, nil)
+	return k, nil
+}
+
+// ID returns a hash over all credentials held by the keychain.
+func (k *PullSecretKeychain) ID() string {
+	return k.id
+}
+
+// Resolve returns the credentials with the longest matching registry key for the given resource.
+// Returns anonymous credentials, if no entry matches.
+func (k *PullSecretKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	registry := normalizeRegistryKey(target.RegistryStr())
+	ref := registry + strings.TrimPrefix(target.String(), target.RegistryStr())
+
+	var (
+		match    *pullSecretEntry
+		matchLen int
+	)
+	for i := range k.entries {
+		entry := &k.entries[i]
+		if entry.Key != registry && entry.Key != ref &&
+			!strings.HasPrefix(ref, entry.Key+"/") {
+			continue
+		}
+		if len(entry.Key) > matchLen {
+			match = entry
+			matchLen = len(entry.Key)
+		}
+	}
+	if match == nil {
+		return authn.Anonymous, nil
+	}
+
+	cfg := authn.AuthConfig{
+		Username:      match.Auth.Username,
+		Password:      match.Auth.Password,
+		IdentityToken: match.Auth.IdentityToken,
+		RegistryToken: match.Auth.RegistryToken,
+	}
+	if len(cfg.Username) == 0 && len(match.Auth.Auth) > 0 {
+		username, password, err := decodeDockerAuth(match.Auth.Auth)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Username, cfg.Password = username, password
+	}
+	return authn.FromConfig(cfg), nil
+}
+
+func dockerConfigFromSecret(secret corev1.Secret) (map[string]dockerConfigEntry, error) {
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		cfg := dockerConfigJSON{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &cfg); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", corev1.DockerConfigJsonKey, err)
+		}
+		return cfg.Auths, nil
+
+	case corev1.SecretTypeDockercfg:
+		auths := map[string]dockerConfigEntry{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &auths); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", corev1.DockerConfigKey, err)
+		}
+		return auths, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedPullSecretType, secret.Type)
+}
+
+// dockerHubHosts are all hostnames that may be used to reference Docker Hub in a docker config.
+var dockerHubHosts = map[string]struct{}{
+	"docker.io":            {},
+	"index.docker.io":      {},
+	"registry-1.docker.io": {},
+}
+
+// normalizeRegistryKey strips schemes, API version suffixes and
+// trailing slashes from docker config keys, e.g.
+// "https://index.docker.io/v1/" -> "index.docker.io".
+func normalizeRegistryKey(key string) string {
+	key = strings.TrimPrefix(key, "https://")
+	key = strings.TrimPrefix(key, "http://")
+	key = strings.TrimSuffix(key, "/")
+	key = strings.TrimSuffix(key, "/v1")
+	key = strings.TrimSuffix(key, "/v2")
+
+	host, path, _ := strings.Cut(key, "/")
+	if _, ok := dockerHubHosts[host]; ok {
+		host = "index.docker.io"
+	}
+	if len(path) == 0 {
+		return host
+	}
+	return host + "/" + path
+}
+
+func decodeDockerAuth(auth string) (username, password string, err error) {
+	decoded, err := base64.StdEncoding.DecodeString(auth)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding auth: %w", errMalformedDockerAuth)
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", fmt.Errorf("decoding auth: %w", errMalformedDockerAuth)
+	}
+	return username, password, nil
+}
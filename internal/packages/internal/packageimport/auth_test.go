@@ -0,0 +1,127 @@
+package packageimport
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPullSecretKeychain(t *testing.T) {
+	t.Parallel()
+
+	keychain, err := NewPullSecretKeychain(
+		corev1.Secret{
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{` +
+					`"quay.io/private":{"username":"private","password":"p1"},` +
+					`"https://index.docker.io/v1/":{"auth":"` +
+					base64.StdEncoding.EncodeToString([]byte("hub:p2")) + `"}}}`),
+			},
+		},
+		corev1.Secret{
+			Type: corev1.SecretTypeDockercfg,
+			Data: map[string][]byte{
+				corev1.DockerConfigKey: []byte(`{"quay.io":{"username":"quay","password":"p3"}}`),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		image    string
+		expected *authn.AuthConfig
+	}{
+		{
+			name:     "repository prefix",
+			image:    "quay.io/private/pkg:v1",
+			expected: &authn.AuthConfig{Username: "private", Password: "p1"},
+		},
+		{
+			name:     "registry host",
+			image:    "quay.io/public/pkg:v1",
+			expected: &authn.AuthConfig{Username: "quay", Password: "p3"},
+		},
+		{
+			name:     "docker hub",
+			image:    "nginx:latest",
+			expected: &authn.AuthConfig{Username: "hub", Password: "p2"},
+		},
+		{
+			name:  "anonymous",
+			image: "ghcr.io/test/pkg:v1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ref, err := name.ParseReference(test.image)
+			require.NoError(t, err)
+
+			auth, err := keychain.Resolve(ref.Context())
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				assert.Equal(t, authn.Anonymous, auth)
+				return
+			}
+			cfg, err := auth.Authorization()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cfg)
+		})
+	}
+}
+
+func TestPullSecretKeychain_ID(t *testing.T) {
+	t.Parallel()
+
+	newSecret := func(password string) corev1.Secret {
+		return corev1.Secret{
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(
+					`{"auths":{"quay.io":{"username":"test","password":"` + password + `"}}}`),
+			},
+		}
+	}
+
+	a, err := NewPullSecretKeychain(newSecret("a"))
+	require.NoError(t, err)
+	a2, err := NewPullSecretKeychain(newSecret("a"))
+	require.NoError(t, err)
+	b, err := NewPullSecretKeychain(newSecret("b"))
+	require.NoError(t, err)
+
+	assert.Equal(t, a.ID(), a2.ID())
+	assert.NotEqual(t, a.ID(), b.ID())
+}
+
+func TestPullSecretKeychain_UnsupportedType(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPullSecretKeychain(corev1.Secret{Type: corev1.SecretTypeOpaque})
+	require.ErrorIs(t, err, ErrUnsupportedPullSecretType)
+}
+
+func Test_normalizeRegistryKey(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"https://index.docker.io/v1/": "index.docker.io",
+		"docker.io":                   "index.docker.io",
+		"quay.io/":                    "quay.io",
+		"http://localhost:5001/v2":    "localhost:5001",
+		"quay.io/org/repo":            "quay.io/org/repo",
+	}
+	for in, expected := range tests {
+		assert.Equal(t, expected, normalizeRegistryKey(in), in)
+	}
+}
@@ -0,0 +1,290 @@
+package packageimport
+
+import (
+	"archive/tar"
+	"container/list"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+const cacheFileSuffix = ".tar"
+
+// Cache stores the contents of pulled package images on disk,
+// keyed by image digest. When the total size of all entries exceeds
+// the configured limit, the least recently used entries are evicted.
+//
+// Entries are written atomically, so multiple processes
+// may safely share the same cache directory.
+type Cache struct {
+	dir     string
+	maxSize int64
+
+	lock    sync.Mutex
+	size    int64
+	entries map[string]*list.Element
+	// least recently used entries are at the back.
+	lru *list.List
+}
+
+type cacheEntry struct {
+	digest string
+	size   int64
+}
+
+// Creates a new Cache in the given directory, limiting its size to maxSize bytes.
+// Entries already present in the directory are picked up,
+// ordered by their last modification time.
+func NewCache(dir string, maxSize int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("creating cache directory: %w", err)
+	}
+
+	c := &Cache{
+		dir:     dir,
+		maxSize: maxSize,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get returns the package stored for the given digest.
+// Returns false if the digest is not cached.
+func (c *Cache) Get(digest string) (*packagetypes.RawPackage, bool, error) {
+	path, err := c.path(digest)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elem, ok := c.entries[digest]
+	if !ok {
+		// Pick up entries written by other processes sharing the directory.
+		info, err := os.Stat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("reading cache entry %s: %w", digest, err)
+		}
+		elem = c.lru.PushFront(&cacheEntry{digest: digest, size: info.Size()})
+		c.entries[digest] = elem
+		c.size += info.Size()
+	}
+
+	rawPkg, err := readCacheFile(path)
+	if err != nil {
+		// Drop unreadable entries, so they are fetched again.
+		c.remove(elem)
+		return nil, false, fmt.Errorf("reading cache entry %s: %w", digest, err)
+	}
+
+	c.lru.MoveToFront(elem)
+	now := time.Now()
+	// Access time is tracked via mtime to keep LRU order across restarts.
+	if err := os.Chtimes(path, now, now); err != nil {
+		return nil, false, fmt.Errorf("touching cache entry %s: %w", digest, err)
+	}
+	return rawPkg, true, nil
+}
+
+// Put stores the given package under the given digest and
+// evicts least recently used entries until the cache fits its size limit.
+func (c *Cache) Put(digest string, rawPkg *packagetypes.RawPackage) error {
+	path, err := c.path(digest)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if elem, ok := c.entries[digest]; ok {
+		// Content addressed, nothing to update.
+		c.lru.MoveToFront(elem)
+		return nil
+	}
+
+	size, err := writeCacheFile(c.dir, path, rawPkg)
+	if err != nil {
+		return fmt.Errorf("writing cache entry %s: %w", digest, err)
+	}
+	c.entries[digest] = c.lru.PushFront(&cacheEntry{digest: digest, size: size})
+	c.size += size
+
+	c.evict()
+	return nil
+}
+
+// Size returns the total size of all cached entries in bytes.
+func (c *Cache) Size() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.size
+}
+
+// evict removes entries from the back of the lru list
+// until the cache fits into maxSize, always keeping the newest entry.
+func (c *Cache) evict() {
+	for c.size > c.maxSize && c.lru.Len() > 1 {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	entry := elem.Value.(*cacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, entry.digest)
+	c.size -= entry.size
+
+	if path, err := c.path(entry.digest); err == nil {
+		_ = os.Remove(path)
+	}
+}
+
+func (c *Cache) load() error {
+	dirEntries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("reading cache directory: %w", err)
+	}
+
+	type fileInfo struct {
+		digest string
+		info   fs.FileInfo
+	}
+	files := make([]fileInfo, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !strings.HasSuffix(name, cacheFileSuffix) {
+			continue
+		}
+		digest := strings.Replace(strings.TrimSuffix(name, cacheFileSuffix), "-", ":", 1)
+		if _, err := containerregistrypkgv1.NewHash(digest); err != nil {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return fmt.Errorf("reading cache entry %s: %w", name, err)
+		}
+		files = append(files, fileInfo{digest: digest, info: info})
+	}
+
+	// Oldest first, so the most recently used entry ends up at the front.
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].info.ModTime().Before(files[j].info.ModTime())
+	})
+	for _, f := range files {
+		c.entries[f.digest] = c.lru.PushFront(&cacheEntry{digest: f.digest, size: f.info.Size()})
+		c.size += f.info.Size()
+	}
+	c.evict()
+	return nil
+}
+
+func (c *Cache) path(digest string) (string, error) {
+	hash, err := containerregistrypkgv1.NewHash(digest)
+	if err != nil {
+		return "", fmt.Errorf("invalid digest %q: %w", digest, err)
+	}
+	return filepath.Join(c.dir, hash.Algorithm+"-"+hash.Hex+cacheFileSuffix), nil
+}
+
+func writeCacheFile(dir, path string, rawPkg *packagetypes.RawPackage) (size int64, err error) {
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	paths := make([]string, 0, len(rawPkg.Files))
+	for p := range rawPkg.Files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	tw := tar.NewWriter(tmp)
+	for _, p := range paths {
+		data := rawPkg.Files[p]
+		if err := tw.WriteHeader(&tar.Header{
+			Name: p,
+			Mode: 0o600,
+			Size: int64(len(data)),
+		}); err != nil {
+			return 0, err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return 0, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return 0, err
+	}
+
+	info, err := tmp.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+func readCacheFile(path string) (rawPkg *packagetypes.RawPackage, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cErr := f.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+	}()
+
+	files := packagetypes.Files{}
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[hdr.Name] = data
+	}
+	if len(files) == 0 {
+		return nil, packagetypes.ErrEmptyPackage
+	}
+	return &packagetypes.RawPackage{Files: files}, nil
+}
@@ -0,0 +1,104 @@
+package packageimport
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+func testDigest(c byte) string {
+	return "sha256:" + strings.Repeat(string(c), 64)
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	c, err := NewCache(dir, 1<<20)
+	require.NoError(t, err)
+
+	_, ok, err := c.Get(testDigest('a'))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	rawPkg := &packagetypes.RawPackage{Files: packagetypes.Files{
+		"manifest.yaml":     []byte("test"),
+		"sub/deploy.yaml":   []byte("test2"),
+		"sub/service.yaml":  []byte{},
+		"another/file.yaml": []byte("test3"),
+	}}
+	require.NoError(t, c.Put(testDigest('a'), rawPkg))
+	assert.Positive(t, c.Size())
+
+	cached, ok, err := c.Get(testDigest('a'))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, rawPkg, cached)
+
+	// Entries survive restarts.
+	c2, err := NewCache(dir, 1<<20)
+	require.NoError(t, err)
+	cached, ok, err = c2.Get(testDigest('a'))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, rawPkg, cached)
+	assert.Equal(t, c.Size(), c2.Size())
+}
+
+func TestCache_Evict(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rawPkg := &packagetypes.RawPackage{Files: packagetypes.Files{
+		"manifest.yaml": []byte(strings.Repeat("x", 1024)),
+	}}
+
+	// measure the size of a single entry.
+	probe, err := NewCache(t.TempDir(), 1<<20)
+	require.NoError(t, err)
+	require.NoError(t, probe.Put(testDigest('f'), rawPkg))
+	entrySize := probe.Size()
+
+	// room for two entries.
+	c, err := NewCache(dir, 2*entrySize)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Put(testDigest('a'), rawPkg))
+	require.NoError(t, c.Put(testDigest('b'), rawPkg))
+	// mark "a" as recently used.
+	_, ok, err := c.Get(testDigest('a'))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, c.Put(testDigest('c'), rawPkg))
+	assert.Equal(t, 2*entrySize, c.Size())
+
+	_, ok, err = c.Get(testDigest('b'))
+	require.NoError(t, err)
+	assert.False(t, ok, "least recently used entry must be evicted")
+	_, err = os.Stat(filepath.Join(dir, "sha256-"+strings.Repeat("b", 64)+cacheFileSuffix))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	for _, d := range []byte{'a', 'c'} {
+		_, ok, err := c.Get(testDigest(d))
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+}
+
+func TestCache_InvalidDigest(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewCache(t.TempDir(), 1<<20)
+	require.NoError(t, err)
+
+	_, _, err = c.Get("../../etc/passwd")
+	require.Error(t, err)
+	require.Error(t, c.Put("sha256:../x", &packagetypes.RawPackage{}))
+}
@@ -2,10 +2,13 @@ package packageimport
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
 
 	"package-operator.run/internal/packages/internal/packagetypes"
 	"package-operator.run/internal/utils"
@@ -25,10 +28,13 @@ func FromRegistry(ctx context.Context, ref string, opts ...crane.Option) (
 // Registry de-duplicates multiple parallel container image pulls.
 type Registry struct {
 	registryHostOverrides map[string]string
+	cache                 *Cache
+	verifier              ImageVerifier
 
-	pullImage    pullImageFn
-	inFlight     map[string][]chan<- response
-	inFlightLock sync.Mutex
+	pullImage     pullImageFn
+	resolveDigest resolveDigestFn
+	inFlight      map[string][]chan<- response
+	inFlightLock  sync.Mutex
 }
 
 type response struct {
@@ -39,22 +45,103 @@ type response struct {
 type pullImageFn func(
 	ctx context.Context, ref string, opts ...crane.Option) (*packagetypes.RawPackage, error)
 
+type resolveDigestFn func(ref string, opts ...crane.Option) (string, error)
+
+// RegistryOption configures a Registry.
+type RegistryOption interface {
+	ConfigureRegistry(c *RegistryConfig)
+}
+
+// ImageVerifier checks the provenance of an image before its contents are used.
+type ImageVerifier interface {
+	// image is the reference as requested, source the location it is pulled from after registry overrides.
+	Verify(ctx context.Context, image, source name.Digest, opts ...crane.Option) error
+}
+
+// RegistryConfig holds settings for a Registry.
+type RegistryConfig struct {
+	// Cache to store pulled packages in.
+	// Packages are always pulled from the registry if no cache is set.
+	Cache *Cache
+	// Verifier to check images with before unpacking them.
+	// Images are not verified if no verifier is set.
+	Verifier ImageVerifier
+}
+
+// Option applies the given options to the config.
+func (c *RegistryConfig) Option(opts ...RegistryOption) {
+	for _, opt := range opts {
+		opt.ConfigureRegistry(c)
+	}
+}
+
+// WithCache configures the Registry to store pulled packages in the given cache.
+type WithCache struct{ Cache *Cache }
+
+func (w WithCache) ConfigureRegistry(c *RegistryConfig) {
+	c.Cache = w.Cache
+}
+
+// WithVerifier configures the Registry to verify images before unpacking them.
+type WithVerifier struct{ Verifier ImageVerifier }
+
+func (w WithVerifier) ConfigureRegistry(c *RegistryConfig) {
+	c.Verifier = w.Verifier
+}
+
 // Creates a new registry instance to de-duplicate parallel container image pulls.
-func NewRegistry(registryHostOverrides map[string]string) *Registry {
+func NewRegistry(registryHostOverrides map[string]string, opts ...RegistryOption) *Registry {
+	var cfg RegistryConfig
+	cfg.Option(opts...)
+
 	return &Registry{
 		registryHostOverrides: registryHostOverrides,
+		cache:                 cfg.Cache,
+		verifier:              cfg.Verifier,
 		pullImage:             FromRegistry,
+		resolveDigest:         crane.Digest,
 		inFlight:              make(map[string][]chan<- response),
 	}
 }
 
-func (r *Registry) Pull(ctx context.Context, image string) (*packagetypes.RawPackage, error) {
-	image, err := r.applyOverride(image)
+// PullOption configures a single image pull.
+type PullOption interface {
+	ConfigurePull(c *PullConfig)
+}
+
+// PullConfig holds settings for a single image pull.
+type PullConfig struct {
+	// Keychain to resolve registry credentials from.
+	// Images are pulled anonymously if no keychain is set.
+	Keychain Keychain
+}
+
+// Option applies the given options to the config.
+func (c *PullConfig) Option(opts ...PullOption) {
+	for _, opt := range opts {
+		opt.ConfigurePull(c)
+	}
+}
+
+// WithKeychain configures the keychain to resolve registry credentials from.
+type WithKeychain struct{ Keychain Keychain }
+
+func (w WithKeychain) ConfigurePull(c *PullConfig) {
+	c.Keychain = w.Keychain
+}
+
+func (r *Registry) Pull(
+	ctx context.Context, image string, opts ...PullOption,
+) (*packagetypes.RawPackage, error) {
+	var cfg PullConfig
+	cfg.Option(opts...)
+
+	source, err := r.applyOverride(image)
 	if err != nil {
 		return nil, err
 	}
 
-	res := <-r.handleRequest(ctx, image)
+	res := <-r.handleRequest(ctx, image, source, cfg)
 
 	return res.RawPackage, res.Err
 }
@@ -75,30 +162,99 @@ func (r *Registry) applyOverride(image string) (string, error) {
 // on the in flight pull requests, more specifically, a check if an image pull
 // is in flight after a pull attempt has started, but before the first receiver
 // is registered.
-func (r *Registry) handleRequest(ctx context.Context, image string) <-chan response {
+//
+// Pulls are keyed by the requested image and keychain ID, so requests with different
+// credentials never share a response. The image is pulled from source.
+func (r *Registry) handleRequest(ctx context.Context, image, source string, cfg PullConfig) <-chan response {
 	r.inFlightLock.Lock()
 	defer r.inFlightLock.Unlock()
 
-	if _, inFlight := r.inFlight[image]; !inFlight {
-		go func(ctx context.Context, image string) {
-			rawPkg, err := r.pullImage(ctx, image, crane.Insecure)
+	key := image
+	craneOpts := []crane.Option{crane.Insecure}
+	if cfg.Keychain != nil {
+		key = image + "@" + cfg.Keychain.ID()
+		craneOpts = append(craneOpts, crane.WithAuthFromKeychain(cfg.Keychain))
+	}
+
+	if _, inFlight := r.inFlight[key]; !inFlight {
+		go func(ctx context.Context, key, image, source string) {
+			rawPkg, err := r.pull(ctx, image, source, craneOpts...)
 
-			r.handleResponse(image, response{
+			r.handleResponse(key, response{
 				RawPackage: rawPkg,
 				Err:        err,
 			})
-		}(ctx, image)
+		}(ctx, key, image, source)
 	}
 
 	// buffer size of 1 ensures that response handler
 	// is never blocked by a receiver.
 	recv := make(chan response, 1)
 
-	r.inFlight[image] = append(r.inFlight[image], recv)
+	r.inFlight[key] = append(r.inFlight[key], recv)
 
 	return recv
 }
 
+// pull fetches the package contents of the given image from source.
+// If a cache or verifier is configured, the image digest is resolved first,
+// the image is verified and its contents are only pulled from the registry
+// when the digest is not already cached.
+// Resolving the digest against the registry on every pull ensures that
+// cache hits still require the caller to have access to the image.
+func (r *Registry) pull(
+	ctx context.Context, image, source string, opts ...crane.Option,
+) (*packagetypes.RawPackage, error) {
+	if r.cache == nil && r.verifier == nil {
+		return r.pullImage(ctx, source, opts...)
+	}
+
+	log := logr.FromContextOrDiscard(ctx)
+	opts = append(opts[:len(opts):len(opts)], crane.WithContext(ctx))
+	digest, err := r.resolveDigest(source, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving image digest: %w", err)
+	}
+	ref, err := name.ParseReference(source)
+	if err != nil {
+		return nil, err
+	}
+	// Pull by digest, in case the tag moved since resolving it.
+	digestRef := ref.Context().Digest(digest)
+
+	if r.verifier != nil {
+		originalRef, err := name.ParseReference(image)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.verifier.Verify(ctx, originalRef.Context().Digest(digest), digestRef, opts...); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.cache != nil {
+		rawPkg, ok, err := r.cache.Get(digest)
+		if err != nil {
+			log.Error(err, "reading package cache", "image", image)
+		}
+		if ok {
+			log.V(1).Info("package cache hit", "image", image, "digest", digest)
+			return rawPkg, nil
+		}
+	}
+
+	rawPkg, err := r.pullImage(ctx, digestRef.String(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	if r.cache != nil {
+		if err := r.cache.Put(digest, rawPkg); err != nil {
+			log.Error(err, "writing package cache", "image", image)
+		}
+	}
+	return rawPkg, nil
+}
+
 // handleResponse broadcasts a response to all receivers listening
 // for a given image's pull request and then deletes the image's
 // entry allowing new requests to trigger a fresh pull. These
@@ -106,11 +262,11 @@ func (r *Registry) handleRequest(ctx context.Context, image string) <-chan respo
 // writes, more specifically, the registration of a new receiver
 // after broadcast has occurred, but before the image entry is
 // deleted.
-func (r *Registry) handleResponse(image string, res response) {
+func (r *Registry) handleResponse(key string, res response) {
 	r.inFlightLock.Lock()
 	defer r.inFlightLock.Unlock()
 
-	for _, recv := range r.inFlight[image] {
+	for _, recv := range r.inFlight[key] {
 		var rawPkg *packagetypes.RawPackage
 		if res.RawPackage != nil {
 			// DeepCopy to ensure clients can work concurrently on the returned files map.
@@ -122,5 +278,5 @@ func (r *Registry) handleResponse(image string, res response) {
 		}
 	}
 
-	delete(r.inFlight, image)
+	delete(r.inFlight, key)
 }
@@ -2,14 +2,19 @@ package packageimport
 
 import (
 	"context"
+	"errors"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"package-operator.run/internal/packages/internal/packagetypes"
 )
@@ -106,6 +111,130 @@ func TestRegistry_DelayedRequests(t *testing.T) {
 	}
 }
 
+func TestRegistry_DistinctKeychains(t *testing.T) {
+	t.Parallel()
+
+	ipm := &imagePullerMock{}
+	ipm.
+		On("Pull", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { time.Sleep(500 * time.Millisecond) }).
+		Return(&packagetypes.RawPackage{Files: packagetypes.Files{"test": nil}}, nil)
+
+	r := NewRegistry(nil)
+	r.pullImage = ipm.Pull
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for _, kc := range []Keychain{
+		nil, &keychainMock{id: "a"}, &keychainMock{id: "a"}, &keychainMock{id: "b"},
+	} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var opts []PullOption
+			if kc != nil {
+				opts = append(opts, WithKeychain{Keychain: kc})
+			}
+			if _, err := r.Pull(ctx, "quay.io/test123", opts...); err != nil {
+				panic(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// anonymous, "a" and "b" are pulled separately.
+	ipm.AssertNumberOfCalls(t, "Pull", 3)
+}
+
+func TestRegistry_Cache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := NewCache(t.TempDir(), 1<<20)
+	require.NoError(t, err)
+
+	pkg := &packagetypes.RawPackage{Files: packagetypes.Files{"test": []byte("test")}}
+	ipm := &imagePullerMock{}
+	ipm.
+		On("Pull", mock.Anything, mock.Anything, mock.Anything).
+		Return(pkg, nil)
+
+	digest := "sha256:" + strings.Repeat("a", 64)
+	r := NewRegistry(nil, WithCache{Cache: cache})
+	r.pullImage = ipm.Pull
+	r.resolveDigest = func(string, ...crane.Option) (string, error) {
+		return digest, nil
+	}
+
+	ctx := context.Background()
+	for range 3 {
+		rawPkg, err := r.Pull(ctx, "quay.io/test123:v1")
+		require.NoError(t, err)
+		assert.Equal(t, pkg, rawPkg)
+	}
+
+	ipm.AssertNumberOfCalls(t, "Pull", 1)
+	ipm.AssertCalled(t, "Pull", mock.Anything, "quay.io/test123@"+digest, mock.Anything)
+}
+
+func TestRegistry_Verifier(t *testing.T) {
+	t.Parallel()
+
+	ipm := &imagePullerMock{}
+	ipm.
+		On("Pull", mock.Anything, mock.Anything, mock.Anything).
+		Return(&packagetypes.RawPackage{Files: packagetypes.Files{"test": nil}}, nil)
+
+	digest := "sha256:" + strings.Repeat("a", 64)
+	vm := &verifierMock{}
+	r := NewRegistry(map[string]string{"quay.io": "mirror.example.com"}, WithVerifier{Verifier: vm})
+	r.pullImage = ipm.Pull
+	r.resolveDigest = func(string, ...crane.Option) (string, error) {
+		return digest, nil
+	}
+
+	ctx := context.Background()
+	vm.
+		On("Verify", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errTest).Once()
+	_, err := r.Pull(ctx, "quay.io/test123:v1")
+	require.ErrorIs(t, err, errTest)
+	ipm.AssertNotCalled(t, "Pull", mock.Anything, mock.Anything, mock.Anything)
+
+	vm.
+		On("Verify", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	_, err = r.Pull(ctx, "quay.io/test123:v1")
+	require.NoError(t, err)
+	ipm.AssertCalled(t, "Pull", mock.Anything, "mirror.example.com/test123@"+digest, mock.Anything)
+	// Policies apply to the requested image, signatures are read from the mirror.
+	vm.AssertCalled(t, "Verify", mock.Anything, mock.MatchedBy(func(ref name.Digest) bool {
+		return ref.String() == "quay.io/test123@"+digest
+	}), mock.MatchedBy(func(ref name.Digest) bool {
+		return ref.String() == "mirror.example.com/test123@"+digest
+	}), mock.Anything)
+}
+
+var errTest = errors.New("test error")
+
+type verifierMock struct {
+	mock.Mock
+}
+
+func (m *verifierMock) Verify(ctx context.Context, image, source name.Digest, opts ...crane.Option) error {
+	args := m.Called(ctx, image, source, opts)
+	return args.Error(0)
+}
+
+type keychainMock struct {
+	id string
+}
+
+func (m *keychainMock) ID() string { return m.id }
+
+func (m *keychainMock) Resolve(authn.Resource) (authn.Authenticator, error) {
+	return authn.Anonymous, nil
+}
+
 type imagePullerMock struct {
 	mock.Mock
 }
@@ -0,0 +1,333 @@
+package packagekickstart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+)
+
+const (
+	helmChartFile = "Chart.yaml"
+	// Namespace charts are rendered into.
+	// Objects in this namespace are installed into the namespace of the Package instead.
+	helmReleaseNamespace = "default"
+)
+
+var (
+	errHelmChartFileMissing = errors.New("Chart.yaml not found")
+	errHelmUnsupportedHook  = errors.New("unsupported Helm hook")
+)
+
+// HelmChart holds the metadata and default values of an imported Helm chart.
+type HelmChart struct {
+	Name    string
+	Version string
+	// Default values from values.yaml, including the values of enabled subcharts.
+	Values map[string]interface{}
+	// Schema of the values from values.schema.json, if the chart ships one.
+	ValuesSchema *apiextensionsv1.JSONSchemaProps
+}
+
+// HelmImportOption configures how a Helm chart is rendered.
+type HelmImportOption interface {
+	ConfigureHelmImport(c *HelmImportConfig)
+}
+
+// HelmImportConfig holds settings for rendering a Helm chart.
+type HelmImportConfig struct {
+	// Kubernetes version exposed via .Capabilities.KubeVersion.
+	// Defaults to the Kubernetes version Helm was built against.
+	KubeVersion string
+	// Additional API versions exposed via .Capabilities.APIVersions.
+	APIVersions []string
+}
+
+// Option applies the given options to the config.
+func (c *HelmImportConfig) Option(opts ...HelmImportOption) {
+	for _, opt := range opts {
+		opt.ConfigureHelmImport(c)
+	}
+}
+
+// WithHelmKubeVersion sets the Kubernetes version charts are rendered for.
+type WithHelmKubeVersion string
+
+func (w WithHelmKubeVersion) ConfigureHelmImport(c *HelmImportConfig) {
+	c.KubeVersion = string(w)
+}
+
+// WithHelmAPIVersions adds API versions charts may check for via .Capabilities.APIVersions.Has.
+type WithHelmAPIVersions []string
+
+func (w WithHelmAPIVersions) ConfigureHelmImport(c *HelmImportConfig) {
+	c.APIVersions = append(c.APIVersions, w...)
+}
+
+// ImportHelmChart renders the Helm chart at the given path, a chart folder or a .tgz chart archive,
+// with its default values like "helm template" would and returns all objects contained.
+// Enabled subcharts are rendered with the chart.
+// Helm hooks are converted into Package Operator lifecycle hooks, see helmHookTypes.
+func ImportHelmChart(_ context.Context, chartPath string, opts ...HelmImportOption) (
+	objects []unstructured.Unstructured, helmChart HelmChart, err error,
+) {
+	var cfg HelmImportConfig
+	cfg.Option(opts...)
+
+	if info, err := os.Stat(chartPath); err == nil && info.IsDir() {
+		if _, err := os.Stat(filepath.Join(chartPath, helmChartFile)); errors.Is(err, fs.ErrNotExist) {
+			return nil, helmChart, fmt.Errorf("%w: %s", errHelmChartFileMissing, chartPath)
+		}
+	}
+	chrt, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, helmChart, fmt.Errorf("loading chart: %w", err)
+	}
+	helmChart.Name, helmChart.Version = chrt.Metadata.Name, chrt.Metadata.Version
+	if len(chrt.Schema) > 0 {
+		helmChart.ValuesSchema = &apiextensionsv1.JSONSchemaProps{}
+		if err := json.Unmarshal(chrt.Schema, helmChart.ValuesSchema); err != nil {
+			return nil, helmChart, fmt.Errorf("parsing values.schema.json: %w", err)
+		}
+	}
+
+	caps, err := helmCapabilities(cfg)
+	if err != nil {
+		return nil, helmChart, err
+	}
+
+	// Same steps as "helm template".
+	if err := chartutil.ProcessDependenciesWithMerge(chrt, chrt.Values); err != nil {
+		return nil, helmChart, fmt.Errorf("processing chart dependencies: %w", err)
+	}
+	renderValues, err := chartutil.ToRenderValues(chrt, chrt.Values, chartutil.ReleaseOptions{
+		Name:      chrt.Metadata.Name,
+		Namespace: helmReleaseNamespace,
+		Revision:  1,
+		IsInstall: true,
+	}, caps)
+	if err != nil {
+		return nil, helmChart, fmt.Errorf("computing chart values: %w", err)
+	}
+	values, err := renderValues.Table("Values")
+	if err != nil {
+		return nil, helmChart, fmt.Errorf("computing chart values: %w", err)
+	}
+	helmChart.Values = values.AsMap()
+
+	rendered, err := engine.Render(chrt, renderValues)
+	if err != nil {
+		return nil, helmChart, fmt.Errorf("rendering chart: %w", err)
+	}
+	hooks, manifests, err := releaseutil.SortManifests(rendered, caps.APIVersions, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, helmChart, fmt.Errorf("parsing rendered chart: %w", err)
+	}
+
+	for _, manifest := range manifests {
+		obj, err := parseHelmManifest(manifest.Name, manifest.Content)
+		if err != nil {
+			return nil, helmChart, err
+		}
+		objects = append(objects, obj)
+	}
+	for _, hook := range hooks {
+		hookObjects, err := convertHelmHook(hook)
+		if err != nil {
+			return nil, helmChart, err
+		}
+		objects = append(objects, hookObjects...)
+	}
+	return objects, helmChart, nil
+}
+
+func helmCapabilities(cfg HelmImportConfig) (*chartutil.Capabilities, error) {
+	caps := chartutil.DefaultCapabilities.Copy()
+	if len(cfg.KubeVersion) > 0 {
+		kubeVersion, err := chartutil.ParseKubeVersion(cfg.KubeVersion)
+		if err != nil {
+			return nil, fmt.Errorf("parsing kube version: %w", err)
+		}
+		caps.KubeVersion = *kubeVersion
+	}
+	caps.APIVersions = append(caps.APIVersions, cfg.APIVersions...)
+	return caps, nil
+}
+
+func parseHelmManifest(name, content string) (unstructured.Unstructured, error) {
+	obj := unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(content), &obj.Object); err != nil {
+		return obj, fmt.Errorf("parsing rendered template %s: %w", name, err)
+	}
+	if obj.GetNamespace() == helmReleaseNamespace {
+		obj.SetNamespace("")
+	}
+	return obj, nil
+}
+
+// Package Operator lifecycle hooks Helm hooks are converted into.
+// Test hooks are dropped, as there is nothing like "helm test".
+// All other Helm hooks have no equivalent and are rejected.
+var helmHookTypes = map[release.HookEvent]corev1alpha1.ObjectSetHookType{
+	release.HookPreInstall: corev1alpha1.ObjectSetHookTypePreInstall,
+	release.HookPreUpgrade: corev1alpha1.ObjectSetHookTypePreUpgrade,
+	release.HookPreDelete:  corev1alpha1.ObjectSetHookTypePreDelete,
+}
+
+// Helm hook annotations, replaced by the Package Operator hook annotations.
+var helmHookAnnotations = []string{
+	release.HookAnnotation, release.HookWeightAnnotation, release.HookDeleteAnnotation,
+}
+
+// Converts a Helm hook into one Package Operator hook object per hook event.
+// The first object keeps the name of the hook, further objects are suffixed with the event,
+// e.g. a "pre-install,pre-upgrade" hook named "migrate" turns into
+// the PreInstall hook "migrate" and the PreUpgrade hook "migrate-pre-upgrade".
+// Hook weights are not kept, hooks of the same type run one after another in file order.
+func convertHelmHook(hook *release.Hook) ([]unstructured.Unstructured, error) {
+	for _, event := range hook.Events {
+		if event == release.HookTest {
+			return nil, nil
+		}
+	}
+
+	obj, err := parseHelmManifest(hook.Path, hook.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	annotations := obj.GetAnnotations()
+	for _, a := range helmHookAnnotations {
+		delete(annotations, a)
+	}
+	annotations[manifestsv1alpha1.PackageHookRetentionPolicyAnnotation] = string(
+		helmHookRetentionPolicy(hook.DeletePolicies))
+	annotations[manifestsv1alpha1.PackageHookFailurePolicyAnnotation] = string(
+		corev1alpha1.ObjectSetHookFailurePolicyAbort)
+	obj.SetAnnotations(annotations)
+
+	objects := make([]unstructured.Unstructured, 0, len(hook.Events))
+	for i, event := range hook.Events {
+		hookType, ok := helmHookTypes[event]
+		if !ok {
+			return nil, fmt.Errorf("%w %q on %s %s: only pre-install, pre-upgrade, pre-delete and test hooks are supported",
+				errHelmUnsupportedHook, event, hook.Kind, hook.Name)
+		}
+
+		hookObj := obj.DeepCopy()
+		if i > 0 {
+			hookObj.SetName(obj.GetName() + "-" + string(event))
+		}
+		hookAnnotations := hookObj.GetAnnotations()
+		hookAnnotations[manifestsv1alpha1.PackageHookAnnotation] = string(hookType)
+		hookObj.SetAnnotations(hookAnnotations)
+		objects = append(objects, *hookObj)
+	}
+	return objects, nil
+}
+
+// Maps the helm.sh/hook-delete-policy to the closest retention policy.
+// "before-hook-creation", the Helm default, keeps the hook object around.
+func helmHookRetentionPolicy(policies []release.HookDeletePolicy) corev1alpha1.ObjectSetHookRetentionPolicy {
+	var succeeded, failed bool
+	for _, p := range policies {
+		switch p {
+		case release.HookSucceeded:
+			succeeded = true
+		case release.HookFailed:
+			failed = true
+		}
+	}
+	switch {
+	case succeeded && failed:
+		return corev1alpha1.ObjectSetHookRetentionPolicyDelete
+	case succeeded:
+		return corev1alpha1.ObjectSetHookRetentionPolicyDeleteOnSuccess
+	default:
+		return corev1alpha1.ObjectSetHookRetentionPolicyRetain
+	}
+}
+
+// HelmValuesSchema returns the config schema of the given chart.
+// Uses the values.schema.json of the chart if present,
+// otherwise the schema is inferred from the default values.
+func HelmValuesSchema(chart HelmChart) (apiextensionsv1.JSONSchemaProps, error) {
+	if chart.ValuesSchema != nil {
+		schema := *chart.ValuesSchema.DeepCopy()
+		for name, prop := range schema.Properties {
+			value, ok := chart.Values[name]
+			if !ok || prop.Default != nil {
+				continue
+			}
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return schema, err
+			}
+			prop.Default = &apiextensionsv1.JSON{Raw: raw}
+			schema.Properties[name] = prop
+		}
+		return schema, nil
+	}
+	return inferHelmValueSchema(chart.Values)
+}
+
+func inferHelmValueSchema(value interface{}) (apiextensionsv1.JSONSchemaProps, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return apiextensionsv1.JSONSchemaProps{}, err
+	}
+	schema := apiextensionsv1.JSONSchemaProps{
+		Default: &apiextensionsv1.JSON{Raw: raw},
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		schema.Type = "object"
+		if len(v) == 0 {
+			// Empty maps like resources: {} take arbitrary keys.
+			schema.XPreserveUnknownFields = ptr.To(true)
+			break
+		}
+		schema.Properties = map[string]apiextensionsv1.JSONSchemaProps{}
+		for name, item := range v {
+			if schema.Properties[name], err = inferHelmValueSchema(item); err != nil {
+				return schema, err
+			}
+		}
+	case []interface{}:
+		schema.Type = "array"
+		schema.Items = &apiextensionsv1.JSONSchemaPropsOrArray{
+			Schema: &apiextensionsv1.JSONSchemaProps{XPreserveUnknownFields: ptr.To(true)},
+		}
+	case string:
+		schema.Type = "string"
+	case bool:
+		schema.Type = "boolean"
+	case float64:
+		schema.Type = "number"
+		if v == float64(int64(v)) {
+			schema.Type = "integer"
+		}
+	default:
+		// null values.
+		schema.Default = nil
+		schema.Nullable = true
+		schema.XPreserveUnknownFields = ptr.To(true)
+	}
+	return schema, nil
+}
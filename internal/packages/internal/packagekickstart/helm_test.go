@@ -0,0 +1,212 @@
+package packagekickstart
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+const testHelmChart = "testdata/helm/mychart"
+
+func TestImportHelmChart(t *testing.T) {
+	t.Parallel()
+
+	t.Run("folder", func(t *testing.T) {
+		t.Parallel()
+		assertHelmChartImport(t, testHelmChart)
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		t.Parallel()
+		archive := filepath.Join(t.TempDir(), "mychart-0.1.0.tgz")
+		writeHelmChartArchive(t, testHelmChart, archive)
+		assertHelmChartImport(t, archive)
+	})
+
+	t.Run("missing Chart.yaml", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := ImportHelmChart(context.Background(), t.TempDir())
+		require.ErrorIs(t, err, errHelmChartFileMissing)
+	})
+
+	t.Run("unsupported hook", func(t *testing.T) {
+		t.Parallel()
+		chartPath := t.TempDir()
+		writeFiles(t, chartPath, map[string]string{
+			"Chart.yaml": "apiVersion: v2\nname: hooks\nversion: 0.1.0\n",
+			"templates/notify.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: notify
+  annotations:
+    helm.sh/hook: post-install
+`,
+		})
+		_, _, err := ImportHelmChart(context.Background(), chartPath)
+		require.ErrorIs(t, err, errHelmUnsupportedHook)
+	})
+
+	t.Run("capabilities", func(t *testing.T) {
+		t.Parallel()
+		chartPath := t.TempDir()
+		writeFiles(t, chartPath, map[string]string{
+			"Chart.yaml": "apiVersion: v2\nname: caps\nversion: 0.1.0\n",
+			"templates/caps.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: caps
+data:
+  kubeVersion: {{ .Capabilities.KubeVersion.Version }}
+  monitoring: {{ .Capabilities.APIVersions.Has "monitoring.coreos.com/v1" | quote }}
+`,
+		})
+		objects, _, err := ImportHelmChart(context.Background(), chartPath,
+			WithHelmKubeVersion("v1.29.3"), WithHelmAPIVersions{"monitoring.coreos.com/v1"})
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, map[string]interface{}{
+			"kubeVersion": "v1.29.3",
+			"monitoring":  "true",
+		}, objects[0].Object["data"])
+	})
+}
+
+func assertHelmChartImport(t *testing.T, path string) {
+	t.Helper()
+
+	objects, chart, err := ImportHelmChart(context.Background(), path)
+	require.NoError(t, err)
+	assert.Equal(t, "mychart", chart.Name)
+	assert.Equal(t, "0.1.0", chart.Version)
+	assert.Equal(t, float64(1), chart.Values["replicaCount"])
+	assert.Equal(t, float64(6380), chart.Values["cache"].(map[string]interface{})["port"])
+	assert.Nil(t, chart.ValuesSchema)
+
+	// Test hook Pod is dropped.
+	require.Len(t, objects, 6)
+	deploy := findObject(t, objects, "Deployment", "mychart")
+	assert.Empty(t, deploy.GetNamespace())
+	assert.Equal(t, map[string]string{
+		"app.kubernetes.io/name":    "mychart",
+		"app.kubernetes.io/version": "1.16.0",
+	}, deploy.GetLabels())
+	svc := findObject(t, objects, "Service", "mychart")
+	assert.Equal(t, "ClusterIP", svc.Object["spec"].(map[string]interface{})["type"])
+
+	// Subchart rendered with the values of the parent chart.
+	cacheSvc := findObject(t, objects, "Service", "mychart-cache")
+	ports, _, _ := unstructured.NestedSlice(cacheSvc.Object, "spec", "ports")
+	assert.Equal(t, float64(6380), ports[0].(map[string]interface{})["port"])
+
+	cm := findObject(t, objects, "ConfigMap", "mychart")
+	assert.Equal(t, "log_level = info", cm.Object["data"].(map[string]interface{})["app.conf"])
+
+	// Hook mapped for every event.
+	preInstall := findObject(t, objects, "Job", "mychart-migrate")
+	assert.Equal(t, map[string]string{
+		manifestsv1alpha1.PackageHookAnnotation:                "PreInstall",
+		manifestsv1alpha1.PackageHookRetentionPolicyAnnotation: "DeleteOnSuccess",
+		manifestsv1alpha1.PackageHookFailurePolicyAnnotation:   "Abort",
+	}, preInstall.GetAnnotations())
+	preUpgrade := findObject(t, objects, "Job", "mychart-migrate-pre-upgrade")
+	assert.Equal(t, "PreUpgrade", preUpgrade.GetAnnotations()[manifestsv1alpha1.PackageHookAnnotation])
+}
+
+func findObject(t *testing.T, objects []unstructured.Unstructured, kind, name string) unstructured.Unstructured {
+	t.Helper()
+
+	for _, obj := range objects {
+		if obj.GetKind() == kind && obj.GetName() == name {
+			return obj
+		}
+	}
+	require.Failf(t, "object not found", "%s %s", kind, name)
+	return unstructured.Unstructured{}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o600))
+	}
+}
+
+func TestKickstart_HelmChart(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objects, chart, err := ImportHelmChart(ctx, testHelmChart)
+	require.NoError(t, err)
+
+	rawPkg, res, err := Kickstart(ctx, "my-pkg", objects, nil, WithHelmChart{Chart: chart})
+	require.NoError(t, err)
+	assert.Equal(t, 6, res.ObjectCount)
+
+	deploy := string(rawPkg.Files["deploy/mychart.deployment.yaml.gotmpl"])
+	assert.Contains(t, deploy, `replicas: {{ index .config "replicaCount" | toJson }}`)
+	assert.Contains(t, deploy, `containerPort: {{ index .config "service" "port" | toJson }}`)
+	svc := string(rawPkg.Files["deploy/mychart.service.yaml.gotmpl"])
+	assert.Contains(t, svc, `type: {{ index .config "service" "type" | toJson }}`)
+
+	manifest := &manifestsv1alpha1.PackageManifest{}
+	require.NoError(t, yaml.Unmarshal(
+		rawPkg.Files[packagetypes.PackageManifestFilename+".yaml"], manifest))
+	schema := manifest.Spec.Config.OpenAPIV3Schema
+	require.NotNil(t, schema)
+	assert.Equal(t, "integer", schema.Properties["replicaCount"].Type)
+	assert.JSONEq(t, "1", string(schema.Properties["replicaCount"].Default.Raw))
+	assert.Equal(t, "string", schema.Properties["image"].Properties["tag"].Type)
+	assert.True(t, *schema.Properties["resources"].XPreserveUnknownFields)
+	assert.NotEmpty(t, manifest.Test.Template)
+}
+
+func writeHelmChartArchive(t *testing.T, chartPath, archivePath string) {
+	t.Helper()
+
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, f.Close()) }()
+	gzw := gzip.NewWriter(f)
+	defer func() { require.NoError(t, gzw.Close()) }()
+	tw := tar.NewWriter(gzw)
+	defer func() { require.NoError(t, tw.Close()) }()
+
+	require.NoError(t, filepath.WalkDir(chartPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(chartPath, p)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     filepath.ToSlash(filepath.Join("mychart", rel)),
+			Mode:     0o600,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return err
+		}
+		_, err = tw.Write(data)
+		return err
+	}))
+}
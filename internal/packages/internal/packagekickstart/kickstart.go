@@ -27,13 +27,44 @@ type KickstartResult struct {
 	GroupKindsWithoutProbes []schema.GroupKind
 }
 
+// KickstartOption configures a Kickstart run.
+type KickstartOption interface {
+	ConfigureKickstart(c *KickstartConfig)
+}
+
+// KickstartConfig holds settings for a Kickstart run.
+type KickstartConfig struct {
+	// Helm chart the objects were rendered from.
+	// Chart values are added to the config schema and
+	// object fields matching them are parametrized.
+	HelmChart *HelmChart
+}
+
+// Option applies the given options to the config.
+func (c *KickstartConfig) Option(opts ...KickstartOption) {
+	for _, opt := range opts {
+		opt.ConfigureKickstart(c)
+	}
+}
+
+// WithHelmChart maps the values of the given Helm chart into the package config.
+type WithHelmChart struct{ Chart HelmChart }
+
+func (w WithHelmChart) ConfigureKickstart(c *KickstartConfig) {
+	c.HelmChart = &w.Chart
+}
+
 func Kickstart(
 	_ context.Context, pkgName string,
 	objects []unstructured.Unstructured,
 	paramFlags []string,
+	opts ...KickstartOption,
 ) (
 	*packagetypes.RawPackage, KickstartResult, error,
 ) {
+	var cfg KickstartConfig
+	cfg.Option(opts...)
+
 	res := KickstartResult{}
 	rawPkg := &packagetypes.RawPackage{
 		Files: packagetypes.Files{},
@@ -52,10 +83,21 @@ func Kickstart(
 			Properties: map[string]v1.JSONSchemaProps{},
 		}
 		imageContainer = &presets.ImageContainer{}
+		helmValues     map[string]interface{}
 
 		namespacesFromObjects = map[string]struct{}{}
 		namespaceObjectsFound = map[string]struct{}{}
 	)
+	if cfg.HelmChart != nil {
+		valuesSchema, err := HelmValuesSchema(*cfg.HelmChart)
+		if err != nil {
+			return nil, res, fmt.Errorf("converting Helm values to schema: %w", err)
+		}
+		for name, prop := range valuesSchema.Properties {
+			scheme.Properties[name] = prop
+		}
+		helmValues = cfg.HelmChart.Values
+	}
 	for _, obj := range objects {
 		gk := obj.GroupVersionKind().GroupKind()
 		phase := presets.DeterminePhase(gk)
@@ -99,7 +141,13 @@ func Kickstart(
 		objCount++
 
 		// Parametrization.
-		if b, ok, err := presets.Parametrize(obj, scheme, imageContainer, paramOpts); err != nil {
+		var helmInstructions []parametrize.Instruction
+		if helmValues != nil {
+			helmInstructions = presets.HelmValues(obj, helmValues)
+		}
+		if b, ok, err := presets.Parametrize(
+			obj, scheme, imageContainer, paramOpts, helmInstructions...,
+		); err != nil {
 			return nil, res, fmt.Errorf("parametrizing: %w", err)
 		} else if ok {
 			addFileWithCollisionPrevention(rawPkg.Files, phase, oid, b, "yaml.gotmpl")
@@ -5,6 +5,8 @@ import (
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"package-operator.run/internal/packages/internal/packagekickstart/parametrize"
 )
 
 type ParametrizeOptions struct {
@@ -67,9 +69,14 @@ func Parametrize(
 	scheme *apiextensionsv1.JSONSchemaProps,
 	imageContainer *ImageContainer,
 	opts ParametrizeOptions,
+	extra ...parametrize.Instruction,
 ) ([]byte, bool, error) {
 	if opts.IsEmpty() {
-		return nil, false, nil
+		if len(extra) == 0 {
+			return nil, false, nil
+		}
+		// Don't add preset config for objects only parametrized by extra instructions.
+		return Generic(obj, GenericOptions{}, extra...)
 	}
 
 	if obj.GroupVersionKind() == deployGVK {
@@ -83,7 +90,7 @@ func Parametrize(
 			GenericOptions: GenericOptions{
 				Namespaces: opts.Namespaces,
 			},
-		})
+		}, extra...)
 		if err != nil {
 			return nil, false, err
 		}
@@ -92,5 +99,5 @@ func Parametrize(
 
 	return Generic(obj, GenericOptions{
 		Namespaces: opts.Namespaces,
-	})
+	}, extra...)
 }
@@ -3,6 +3,7 @@ package presets
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/joeycumines/go-dotnotation/dotnotation"
@@ -23,15 +24,18 @@ type DeploymentOptions struct {
 	GenericOptions
 }
 
+// Add Preset Parametrization to Deployments.
+// Extra instructions are executed first, so presets take precedence on the same field.
 func Deployment(
 	obj unstructured.Unstructured,
 	schema *apiextensionsv1.JSONSchemaProps,
 	imageContainer *ImageContainer,
 	opts DeploymentOptions,
+	extra ...parametrize.Instruction,
 ) (
 	[]byte, error,
 ) {
-	var instructions []parametrize.Instruction
+	instructions := slices.Clone(extra)
 	if opts.Namespaces {
 		if inst, ok := parametrizeNamespace(obj); ok {
 			instructions = append(instructions, inst...)
@@ -2,6 +2,7 @@ package presets
 
 import (
 	"fmt"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -14,13 +15,15 @@ type GenericOptions struct {
 }
 
 // Add Preset Parametrization to any objects without special handling.
+// Extra instructions are executed first, so presets take precedence on the same field.
 func Generic(
 	obj unstructured.Unstructured,
 	opts GenericOptions,
+	extra ...parametrize.Instruction,
 ) (
 	[]byte, bool, error,
 ) {
-	var instructions []parametrize.Instruction
+	instructions := slices.Clone(extra)
 	if opts.Namespaces {
 		if inst, ok := parametrizeNamespace(obj); ok {
 			instructions = append(instructions, inst...)
@@ -0,0 +1,152 @@
+package presets
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"package-operator.run/internal/packages/internal/packagekickstart/parametrize"
+)
+
+// Strings shorter than this are too ambiguous to be mapped back to values.
+const helmValueMinStringLength = 3
+
+// Fields and their children that must stay static, because they identify objects
+// or select other objects and are immutable or break ownership when changed.
+var helmValueSkippedFields = []string{
+	"apiVersion", "kind",
+	"metadata.name", "metadata.generateName", "metadata.namespace",
+	"metadata.labels", "metadata.ownerReferences",
+	"spec.selector", "spec.template.metadata.labels",
+	"spec.jobTemplate.spec.selector", "spec.jobTemplate.spec.template.metadata.labels",
+	"roleRef",
+}
+
+// HelmValues returns instructions that turn object fields rendered
+// from Helm chart values back into references to the package config.
+// String values are matched on any field with the same value, if the value is unique within the values.
+// Numbers and booleans are only matched on fields with a related key, e.g. replicaCount and replicas.
+func HelmValues(
+	obj unstructured.Unstructured,
+	values map[string]interface{},
+) []parametrize.Instruction {
+	idx := helmValueIndex{}
+	idx.add(values, nil)
+
+	var instructions []parametrize.Instruction
+	walkHelmObject(obj.Object, nil, func(fieldPath []string, value interface{}) {
+		if isHelmValueSkippedField(fieldPath) {
+			return
+		}
+		valuePath, ok := idx.lookup(fieldPath[len(fieldPath)-1], value)
+		if !ok {
+			return
+		}
+
+		args := make([]string, len(valuePath))
+		for i, p := range valuePath {
+			args[i] = strconv.Quote(p)
+		}
+		instructions = append(instructions, parametrize.Pipeline(
+			fmt.Sprintf("index .config %s | toJson", strings.Join(args, " ")),
+			strings.Join(fieldPath, ".")))
+	})
+	return instructions
+}
+
+func isHelmValueSkippedField(fieldPath []string) bool {
+	path := strings.Join(fieldPath, ".")
+	for _, skipped := range helmValueSkippedFields {
+		if path == skipped || strings.HasPrefix(path, skipped+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// helmValueIndex maps the JSON encoding of leaf values to their paths in the Helm values.
+// Values are compared by their JSON encoding, so int64 object fields match float64 values.
+type helmValueIndex map[string][][]string
+
+func (idx helmValueIndex) add(values map[string]interface{}, parent []string) {
+	for k, v := range values {
+		p := append(slices.Clone(parent), k)
+		switch v := v.(type) {
+		case map[string]interface{}:
+			idx.add(v, p)
+		case string:
+			if len(v) < helmValueMinStringLength {
+				continue
+			}
+			idx[helmValueKey(v)] = append(idx[helmValueKey(v)], p)
+		case bool, float64, int64:
+			idx[helmValueKey(v)] = append(idx[helmValueKey(v)], p)
+		}
+	}
+}
+
+func (idx helmValueIndex) lookup(field string, value interface{}) ([]string, bool) {
+	paths := idx[helmValueKey(value)]
+	if _, ok := value.(string); !ok {
+		var related [][]string
+		for _, p := range paths {
+			if helmKeysRelated(field, p[len(p)-1]) {
+				related = append(related, p)
+			}
+		}
+		paths = related
+	}
+	if len(paths) != 1 {
+		// Not found or ambiguous.
+		return nil, false
+	}
+	return paths[0], true
+}
+
+func helmValueKey(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// Checks whether one key contains the stem of the other, e.g. replicas and replicaCount or port and containerPort.
+func helmKeysRelated(a, b string) bool {
+	stem := func(k string) string {
+		k = strings.ToLower(k)
+		k = strings.TrimSuffix(k, "count")
+		return strings.TrimSuffix(k, "s")
+	}
+	a, b = stem(a), stem(b)
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	return strings.Contains(a, b) || strings.Contains(b, a)
+}
+
+// Calls fn for every scalar leaf of the object.
+func walkHelmObject(obj interface{}, fieldPath []string, fn func(fieldPath []string, value interface{})) {
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range o {
+			if strings.Contains(k, ".") {
+				// Can't be addressed by dot notation.
+				continue
+			}
+			walkHelmObject(v, append(slices.Clone(fieldPath), k), fn)
+		}
+	case []interface{}:
+		for i, v := range o {
+			walkHelmObject(v, append(slices.Clone(fieldPath), strconv.Itoa(i)), fn)
+		}
+	case string, bool, float64, int64:
+		if len(fieldPath) > 0 {
+			fn(fieldPath, o)
+		}
+	}
+}
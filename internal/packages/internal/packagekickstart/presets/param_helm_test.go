@@ -0,0 +1,96 @@
+package presets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestHelmValues(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]interface{}{
+		"replicaCount": float64(2),
+		"logLevel":     "debug",
+		"appName":      "frontend",
+		"service": map[string]interface{}{
+			"port": float64(8080),
+		},
+		"timeoutSeconds": float64(2),
+	}
+	obj := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "StatefulSet",
+			"metadata": map[string]interface{}{
+				"name": "debug",
+				"labels": map[string]interface{}{
+					"app": "frontend",
+				},
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(2),
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app": "frontend",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app": "frontend",
+						},
+					},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "app",
+								"args": []interface{}{"debug"},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": int64(8080),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	scheme := &v1.JSONSchemaProps{
+		Type:       "object",
+		Properties: map[string]v1.JSONSchemaProps{},
+	}
+	out, ok, err := Parametrize(obj, scheme, &ImageContainer{}, ParametrizeOptions{},
+		HelmValues(obj, values)...)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, `apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  labels:
+    app: frontend
+  name: debug
+spec:
+  replicas: {{ index .config "replicaCount" | toJson }}
+  selector:
+    matchLabels:
+      app: frontend
+  template:
+    metadata:
+      labels:
+        app: frontend
+    spec:
+      containers:
+      - args:
+        - {{ index .config "logLevel" | toJson }}
+        name: app
+        ports:
+        - containerPort: {{ index .config "service" "port" | toJson }}
+`, string(out))
+}
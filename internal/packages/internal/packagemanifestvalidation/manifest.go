@@ -88,7 +88,10 @@ func ValidatePackageManifest(ctx context.Context, obj *manifests.PackageManifest
 				field.Invalid(testTemplate.Index(i).Child("name"), template.Name, strings.Join(el, ", ")))
 		}
 
-		if len(configErrors) == 0 {
+		allErrs = append(allErrs, validateTestCaseExpectations(testTemplate.Index(i), template)...)
+
+		// Test cases expecting errors may use invalid configuration on purpose.
+		if len(configErrors) == 0 && len(template.ExpectErrors) == 0 {
 			configuration := map[string]any{}
 			if template.Context.Config != nil {
 				if err := json.Unmarshal(template.Context.Config.Raw, &configuration); err != nil {
@@ -114,6 +117,28 @@ func ValidatePackageManifest(ctx context.Context, obj *manifests.PackageManifest
 	return allErrs, nil
 }
 
+func validateTestCaseExpectations(
+	path *field.Path, template manifests.PackageManifestTestCaseTemplate,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, expected := range template.ExpectErrors {
+		if len(expected.Reason) == 0 && len(expected.Field) == 0 && len(expected.Message) == 0 {
+			allErrs = append(allErrs,
+				field.Required(path.Child("expectErrors").Index(i), "one of reason, field or message must be set"))
+		}
+	}
+	for i, assertion := range template.Assertions {
+		apath := path.Child("assertions").Index(i)
+		if len(assertion.Name) == 0 {
+			allErrs = append(allErrs, field.Required(apath.Child("name"), ""))
+		}
+		if len(assertion.Expression) == 0 {
+			allErrs = append(allErrs, field.Required(apath.Child("expression"), ""))
+		}
+	}
+	return allErrs
+}
+
 func validateConstraints(path *field.Path, constraints []manifests.PackageManifestConstraint) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, constraint := range constraints {
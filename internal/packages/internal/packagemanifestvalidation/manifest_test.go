@@ -91,6 +91,37 @@ func TestValidatePackageManifest(t *testing.T) {
 				"test.template[0].context.config.banana: Required value",
 			},
 		},
+		{
+			name: "template test expectations",
+			packageManifest: &manifests.PackageManifest{
+				Spec: manifests.PackageManifestSpec{
+					Config: manifests.PackageManifestSpecConfig{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type:     OpenapiV3TypeObject,
+							Required: []string{"banana"},
+						},
+					},
+				},
+				Test: manifests.PackageManifestTest{
+					Template: []manifests.PackageManifestTestCaseTemplate{
+						{
+							Name:         "missing-banana",
+							Context:      manifests.TemplateContext{},
+							ExpectErrors: []manifests.PackageManifestTestCaseExpectedError{{}},
+							Assertions:   []manifests.PackageManifestTestCaseAssertion{{}},
+						},
+					},
+				},
+			},
+			expectedErrors: []string{
+				"metadata.name: Required value",
+				"spec.scopes: Required value",
+				"spec.phases: Required value",
+				"test.template[0].expectErrors[0]: Required value: one of reason, field or message must be set",
+				"test.template[0].assertions[0].name: Required value",
+				"test.template[0].assertions[0].expression: Required value",
+			},
+		},
 		{
 			name: "empty image",
 			packageManifest: &manifests.PackageManifest{
@@ -2,6 +2,7 @@ package packagerender
 
 import (
 	"sort"
+	"strconv"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
@@ -15,14 +16,59 @@ import (
 func RenderObjectSetTemplateSpec(
 	pkgInstance *packagetypes.PackageInstance,
 ) (templateSpec corev1alpha1.ObjectSetTemplateSpec) {
+	objects, hooks := collectHooks(pkgInstance.Objects)
+
 	collector := newPhaseCollector(pkgInstance.Manifest.Spec.Phases...)
-	collector.AddObjects(pkgInstance.Objects...)
+	collector.AddObjects(objects...)
 
 	templateSpec.AvailabilityProbes = pkgInstance.Manifest.Spec.AvailabilityProbes
 	templateSpec.Phases = append(templateSpec.Phases, collector.Collect()...)
+	templateSpec.Hooks = hooks
 	return
 }
 
+// Separates objects annotated as lifecycle hooks from the objects going into phases.
+func collectHooks(
+	objs []unstructured.Unstructured,
+) (objects []unstructured.Unstructured, hooks []corev1alpha1.ObjectSetHook) {
+	for _, object := range objs {
+		annotations := object.GetAnnotations()
+		hookAnnotation, ok := annotations[manifestsv1alpha1.PackageHookAnnotation]
+		if !ok {
+			objects = append(objects, object)
+			continue
+		}
+
+		hook := corev1alpha1.ObjectSetHook{
+			Type: corev1alpha1.ObjectSetHookType(hookAnnotation),
+			RetentionPolicy: corev1alpha1.ObjectSetHookRetentionPolicy(
+				annotations[manifestsv1alpha1.PackageHookRetentionPolicyAnnotation]),
+			FailurePolicy: corev1alpha1.ObjectSetHookFailurePolicy(
+				annotations[manifestsv1alpha1.PackageHookFailurePolicyAnnotation]),
+		}
+		delete(annotations, manifestsv1alpha1.PackageHookAnnotation)
+		delete(annotations, manifestsv1alpha1.PackageHookRetentionPolicyAnnotation)
+		delete(annotations, manifestsv1alpha1.PackageHookFailurePolicyAnnotation)
+		delete(annotations, manifestsv1alpha1.PackagePhaseAnnotation)
+		delete(annotations, manifestsv1alpha1.PackageCELConditionAnnotation)
+		if len(annotations) == 0 {
+			// Empty maps are dropped by the API server, see AddObjects.
+			annotations = nil
+		}
+		object.SetAnnotations(annotations)
+
+		if len(hook.RetentionPolicy) == 0 {
+			hook.RetentionPolicy = corev1alpha1.ObjectSetHookRetentionPolicyDeleteOnSuccess
+		}
+		if len(hook.FailurePolicy) == 0 {
+			hook.FailurePolicy = corev1alpha1.ObjectSetHookFailurePolicyAbort
+		}
+		hook.Object = object
+		hooks = append(hooks, hook)
+	}
+	return objects, hooks
+}
+
 func newPhaseCollector(phases ...manifests.PackageManifestPhase) phaseCollector {
 	collector := make(phaseCollector)
 
@@ -52,11 +98,14 @@ func (c phaseCollector) AddObjects(objs ...unstructured.Unstructured) {
 		phaseAnnotation := annotations[manifestsv1alpha1.PackagePhaseAnnotation]
 		collisionProtectionAnnotation := annotations[manifestsv1alpha1.PackageCollisionProtectionAnnotation]
 		isExternalObject := annotations[manifestsv1alpha1.PackageExternalObjectAnnotation] == "True"
+		deletionPolicyAnnotation := annotations[manifestsv1alpha1.PackageDeletionPolicyAnnotation]
 		delete(annotations, manifestsv1alpha1.PackagePhaseAnnotation)
 		delete(annotations, manifestsv1alpha1.PackageConditionMapAnnotation)
 		delete(annotations, manifestsv1alpha1.PackageExternalObjectAnnotation)
 		delete(annotations, manifestsv1alpha1.PackageCollisionProtectionAnnotation)
 		delete(annotations, manifestsv1alpha1.PackageCELConditionAnnotation)
+		delete(annotations, manifestsv1alpha1.PackageWaveAnnotation)
+		delete(annotations, manifestsv1alpha1.PackageDeletionPolicyAnnotation)
 		if len(annotations) == 0 {
 			// This is important!
 			// When submitted to the API server empty maps will be dropped.
@@ -71,6 +120,10 @@ func (c phaseCollector) AddObjects(objs ...unstructured.Unstructured) {
 		if err != nil {
 			panic(err)
 		}
+		wave, err := parseWaveAnnotation(&objs[i])
+		if err != nil {
+			panic(err)
+		}
 
 		object.SetAnnotations(annotations)
 
@@ -78,6 +131,8 @@ func (c phaseCollector) AddObjects(objs ...unstructured.Unstructured) {
 			Object:              object,
 			ConditionMappings:   conditionMapping,
 			CollisionProtection: corev1alpha1.CollisionProtection(collisionProtectionAnnotation),
+			Wave:                wave,
+			DeletionPolicy:      corev1alpha1.DeletionPolicy(deletionPolicyAnnotation),
 		}
 
 		if isExternalObject {
@@ -134,3 +189,16 @@ func (c phaseCollector) Collect() []corev1alpha1.ObjectSetTemplatePhase {
 
 	return phases
 }
+
+// Returns the wave assigned to the object via annotation, defaulting to 0.
+func parseWaveAnnotation(obj *unstructured.Unstructured) (int32, error) {
+	waveAnnotation, ok := obj.GetAnnotations()[manifestsv1alpha1.PackageWaveAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	wave, err := strconv.ParseInt(waveAnnotation, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return int32(wave), nil
+}
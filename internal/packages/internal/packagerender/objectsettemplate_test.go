@@ -8,8 +8,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/packages/internal/packageimport"
 	"package-operator.run/internal/packages/internal/packagestructure"
 	"package-operator.run/internal/packages/internal/packagetypes"
@@ -46,6 +49,82 @@ func TestTemplateSpecFromPackage(t *testing.T) {
 	}, objectsToKindNameString(spec.Phases[0].Objects))
 }
 
+func TestPhaseCollector_wave(t *testing.T) {
+	t.Parallel()
+
+	collector := newPhaseCollector(manifests.PackageManifestPhase{Name: "deploy"})
+	obj := unstructured.Unstructured{}
+	obj.SetName("crd")
+	obj.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackagePhaseAnnotation: "deploy",
+		manifestsv1alpha1.PackageWaveAnnotation:  "-1",
+	})
+	collector.AddObjects(obj)
+
+	phases := collector.Collect()
+	require.Len(t, phases, 1)
+	require.Len(t, phases[0].Objects, 1)
+	assert.Equal(t, int32(-1), phases[0].Objects[0].Wave)
+	assert.Empty(t, phases[0].Objects[0].Object.GetAnnotations())
+}
+
+func TestPhaseCollector_deletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	collector := newPhaseCollector(manifests.PackageManifestPhase{Name: "deploy"})
+	obj := unstructured.Unstructured{}
+	obj.SetName("pvc")
+	obj.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackagePhaseAnnotation:          "deploy",
+		manifestsv1alpha1.PackageDeletionPolicyAnnotation: "Orphan",
+	})
+	collector.AddObjects(obj)
+
+	phases := collector.Collect()
+	require.Len(t, phases, 1)
+	require.Len(t, phases[0].Objects, 1)
+	assert.Equal(t, v1alpha1.DeletionPolicyOrphan, phases[0].Objects[0].DeletionPolicy)
+	assert.Empty(t, phases[0].Objects[0].Object.GetAnnotations())
+}
+
+func TestCollectHooks(t *testing.T) {
+	t.Parallel()
+
+	migration := unstructured.Unstructured{}
+	migration.SetName("migration")
+	migration.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackageHookAnnotation:                "PreUpgrade",
+		manifestsv1alpha1.PackageHookFailurePolicyAnnotation:   "Ignore",
+		manifestsv1alpha1.PackageHookRetentionPolicyAnnotation: "Retain",
+		"keep": "me",
+	})
+	cleanup := unstructured.Unstructured{}
+	cleanup.SetName("cleanup")
+	cleanup.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackageHookAnnotation: "PreDelete",
+	})
+	cm := unstructured.Unstructured{}
+	cm.SetName("cm")
+	cm.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackagePhaseAnnotation: "deploy",
+	})
+
+	objects, hooks := collectHooks([]unstructured.Unstructured{migration, cm, cleanup})
+	require.Len(t, objects, 1)
+	assert.Equal(t, "cm", objects[0].GetName())
+
+	require.Len(t, hooks, 2)
+	assert.Equal(t, v1alpha1.ObjectSetHookTypePreUpgrade, hooks[0].Type)
+	assert.Equal(t, v1alpha1.ObjectSetHookFailurePolicyIgnore, hooks[0].FailurePolicy)
+	assert.Equal(t, v1alpha1.ObjectSetHookRetentionPolicyRetain, hooks[0].RetentionPolicy)
+	assert.Equal(t, map[string]string{"keep": "me"}, hooks[0].Object.GetAnnotations())
+
+	assert.Equal(t, v1alpha1.ObjectSetHookTypePreDelete, hooks[1].Type)
+	assert.Equal(t, v1alpha1.ObjectSetHookFailurePolicyAbort, hooks[1].FailurePolicy)
+	assert.Equal(t, v1alpha1.ObjectSetHookRetentionPolicyDeleteOnSuccess, hooks[1].RetentionPolicy)
+	assert.Empty(t, hooks[1].Object.GetAnnotations())
+}
+
 func objectsToKindNameString(objects []v1alpha1.ObjectSetObject) []string {
 	out := make([]string, len(objects))
 	for i, obj := range objects {
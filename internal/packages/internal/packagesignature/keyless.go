@@ -0,0 +1,148 @@
+package packagesignature
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	protorekor "github.com/sigstore/protobuf-specs/gen/pb-go/rekor/v1"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+)
+
+var (
+	errNoBundle      = errors.New("keyless signature has no transparency log bundle")
+	errInvalidBundle = errors.New("transparency log entry does not record the signed payload")
+)
+
+// Media type of the sigstore bundle keyless signatures are verified as.
+// v0.1 bundles carry the inclusion promise (SET) cosign attaches to signatures.
+const sigstoreBundleMediaType = "application/vnd.dev.sigstore.bundle+json;version=0.1"
+
+// rekorBundle is the offline proof of inclusion in the Rekor transparency log.
+type rekorBundle struct {
+	SignedEntryTimestamp []byte             `json:"SignedEntryTimestamp"`
+	Payload              rekorBundlePayload `json:"Payload"`
+}
+
+// Fields are ordered alphabetically, so json.Marshal produces the canonical form Rekor signs.
+type rekorBundlePayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+// transparency log entry header and the hashedrekord data hash.
+type rekorEntry struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Spec       struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+	} `json:"spec"`
+}
+
+// verifyKeyless checks a signature made with a short-lived Fulcio certificate
+// by converting the cosign annotations into a sigstore bundle and verifying it offline:
+// the certificate has to chain up to the trusted root, the Rekor entry has to be
+// promised by a trusted log and record this signature, certificate and payload,
+// and the certificate identity has to be trusted by the policy.
+func (v *Verifier) verifyKeyless(
+	payload, sig []byte, annotations map[string]string, identities []verify.CertificateIdentity,
+) error {
+	entity, err := newSigstoreBundle(payload, sig, annotations)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, id := range identities {
+		_, err := v.keyless.Verify(entity, verify.NewPolicy(
+			verify.WithArtifact(bytes.NewReader(payload)),
+			verify.WithCertificateIdentity(id),
+		))
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func newSigstoreBundle(payload, sig []byte, annotations map[string]string) (*bundle.ProtobufBundle, error) {
+	if len(annotations[BundleAnnotation]) == 0 {
+		return nil, errNoBundle
+	}
+	rb := rekorBundle{}
+	if err := json.Unmarshal([]byte(annotations[BundleAnnotation]), &rb); err != nil {
+		return nil, fmt.Errorf("parsing transparency log bundle: %w", err)
+	}
+	body, err := base64.StdEncoding.DecodeString(rb.Payload.Body)
+	if err != nil {
+		return nil, fmt.Errorf("decoding transparency log entry: %w", err)
+	}
+	entry := rekorEntry{}
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return nil, fmt.Errorf("parsing transparency log entry: %w", err)
+	}
+	// sigstore-go binds the entry to the signature and certificate,
+	// make sure it was also made for this payload.
+	payloadHash := sha256.Sum256(payload)
+	if entry.Spec.Data.Hash.Algorithm != "sha256" ||
+		entry.Spec.Data.Hash.Value != hex.EncodeToString(payloadHash[:]) {
+		return nil, errInvalidBundle
+	}
+	logID, err := hex.DecodeString(rb.Payload.LogID)
+	if err != nil {
+		return nil, fmt.Errorf("decoding transparency log id: %w", err)
+	}
+
+	block, _ := pem.Decode([]byte(annotations[CertificateAnnotation]))
+	if block == nil {
+		return nil, errNoPEMBlock
+	}
+
+	entity, err := bundle.NewProtobufBundle(&protobundle.Bundle{
+		MediaType: sigstoreBundleMediaType,
+		VerificationMaterial: &protobundle.VerificationMaterial{
+			Content: &protobundle.VerificationMaterial_X509CertificateChain{
+				X509CertificateChain: &protocommon.X509CertificateChain{
+					Certificates: []*protocommon.X509Certificate{{RawBytes: block.Bytes}},
+				},
+			},
+			TlogEntries: []*protorekor.TransparencyLogEntry{{
+				LogIndex:          rb.Payload.LogIndex,
+				LogId:             &protocommon.LogId{KeyId: logID},
+				KindVersion:       &protorekor.KindVersion{Kind: entry.Kind, Version: entry.APIVersion},
+				IntegratedTime:    rb.Payload.IntegratedTime,
+				InclusionPromise:  &protorekor.InclusionPromise{SignedEntryTimestamp: rb.SignedEntryTimestamp},
+				CanonicalizedBody: body,
+			}},
+		},
+		Content: &protobundle.Bundle_MessageSignature{
+			MessageSignature: &protocommon.MessageSignature{
+				MessageDigest: &protocommon.HashOutput{
+					Algorithm: protocommon.HashAlgorithm_SHA2_256,
+					Digest:    payloadHash[:],
+				},
+				Signature: sig,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building sigstore bundle: %w", err)
+	}
+	return entity, nil
+}
@@ -0,0 +1,157 @@
+package packagesignature
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"sigs.k8s.io/yaml"
+)
+
+var errInvalidImagePrefix = errors.New("invalid image prefix")
+
+// VerificationPolicy configures which images have to be signed by whom.
+//
+//	trustedRoot: |
+//	  {"mediaType": "application/vnd.dev.sigstore.trustedroot+json;version=0.1", ...}
+//	policies:
+//	- imagePrefix: quay.io/my-org
+//	  publicKeys:
+//	  - |
+//	    -----BEGIN PUBLIC KEY-----
+//	    ...
+//	  keyless:
+//	  - issuer: https://token.actions.githubusercontent.com
+//	    subjectRegExp: ^https://github.com/my-org/.*$
+type VerificationPolicy struct {
+	// Sigstore trusted root to verify keyless signatures against,
+	// in the trusted_root.json format distributed via the Sigstore TUF repository.
+	// Keyless signatures are verified offline, so no online trust root is needed.
+	TrustedRoot string `json:"trustedRoot,omitempty"`
+	// Policies scoped by image prefix.
+	// Images matching none of the policies are not verified.
+	// When multiple policies match, the most specific one is used.
+	Policies []ImagePolicy `json:"policies"`
+}
+
+// ImagePolicy requires images to be signed by one of the given keys or identities.
+type ImagePolicy struct {
+	// Registry host, optionally followed by a repository path prefix, this policy applies to.
+	// Matched on whole path segments, so "quay.io/my-org" applies to "quay.io/my-org/pkg",
+	// but not to "quay.io/my-org-other/pkg".
+	ImagePrefix string `json:"imagePrefix"`
+	// PEM encoded public keys trusted to sign images.
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// Keyless signing identities trusted to sign images.
+	Keyless []KeylessIdentity `json:"keyless,omitempty"`
+}
+
+// KeylessIdentity identifies the signer of a keyless signature.
+type KeylessIdentity struct {
+	// OIDC issuer of the signing identity.
+	Issuer string `json:"issuer"`
+	// Expected subject (email or URI) of the signing certificate.
+	Subject string `json:"subject,omitempty"`
+	// Regular expression matching the subject of the signing certificate.
+	SubjectRegExp string `json:"subjectRegExp,omitempty"`
+}
+
+// Loads a VerificationPolicy from the given YAML file.
+func LoadVerificationPolicy(path string) (*VerificationPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading verification policy: %w", err)
+	}
+	policy := &VerificationPolicy{}
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, fmt.Errorf("parsing verification policy: %w", err)
+	}
+	return policy, nil
+}
+
+// parsed representation of an ImagePolicy.
+type compiledPolicy struct {
+	imagePrefix string
+	// registry host followed by repository path segments.
+	segments   []string
+	verifiers  []signature.Verifier
+	identities []verify.CertificateIdentity
+}
+
+// Returns true if the given repository is located below the image prefix of the policy.
+func (p compiledPolicy) matches(repo name.Repository) bool {
+	segments := repositorySegments(repo)
+	if len(segments) < len(p.segments) {
+		return false
+	}
+	for i := range p.segments {
+		if segments[i] != p.segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func compilePolicies(policies []ImagePolicy) ([]compiledPolicy, error) {
+	compiled := make([]compiledPolicy, len(policies))
+	for i, p := range policies {
+		segments, err := imagePrefixSegments(p.ImagePrefix)
+		if err != nil {
+			return nil, err
+		}
+		compiled[i].imagePrefix = p.ImagePrefix
+		compiled[i].segments = segments
+
+		for _, keyPEM := range p.PublicKeys {
+			key, err := cryptoutils.UnmarshalPEMToPublicKey([]byte(keyPEM))
+			if err != nil {
+				return nil, fmt.Errorf("policy %s: parsing public key: %w", p.ImagePrefix, err)
+			}
+			verifier, err := signature.LoadVerifier(key, crypto.SHA256)
+			if err != nil {
+				return nil, fmt.Errorf("policy %s: %w: %w", p.ImagePrefix, ErrUnsupportedKey, err)
+			}
+			compiled[i].verifiers = append(compiled[i].verifiers, verifier)
+		}
+		for _, id := range p.Keyless {
+			identity, err := verify.NewShortCertificateIdentity(id.Issuer, "", id.Subject, id.SubjectRegExp)
+			if err != nil {
+				return nil, fmt.Errorf("policy %s: %w", p.ImagePrefix, err)
+			}
+			compiled[i].identities = append(compiled[i].identities, identity)
+		}
+	}
+
+	// Most path segments first, so the most specific policy wins.
+	sort.SliceStable(compiled, func(i, j int) bool {
+		return len(compiled[i].segments) > len(compiled[j].segments)
+	})
+	return compiled, nil
+}
+
+// Splits an image prefix into the normalized registry host and repository path segments.
+func imagePrefixSegments(prefix string) ([]string, error) {
+	segments := strings.Split(strings.Trim(prefix, "/"), "/")
+	registry, err := name.NewRegistry(segments[0])
+	if err != nil || len(segments[0]) == 0 {
+		return nil, fmt.Errorf("%w %q: must start with a registry host", errInvalidImagePrefix, prefix)
+	}
+	segments[0] = registry.RegistryStr()
+	for _, segment := range segments[1:] {
+		if len(segment) == 0 {
+			return nil, fmt.Errorf("%w %q: empty path segment", errInvalidImagePrefix, prefix)
+		}
+	}
+	return segments, nil
+}
+
+func repositorySegments(repo name.Repository) []string {
+	return append([]string{repo.RegistryStr()}, strings.Split(repo.RepositoryStr(), "/")...)
+}
@@ -0,0 +1,49 @@
+package packagesignature
+
+import (
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompiledPolicy_matches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		prefix  string
+		image   string
+		matches bool
+	}{
+		{prefix: "quay.io", image: "quay.io/org/pkg", matches: true},
+		{prefix: "quay.io/", image: "quay.io/org/pkg", matches: true},
+		{prefix: "quay.io/org", image: "quay.io/org/pkg", matches: true},
+		{prefix: "quay.io/org/pkg", image: "quay.io/org/pkg", matches: true},
+		{prefix: "quay.io/or", image: "quay.io/org/pkg", matches: false},
+		{prefix: "quay.io/org-other", image: "quay.io/org/pkg", matches: false},
+		{prefix: "quay.io/org/pkg/sub", image: "quay.io/org/pkg", matches: false},
+		{prefix: "quay.i", image: "quay.io/org/pkg", matches: false},
+		{prefix: "docker.io/library", image: "busybox", matches: true},
+	}
+	for _, test := range tests {
+		t.Run(test.prefix+" "+test.image, func(t *testing.T) {
+			t.Parallel()
+
+			policies, err := compilePolicies([]ImagePolicy{{ImagePrefix: test.prefix}})
+			require.NoError(t, err)
+			repo, err := name.NewRepository(test.image)
+			require.NoError(t, err)
+			assert.Equal(t, test.matches, policies[0].matches(repo))
+		})
+	}
+}
+
+func TestCompilePolicies_invalidPrefix(t *testing.T) {
+	t.Parallel()
+
+	for _, prefix := range []string{"", "quay.io//org"} {
+		_, err := compilePolicies([]ImagePolicy{{ImagePrefix: prefix}})
+		require.ErrorIs(t, err, errInvalidImagePrefix, prefix)
+	}
+}
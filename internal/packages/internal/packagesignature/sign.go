@@ -0,0 +1,138 @@
+package packagesignature
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+var (
+	// ErrEncryptedSigningKey is returned when loading a password protected cosign key.
+	ErrEncryptedSigningKey = errors.New(
+		"encrypted cosign keys are not supported, use an unencrypted PKCS#8 private key")
+	// ErrUnsupportedKey is returned for keys that are not ECDSA, RSA or Ed25519 keys.
+	ErrUnsupportedKey = errors.New("unsupported key type")
+	errNoPEMBlock     = errors.New("no PEM block found")
+)
+
+// Loads a PEM encoded ECDSA, RSA or Ed25519 private key to sign images with.
+func LoadSigningKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errNoPEMBlock
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "ENCRYPTED COSIGN PRIVATE KEY", "ENCRYPTED SIGSTORE PRIVATE KEY":
+		return nil, ErrEncryptedSigningKey
+	default:
+		return nil, fmt.Errorf("%w: PEM block %q", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+	return signer, nil
+}
+
+// Sign signs the given image digest with the given key and pushes
+// the signature next to the image, where cosign expects it.
+// Existing signatures of the same digest are kept.
+func Sign(ctx context.Context, ref name.Digest, signer crypto.Signer, opts ...crane.Option) error {
+	payload, err := json.Marshal(newSimpleSigning(ref))
+	if err != nil {
+		return err
+	}
+	sig, err := signPayload(signer, payload)
+	if err != nil {
+		return fmt.Errorf("signing payload: %w", err)
+	}
+
+	opts = append(opts, crane.WithContext(ctx))
+	sigTag := SignatureTag(ref)
+	sigImage, err := signatureBase(sigTag, opts...)
+	if err != nil {
+		return err
+	}
+	sigImage, err = appendSignatureLayer(sigImage, payload, map[string]string{
+		SignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	})
+	if err != nil {
+		return err
+	}
+
+	logr.FromContextOrDiscard(ctx).V(1).Info("pushing signature", "reference", sigTag.String())
+	if err := crane.Push(sigImage, sigTag.String(), opts...); err != nil {
+		return fmt.Errorf("push signature: %w", err)
+	}
+	return nil
+}
+
+// signatureBase returns the existing signature image or an empty image, if none exists yet.
+func signatureBase(sigTag name.Tag, opts ...crane.Option) (containerregistrypkgv1.Image, error) {
+	sigImage, err := crane.Pull(sigTag.String(), opts...)
+	var terr *transport.Error
+	if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+		return mutate.ConfigMediaType(
+			mutate.MediaType(empty.Image, types.OCIManifestSchema1),
+			types.OCIConfigJSON,
+		), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pulling existing signatures: %w", err)
+	}
+	return sigImage, nil
+}
+
+func appendSignatureLayer(
+	sigImage containerregistrypkgv1.Image, payload []byte, annotations map[string]string,
+) (containerregistrypkgv1.Image, error) {
+	sigImage, err := mutate.Append(sigImage, mutate.Addendum{
+		Layer:       static.NewLayer(payload, SimpleSigningMediaType),
+		Annotations: annotations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("adding signature layer: %w", err)
+	}
+	return sigImage, nil
+}
+
+func signPayload(signer crypto.Signer, payload []byte) ([]byte, error) {
+	s, err := signature.LoadSigner(signer, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedKey, err)
+	}
+	return s.SignMessage(bytes.NewReader(payload))
+}
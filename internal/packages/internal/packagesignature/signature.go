@@ -0,0 +1,59 @@
+package packagesignature
+
+import (
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// Cosign compatible media type and annotations of signature image layers.
+const (
+	// SimpleSigningMediaType is the media type of signature payload layers.
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// SignatureAnnotation holds the base64 encoded signature of the layer payload.
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// CertificateAnnotation holds the PEM encoded signing certificate of keyless signatures.
+	CertificateAnnotation = "dev.sigstore.cosign/certificate"
+	// BundleAnnotation holds the transparency log entry of keyless signatures.
+	BundleAnnotation = "dev.sigstore.cosign/bundle"
+
+	simpleSigningType = "cosign container image signature"
+	signatureSuffix   = ".sig"
+)
+
+// simpleSigning is the payload signed for an image,
+// following the containers/image "simple signing" format used by cosign.
+type simpleSigning struct {
+	Critical simpleSigningCritical `json:"critical"`
+	Optional map[string]any        `json:"optional"`
+}
+
+type simpleSigningCritical struct {
+	Identity simpleSigningIdentity `json:"identity"`
+	Image    simpleSigningImage    `json:"image"`
+	Type     string                `json:"type"`
+}
+
+type simpleSigningIdentity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type simpleSigningImage struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+func newSimpleSigning(ref name.Digest) simpleSigning {
+	return simpleSigning{
+		Critical: simpleSigningCritical{
+			Identity: simpleSigningIdentity{DockerReference: ref.Context().Name()},
+			Image:    simpleSigningImage{DockerManifestDigest: ref.DigestStr()},
+			Type:     simpleSigningType,
+		},
+	}
+}
+
+// SignatureTag returns the tag cosign stores signatures of the given image digest under.
+// e.g. quay.io/org/pkg@sha256:abc... -> quay.io/org/pkg:sha256-abc....sig.
+func SignatureTag(ref name.Digest) name.Tag {
+	return ref.Context().Tag(strings.Replace(ref.DigestStr(), ":", "-", 1) + signatureSuffix)
+}
@@ -0,0 +1,187 @@
+package packagesignature
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+)
+
+var (
+	// ErrVerificationFailed is returned when an image has no signature
+	// satisfying the verification policy that applies to it.
+	ErrVerificationFailed = errors.New("image signature verification failed")
+	// ErrNoTrustRoot is returned when a policy uses keyless identities without a trusted root.
+	ErrNoTrustRoot        = errors.New("keyless verification requires a trusted root")
+	errDigestMismatch     = errors.New("signed digest does not match image digest")
+	errReferenceMismatch  = errors.New("signed reference does not match image repository")
+	errNoMatchingSigner   = errors.New("not signed by a trusted key or identity")
+	errPayloadUnsupported = errors.New("unsupported signature payload type")
+)
+
+// Verifier checks cosign signatures of images against a VerificationPolicy.
+type Verifier struct {
+	policies []compiledPolicy
+	keyless  *verify.SignedEntityVerifier
+
+	pullSignatures pullSignaturesFn
+}
+
+type pullSignaturesFn func(ref string, opts ...crane.Option) (containerregistrypkgv1.Image, error)
+
+// Creates a new Verifier enforcing the given policy.
+func NewVerifier(policy VerificationPolicy) (*Verifier, error) {
+	policies, err := compilePolicies(policy.Policies)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Verifier{
+		policies:       policies,
+		pullSignatures: crane.Pull,
+	}
+	if len(policy.TrustedRoot) > 0 {
+		trustedRoot, err := root.NewTrustedRootFromJSON([]byte(policy.TrustedRoot))
+		if err != nil {
+			return nil, fmt.Errorf("parsing trusted root: %w", err)
+		}
+		// Signatures are verified offline against the Rekor inclusion promise,
+		// which also provides the time the certificate has to be valid at.
+		v.keyless, err = verify.NewSignedEntityVerifier(trustedRoot,
+			verify.WithTransparencyLog(1), verify.WithIntegratedTimestamps(1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range policies {
+		if len(p.identities) > 0 && v.keyless == nil {
+			return nil, fmt.Errorf("policy %s: %w", p.imagePrefix, ErrNoTrustRoot)
+		}
+	}
+	return v, nil
+}
+
+// Verify checks that the given image digest carries at least one signature
+// satisfying the policy matching the image.
+// image is the reference as requested, used to select the policy and to check the signed identity.
+// source is where the image is actually pulled from, e.g. a registry mirror, and may be equal to image.
+// Signatures are read from source.
+// Images not matching any policy are accepted without checks.
+func (v *Verifier) Verify(ctx context.Context, image, source name.Digest, opts ...crane.Option) error {
+	policy, ok := v.policyFor(image.Context())
+	if !ok {
+		return nil
+	}
+
+	sigImage, err := v.pullSignatures(SignatureTag(source).String(), opts...)
+	if err != nil {
+		return fmt.Errorf("%w: pulling signatures of %s: %w", ErrVerificationFailed, image, err)
+	}
+	manifest, err := sigImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("%w: reading signatures of %s: %w", ErrVerificationFailed, image, err)
+	}
+
+	log := logr.FromContextOrDiscard(ctx)
+	var errs []error
+	for _, desc := range manifest.Layers {
+		err := v.verifyLayer(image, sigImage, desc, policy)
+		if err == nil {
+			log.V(1).Info("verified image signature", "image", image.String(), "source", source.String())
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("%w: no signatures found for %s", ErrVerificationFailed, image)
+	}
+	return fmt.Errorf("%w: %s: %w", ErrVerificationFailed, image, errors.Join(errs...))
+}
+
+func (v *Verifier) policyFor(repo name.Repository) (compiledPolicy, bool) {
+	for _, p := range v.policies {
+		if p.matches(repo) {
+			return p, true
+		}
+	}
+	return compiledPolicy{}, false
+}
+
+func (v *Verifier) verifyLayer(
+	image name.Digest, sigImage containerregistrypkgv1.Image,
+	desc containerregistrypkgv1.Descriptor, policy compiledPolicy,
+) error {
+	if desc.MediaType != SimpleSigningMediaType {
+		return fmt.Errorf("%w: %s", errPayloadUnsupported, desc.MediaType)
+	}
+	payload, err := readLayer(sigImage, desc.Digest)
+	if err != nil {
+		return err
+	}
+
+	ss := simpleSigning{}
+	if err := json.Unmarshal(payload, &ss); err != nil {
+		return fmt.Errorf("parsing signature payload: %w", err)
+	}
+	if ss.Critical.Type != simpleSigningType {
+		return fmt.Errorf("%w: %q", errPayloadUnsupported, ss.Critical.Type)
+	}
+	if ss.Critical.Image.DockerManifestDigest != image.DigestStr() {
+		return errDigestMismatch
+	}
+	// A signature is only valid for the repository it was made for,
+	// otherwise it could be copied to other repositories signed by the same key.
+	signedRepo, err := name.NewRepository(ss.Critical.Identity.DockerReference)
+	if err != nil || signedRepo.Name() != image.Context().Name() {
+		return fmt.Errorf("%w: %q", errReferenceMismatch, ss.Critical.Identity.DockerReference)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(desc.Annotations[SignatureAnnotation])
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+
+	for _, verifier := range policy.verifiers {
+		if verifier.VerifySignature(bytes.NewReader(sig), bytes.NewReader(payload)) == nil {
+			return nil
+		}
+	}
+
+	if len(policy.identities) > 0 && len(desc.Annotations[CertificateAnnotation]) > 0 {
+		return v.verifyKeyless(payload, sig, desc.Annotations, policy.identities)
+	}
+	return errNoMatchingSigner
+}
+
+// Blob digests are verified by go-containerregistry while reading.
+func readLayer(img containerregistrypkgv1.Image, digest containerregistrypkgv1.Hash) (data []byte, err error) {
+	layer, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, fmt.Errorf("getting signature layer: %w", err)
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("reading signature layer: %w", err)
+	}
+	defer func() {
+		if cErr := rc.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+	}()
+
+	data, err = io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("reading signature layer: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,439 @@
+package packagesignature
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	prototrustroot "github.com/sigstore/protobuf-specs/gen/pb-go/trustroot/v1"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"package-operator.run/internal/testutil"
+)
+
+const testRepo = "registry.package-operator.run/signed/pkg"
+
+func TestSignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	reg := testutil.NewInMemoryRegistry()
+	ctx := context.Background()
+	ref := pushTestImage(t, reg, testRepo+":v1")
+
+	key, keyPEM := newTestKey(t)
+	_, otherKeyPEM := newTestKey(t)
+
+	// Unsigned.
+	v := newTestVerifier(t, VerificationPolicy{Policies: []ImagePolicy{
+		{ImagePrefix: "registry.package-operator.run/signed/", PublicKeys: []string{keyPEM}},
+	}})
+	require.ErrorIs(t, v.Verify(ctx, ref, ref, reg.CraneOpt), ErrVerificationFailed)
+
+	require.NoError(t, Sign(ctx, ref, key, reg.CraneOpt))
+	require.NoError(t, v.Verify(ctx, ref, ref, reg.CraneOpt))
+
+	// Signing again keeps existing signatures.
+	otherKey, _ := newTestKey(t)
+	require.NoError(t, Sign(ctx, ref, otherKey, reg.CraneOpt))
+	require.NoError(t, v.Verify(ctx, ref, ref, reg.CraneOpt))
+	sigImage, err := crane.Pull(SignatureTag(ref).String(), reg.CraneOpt)
+	require.NoError(t, err)
+	manifest, err := sigImage.Manifest()
+	require.NoError(t, err)
+	assert.Len(t, manifest.Layers, 2)
+
+	// Untrusted key.
+	v = newTestVerifier(t, VerificationPolicy{Policies: []ImagePolicy{
+		{ImagePrefix: "registry.package-operator.run/", PublicKeys: []string{otherKeyPEM}},
+	}})
+	require.ErrorIs(t, v.Verify(ctx, ref, ref, reg.CraneOpt), ErrVerificationFailed)
+
+	// Most specific policy wins.
+	v = newTestVerifier(t, VerificationPolicy{Policies: []ImagePolicy{
+		{ImagePrefix: "registry.package-operator.run/", PublicKeys: []string{otherKeyPEM}},
+		{ImagePrefix: "registry.package-operator.run/signed/", PublicKeys: []string{keyPEM}},
+	}})
+	require.NoError(t, v.Verify(ctx, ref, ref, reg.CraneOpt))
+
+	// Not covered by any policy.
+	v = newTestVerifier(t, VerificationPolicy{Policies: []ImagePolicy{
+		{ImagePrefix: "quay.io/", PublicKeys: []string{otherKeyPEM}},
+	}})
+	require.NoError(t, v.Verify(ctx, ref, ref, reg.CraneOpt))
+
+	// Prefixes match whole path segments only.
+	v = newTestVerifier(t, VerificationPolicy{Policies: []ImagePolicy{
+		{ImagePrefix: "registry.package-operator.run/sig", PublicKeys: []string{otherKeyPEM}},
+	}})
+	require.NoError(t, v.Verify(ctx, ref, ref, reg.CraneOpt))
+}
+
+func TestVerify_mirror(t *testing.T) {
+	t.Parallel()
+
+	reg := testutil.NewInMemoryRegistry()
+	ctx := context.Background()
+	key, keyPEM := newTestKey(t)
+
+	// The mirror holds a copy of the image and its signatures.
+	source := pushTestImage(t, reg, "mirror.package-operator.run/signed/pkg:v1")
+	image, err := name.NewDigest(testRepo + "@" + source.DigestStr())
+	require.NoError(t, err)
+	payload, err := json.Marshal(newSimpleSigning(image))
+	require.NoError(t, err)
+	sig, err := signPayload(key, payload)
+	require.NoError(t, err)
+	pushTestSignature(t, reg, source, payload, map[string]string{
+		SignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	})
+
+	// Policies apply to the requested image, not the mirror.
+	v := newTestVerifier(t, VerificationPolicy{Policies: []ImagePolicy{
+		{ImagePrefix: "registry.package-operator.run/signed", PublicKeys: []string{keyPEM}},
+	}})
+	require.NoError(t, v.Verify(ctx, image, source, reg.CraneOpt))
+
+	v = newTestVerifier(t, VerificationPolicy{Policies: []ImagePolicy{
+		{ImagePrefix: "registry.package-operator.run/signed", PublicKeys: []string{keyPEM}},
+		{ImagePrefix: "mirror.package-operator.run", PublicKeys: []string{keyPEM}},
+	}})
+	// The signature was made for the requested repository, not for the mirror.
+	require.ErrorIs(t, v.Verify(ctx, source, source, reg.CraneOpt), ErrVerificationFailed)
+}
+
+func TestVerify_referenceMismatch(t *testing.T) {
+	t.Parallel()
+
+	reg := testutil.NewInMemoryRegistry()
+	ctx := context.Background()
+	ref := pushTestImage(t, reg, testRepo+":v1")
+	key, keyPEM := newTestKey(t)
+
+	// Signature copied over from another repository signed by the same key.
+	other, err := name.NewDigest("registry.package-operator.run/other@" + ref.DigestStr())
+	require.NoError(t, err)
+	payload, err := json.Marshal(newSimpleSigning(other))
+	require.NoError(t, err)
+	sig, err := signPayload(key, payload)
+	require.NoError(t, err)
+	pushTestSignature(t, reg, ref, payload, map[string]string{
+		SignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	})
+
+	v := newTestVerifier(t, VerificationPolicy{Policies: []ImagePolicy{
+		{ImagePrefix: "registry.package-operator.run", PublicKeys: []string{keyPEM}},
+	}})
+	err = v.Verify(ctx, ref, ref, reg.CraneOpt)
+	require.ErrorIs(t, err, ErrVerificationFailed)
+	require.ErrorIs(t, err, errReferenceMismatch)
+}
+
+func TestVerify_keyless(t *testing.T) {
+	t.Parallel()
+
+	reg := testutil.NewInMemoryRegistry()
+	ctx := context.Background()
+	ref := pushTestImage(t, reg, testRepo+":v1")
+
+	ca := newTestCA(t)
+	rekorKey, _ := newTestKey(t)
+	trustedRoot := newTestTrustedRoot(t, ca, rekorKey)
+
+	signingKey, _ := newTestKey(t)
+	const issuer = "https://token.actions.githubusercontent.com"
+	subject := "https://github.com/package-operator/pkg/.github/workflows/release.yaml@refs/heads/main"
+	certPEM := ca.issue(t, signingKey, issuer, subject)
+
+	payload, err := json.Marshal(newSimpleSigning(ref))
+	require.NoError(t, err)
+	sig, err := signPayload(signingKey, payload)
+	require.NoError(t, err)
+	bundle := newTestBundle(t, rekorKey, payload, sig, certPEM)
+
+	pushTestSignature(t, reg, ref, payload, map[string]string{
+		SignatureAnnotation:   base64.StdEncoding.EncodeToString(sig),
+		CertificateAnnotation: certPEM,
+		BundleAnnotation:      bundle,
+	})
+
+	tests := []struct {
+		name     string
+		identity KeylessIdentity
+		trusted  bool
+	}{
+		{
+			name:     "exact subject",
+			identity: KeylessIdentity{Issuer: issuer, Subject: subject},
+			trusted:  true,
+		},
+		{
+			name:     "subject regexp",
+			identity: KeylessIdentity{Issuer: issuer, SubjectRegExp: "^https://github.com/package-operator/.*$"},
+			trusted:  true,
+		},
+		{
+			name:     "other issuer",
+			identity: KeylessIdentity{Issuer: "https://accounts.google.com", Subject: subject},
+		},
+		{
+			name:     "other subject",
+			identity: KeylessIdentity{Issuer: issuer, SubjectRegExp: "^https://github.com/other/.*$"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := newTestVerifier(t, VerificationPolicy{
+				TrustedRoot: trustedRoot,
+				Policies: []ImagePolicy{{
+					ImagePrefix: testRepo,
+					Keyless:     []KeylessIdentity{test.identity},
+				}},
+			})
+			err := v.Verify(ctx, ref, ref, reg.CraneOpt)
+			if test.trusted {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrVerificationFailed)
+		})
+	}
+
+	// Untrusted transparency log.
+	otherRekorKey, _ := newTestKey(t)
+	v := newTestVerifier(t, VerificationPolicy{
+		TrustedRoot: newTestTrustedRoot(t, ca, otherRekorKey),
+		Policies: []ImagePolicy{{
+			ImagePrefix: testRepo,
+			Keyless:     []KeylessIdentity{{Issuer: issuer, Subject: subject}},
+		}},
+	})
+	require.ErrorIs(t, v.Verify(ctx, ref, ref, reg.CraneOpt), ErrVerificationFailed)
+
+	// Transparency log entry of another payload.
+	otherPayload, err := json.Marshal(newSimpleSigning(ref.Context().Digest("sha256:" + strings.Repeat("a", 64))))
+	require.NoError(t, err)
+	_, err = newSigstoreBundle(payload, sig, map[string]string{
+		CertificateAnnotation: certPEM,
+		BundleAnnotation:      newTestBundle(t, rekorKey, otherPayload, sig, certPEM),
+	})
+	require.ErrorIs(t, err, errInvalidBundle)
+}
+
+func TestNewVerifier_keylessWithoutTrustRoot(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewVerifier(VerificationPolicy{Policies: []ImagePolicy{{
+		ImagePrefix: "quay.io/",
+		Keyless:     []KeylessIdentity{{Issuer: "https://accounts.google.com", Subject: "test@example.com"}},
+	}}})
+	require.ErrorIs(t, err, ErrNoTrustRoot)
+}
+
+func TestLoadSigningKey(t *testing.T) {
+	t.Parallel()
+
+	key, _ := newTestKey(t)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	signer, err := LoadSigningKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	assert.Equal(t, key.Public(), signer.Public())
+
+	_, err = LoadSigningKey(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY"}))
+	require.ErrorIs(t, err, ErrEncryptedSigningKey)
+}
+
+func newTestVerifier(t *testing.T, policy VerificationPolicy) *Verifier {
+	t.Helper()
+
+	v, err := NewVerifier(policy)
+	require.NoError(t, err)
+	return v
+}
+
+func newTestKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func pushTestImage(t *testing.T, reg *testutil.InMemoryRegistry, tag string) name.Digest {
+	t.Helper()
+
+	image := testutil.BuildImage(t, map[string][]byte{"package/manifest.yaml": []byte("test")})
+	require.NoError(t, crane.Push(image, tag, reg.CraneOpt))
+	digest, err := image.Digest()
+	require.NoError(t, err)
+	ref, err := name.NewTag(tag)
+	require.NoError(t, err)
+	return ref.Context().Digest(digest.String())
+}
+
+func pushTestSignature(
+	t *testing.T, reg *testutil.InMemoryRegistry, ref name.Digest,
+	payload []byte, annotations map[string]string,
+) {
+	t.Helper()
+
+	sigTag := SignatureTag(ref)
+	base, err := signatureBase(sigTag, reg.CraneOpt)
+	require.NoError(t, err)
+	sigImage, err := appendSignatureLayer(base, payload, annotations)
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(sigImage, sigTag.String(), reg.CraneOpt))
+}
+
+func newTestBundle(t *testing.T, rekorKey *ecdsa.PrivateKey, payload, sig []byte, certPEM string) string {
+	t.Helper()
+
+	payloadHash := sha256.Sum256(payload)
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{
+				"hash": map[string]any{"algorithm": "sha256", "value": hex.EncodeToString(payloadHash[:])},
+			},
+			"signature": map[string]any{
+				"content":   base64.StdEncoding.EncodeToString(sig),
+				"publicKey": map[string]any{"content": base64.StdEncoding.EncodeToString([]byte(certPEM))},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	bundle := rekorBundle{Payload: rekorBundlePayload{
+		Body:           base64.StdEncoding.EncodeToString(body),
+		IntegratedTime: time.Now().Unix(),
+		LogID:          hex.EncodeToString(testLogID(t, rekorKey)),
+		LogIndex:       1,
+	}}
+	canonical, err := json.Marshal(bundle.Payload)
+	require.NoError(t, err)
+	bundle.SignedEntryTimestamp, err = signPayload(rekorKey, canonical)
+	require.NoError(t, err)
+
+	bundleJSON, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	return string(bundleJSON)
+}
+
+// Rekor log IDs are the SHA256 of the DER encoded log public key.
+func testLogID(t *testing.T, rekorKey *ecdsa.PrivateKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(rekorKey.Public())
+	require.NoError(t, err)
+	id := sha256.Sum256(der)
+	return id[:]
+}
+
+func newTestTrustedRoot(t *testing.T, ca *testCA, rekorKey *ecdsa.PrivateKey) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(rekorKey.Public())
+	require.NoError(t, err)
+	validFor := &protocommon.TimeRange{Start: timestamppb.New(time.Now().Add(-time.Hour))}
+	trustedRoot, err := protojson.Marshal(&prototrustroot.TrustedRoot{
+		MediaType: root.TrustedRootMediaType01,
+		Tlogs: []*prototrustroot.TransparencyLogInstance{{
+			BaseUrl:       "https://rekor.package-operator.run",
+			HashAlgorithm: protocommon.HashAlgorithm_SHA2_256,
+			PublicKey: &protocommon.PublicKey{
+				RawBytes:   der,
+				KeyDetails: protocommon.PublicKeyDetails_PKIX_ECDSA_P256_SHA_256,
+				ValidFor:   validFor,
+			},
+			LogId: &protocommon.LogId{KeyId: testLogID(t, rekorKey)},
+		}},
+		CertificateAuthorities: []*prototrustroot.CertificateAuthority{{
+			Uri: "https://fulcio.package-operator.run",
+			CertChain: &protocommon.X509CertificateChain{
+				Certificates: []*protocommon.X509Certificate{{RawBytes: ca.cert.Raw}},
+			},
+			ValidFor: validFor,
+		}},
+	})
+	require.NoError(t, err)
+	return string(trustedRoot)
+}
+
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, _ := newTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-fulcio"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{
+		cert: cert, key: key,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func (ca *testCA) issue(t *testing.T, key *ecdsa.PrivateKey, issuer, subject string) string {
+	t.Helper()
+
+	issuerExt, err := asn1.Marshal(issuer)
+	require.NoError(t, err)
+	subjectURI, err := url.Parse(subject)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(10 * time.Minute),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:         []*url.URL{subjectURI},
+		// Fulcio OIDC issuer (v2) extension.
+		ExtraExtensions: []pkix.Extension{{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}, Value: issuerExt}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -0,0 +1,96 @@
+package packagestructure
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// Path the output of the kustomize build is stored at.
+// Distinct from the kustomization file names, to not confuse build input and output.
+const kustomizeOutputPath = "kustomize-build-output.yaml"
+
+const kustomizeRoot = "/"
+
+// Runs an in-process kustomize build, if a kustomization is found in the root of the given files.
+// All files read by kustomize are replaced by the build output, other files are left as is.
+func buildKustomization(files packagetypes.Files) error {
+	var kustomizationPath string
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if _, ok := files[name]; ok {
+			kustomizationPath = name
+			break
+		}
+	}
+	if len(kustomizationPath) == 0 {
+		return nil
+	}
+
+	fSys := &readRecordingFS{
+		FileSystem: filesys.MakeFsInMemory(),
+		read:       map[string]struct{}{},
+	}
+	for path, content := range files {
+		path = filepath.Join(kustomizeRoot, path)
+		if err := fSys.MkdirAll(filepath.Dir(path)); err != nil {
+			return err
+		}
+		if err := fSys.WriteFile(path, content); err != nil {
+			return err
+		}
+	}
+
+	// Default options disable plugins and Helm chart inflation.
+	k := krusty.MakeKustomizer(krusty.MakeDefaultOptions())
+	resMap, err := k.Run(fSys, kustomizeRoot)
+	if err != nil {
+		return packagetypes.ViolationError{
+			Reason:  packagetypes.ViolationReasonKustomizeBuild,
+			Details: err.Error(),
+			Path:    kustomizationPath,
+		}
+	}
+	out, err := resMap.AsYaml()
+	if err != nil {
+		return packagetypes.ViolationError{
+			Reason:  packagetypes.ViolationReasonKustomizeBuild,
+			Details: err.Error(),
+			Path:    kustomizationPath,
+		}
+	}
+
+	for path := range fSys.read {
+		relPath, err := filepath.Rel(kustomizeRoot, path)
+		if err != nil {
+			return err
+		}
+		delete(files, relPath)
+	}
+	if _, ok := files[kustomizeOutputPath]; ok {
+		return packagetypes.ViolationError{
+			Reason:  packagetypes.ViolationReasonKustomizeBuild,
+			Details: "build output path is already used by another file",
+			Path:    kustomizeOutputPath,
+		}
+	}
+	files[kustomizeOutputPath] = out
+	return nil
+}
+
+// readRecordingFS remembers all files read from it.
+type readRecordingFS struct {
+	filesys.FileSystem
+	read map[string]struct{}
+}
+
+func (fs *readRecordingFS) ReadFile(path string) ([]byte, error) {
+	content, err := fs.FileSystem.ReadFile(path)
+	if err == nil {
+		fs.read[filepath.Clean(path)] = struct{}{}
+	}
+	return content, err
+}
@@ -104,6 +104,9 @@ func (l *StructuralLoader) load(
 	delete(pkg.Files, packagetypes.PackageManifestLockFilename+"."+"yaml")
 
 	if pkg.Manifest.Spec.Components == nil {
+		if err := buildKustomization(pkg.Files); err != nil {
+			return nil, err
+		}
 		return pkg, nil
 	}
 
@@ -154,6 +157,10 @@ func (l *StructuralLoader) load(
 		delete(pkg.Files, path)
 	}
 
+	if err := buildKustomization(pkg.Files); err != nil {
+		return nil, err
+	}
+
 	for componentName, files := range componentFiles {
 		subPkg, err := l.load(ctx, files, componentName)
 		if err != nil {
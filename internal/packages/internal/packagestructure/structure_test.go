@@ -102,6 +102,53 @@ func TestStructuralLoader_Load(t *testing.T) {
 		assert.Empty(t, pkg.Components)
 	})
 
+	t.Run("kustomize", func(t *testing.T) {
+		t.Parallel()
+		ctx := logr.NewContext(context.Background(), testr.New(t))
+		rawPkg, err := packageimport.FromFolder(ctx, "testdata/kustomize")
+		require.NoError(t, err)
+
+		pkg, err := sl.Load(ctx, rawPkg)
+		require.NoError(t, err)
+
+		// Kustomize inputs are replaced by the build output.
+		if assert.Len(t, pkg.Files, 2) {
+			assert.NotEmpty(t, pkg.Files["configmap.yaml"])
+			out := string(pkg.Files["kustomize-build-output.yaml"])
+			assert.Contains(t, out, "name: prod-app")
+			assert.Contains(t, out, "replicas: 3")
+			assert.Contains(t, out, "package-operator.run/phase: deploy")
+		}
+	})
+
+	t.Run("kustomize-invalid", func(t *testing.T) {
+		t.Parallel()
+		ctx := logr.NewContext(context.Background(), testr.New(t))
+		rawPkg, err := packageimport.FromFolder(ctx, "testdata/kustomize")
+		require.NoError(t, err)
+		rawPkg.Files["kustomization.yaml"] = []byte("resources:\n- missing.yaml\n")
+
+		_, err = sl.Load(ctx, rawPkg)
+		var verr packagetypes.ViolationError
+		require.ErrorAs(t, err, &verr)
+		assert.Equal(t, packagetypes.ViolationReasonKustomizeBuild, verr.Reason)
+		assert.Equal(t, "kustomization.yaml", verr.Path)
+	})
+
+	t.Run("kustomize-output-collision", func(t *testing.T) {
+		t.Parallel()
+		ctx := logr.NewContext(context.Background(), testr.New(t))
+		rawPkg, err := packageimport.FromFolder(ctx, "testdata/kustomize")
+		require.NoError(t, err)
+		rawPkg.Files["kustomize-build-output.yaml"] = []byte("apiVersion: v1\nkind: ConfigMap\n")
+
+		_, err = sl.Load(ctx, rawPkg)
+		var verr packagetypes.ViolationError
+		require.ErrorAs(t, err, &verr)
+		assert.Equal(t, packagetypes.ViolationReasonKustomizeBuild, verr.Reason)
+		assert.Equal(t, "kustomize-build-output.yaml", verr.Path)
+	})
+
 	t.Run("components-disabled", func(t *testing.T) {
 		t.Parallel()
 		ctx := logr.NewContext(context.Background(), testr.New(t))
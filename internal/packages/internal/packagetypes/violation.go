@@ -65,6 +65,9 @@ const (
 	ViolationReasonInvalidYAML                   ViolationReason = "Invalid YAML"
 	ViolationReasonMissingPhaseAnnotation        ViolationReason = "Missing " + manifests.PackagePhaseAnnotation + " Annotation" //nolint: lll
 	ViolationReasonPhaseNotFound                 ViolationReason = "Phase name not found in manifest"                            //nolint: lll
+	ViolationReasonInvalidWaveAnnotation         ViolationReason = "Invalid " + manifests.PackageWaveAnnotation + " Annotation"  //nolint: lll
+	ViolationReasonInvalidHookAnnotation         ViolationReason = "Invalid hook annotation"
+	ViolationReasonInvalidDeletionPolicy         ViolationReason = "Invalid " + manifests.PackageDeletionPolicyAnnotation + " Annotation" //nolint: lll
 	ViolationReasonMissingGVK                    ViolationReason = "GroupVersionKind not set"
 	ViolationReasonDuplicateObject               ViolationReason = "Duplicate Object"
 	ViolationReasonLabelsInvalid                 ViolationReason = "Labels invalid"
@@ -77,10 +80,13 @@ const (
 	ViolationReasonNestedMultiComponentPkg       ViolationReason = "Nesting multi-component packages not allowed"
 	ViolationReasonInvalidFileInComponentsDir    ViolationReason = "The components directory may only contain folders and dot files" //nolint: lll
 	ViolationReasonKubeconform                   ViolationReason = "Kubeconform rejected schema"
+	ViolationReasonKustomizeBuild                ViolationReason = "Kustomize build failed"
 	ViolationReasonLockfileMissing               ViolationReason = "Missing image in manifest.lock.yaml, but using PackageManifest.spec.images. Try running: kubectl package update" //nolint: lll
 	ViolationReasonImageMissingInLockfile        ViolationReason = "Image specified in manifest but missing from lockfile. Try running: kubectl package update"                      //nolint: lll
 	ViolationReasonImageDifferentToLockfile      ViolationReason = "Image specified in manifest does not match with lockfile. Try running: kubectl package update"                   //nolint: lll
 	ViolationReasonInvalidCELExpression          ViolationReason = "The CEL expression in " + manifests.PackageCELConditionAnnotation + " annotation is invalid."                    //nolint: lll
+	ViolationReasonTestAssertionFailed           ViolationReason = "Test assertion failed"
+	ViolationReasonExpectedErrorMissing          ViolationReason = "Expected error not reported"
 )
 
 var ErrEmptyPackage = ViolationError{
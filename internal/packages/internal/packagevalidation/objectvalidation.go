@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/validation"
@@ -11,6 +14,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/packages/internal/packagetypes"
 )
@@ -19,6 +23,8 @@ import (
 var DefaultObjectValidators = ObjectValidatorList{
 	&ObjectDuplicateValidator{}, &ObjectGVKValidator{},
 	&ObjectLabelsValidator{}, &ObjectPhaseAnnotationValidator{},
+	&ObjectWaveAnnotationValidator{}, &ObjectHookAnnotationValidator{},
+	&ObjectDeletionPolicyAnnotationValidator{},
 }
 
 // ObjectValidatorList runs a list of validators and joins all errors.
@@ -62,7 +68,7 @@ func ValidateEachObject(
 	return errors.Join(errs...)
 }
 
-// Validates that the PKO phase-annotation is set on all objects.
+// Validates that the PKO phase-annotation is set on all objects, except lifecycle hooks.
 type ObjectPhaseAnnotationValidator struct{}
 
 var _ packagetypes.ObjectValidator = (*ObjectPhaseAnnotationValidator)(nil)
@@ -79,6 +85,10 @@ func (*ObjectPhaseAnnotationValidator) validate(
 	_ context.Context, path string, index int,
 	obj unstructured.Unstructured, manifest *manifests.PackageManifest,
 ) error {
+	if _, isHook := obj.GetAnnotations()[manifests.PackageHookAnnotation]; isHook {
+		// Hooks run outside of phases.
+		return nil
+	}
 	if obj.GetAnnotations() == nil ||
 		len(obj.GetAnnotations()[manifests.PackagePhaseAnnotation]) == 0 {
 		return packagetypes.ViolationError{
@@ -99,6 +109,140 @@ func (*ObjectPhaseAnnotationValidator) validate(
 	}
 }
 
+// Validates that the PKO wave-annotation is an integer, if set.
+type ObjectWaveAnnotationValidator struct{}
+
+var _ packagetypes.ObjectValidator = (*ObjectWaveAnnotationValidator)(nil)
+
+func (v *ObjectWaveAnnotationValidator) ValidateObjects(
+	ctx context.Context,
+	manifest *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) error {
+	return ValidateEachObject(ctx, manifest, objects, v.validate)
+}
+
+func (*ObjectWaveAnnotationValidator) validate(
+	_ context.Context, path string, index int,
+	obj unstructured.Unstructured, _ *manifests.PackageManifest,
+) error {
+	wave, ok := obj.GetAnnotations()[manifests.PackageWaveAnnotation]
+	if !ok {
+		return nil
+	}
+	if _, err := strconv.ParseInt(wave, 10, 32); err != nil {
+		return packagetypes.ViolationError{
+			Reason:  packagetypes.ViolationReasonInvalidWaveAnnotation,
+			Details: err.Error(),
+			Path:    path,
+			Index:   ptr.To(index),
+		}
+	}
+	return nil
+}
+
+// Validates the values of the PKO hook-annotations, if set.
+type ObjectHookAnnotationValidator struct{}
+
+var _ packagetypes.ObjectValidator = (*ObjectHookAnnotationValidator)(nil)
+
+var hookAnnotationValues = map[string][]string{
+	manifests.PackageHookAnnotation: {
+		string(corev1alpha1.ObjectSetHookTypePreInstall),
+		string(corev1alpha1.ObjectSetHookTypePreUpgrade),
+		string(corev1alpha1.ObjectSetHookTypePreDelete),
+	},
+	manifests.PackageHookRetentionPolicyAnnotation: {
+		string(corev1alpha1.ObjectSetHookRetentionPolicyRetain),
+		string(corev1alpha1.ObjectSetHookRetentionPolicyDeleteOnSuccess),
+		string(corev1alpha1.ObjectSetHookRetentionPolicyDelete),
+	},
+	manifests.PackageHookFailurePolicyAnnotation: {
+		string(corev1alpha1.ObjectSetHookFailurePolicyAbort),
+		string(corev1alpha1.ObjectSetHookFailurePolicyIgnore),
+	},
+}
+
+func (v *ObjectHookAnnotationValidator) ValidateObjects(
+	ctx context.Context,
+	manifest *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) error {
+	return ValidateEachObject(ctx, manifest, objects, v.validate)
+}
+
+func (*ObjectHookAnnotationValidator) validate(
+	_ context.Context, path string, index int,
+	obj unstructured.Unstructured, _ *manifests.PackageManifest,
+) error {
+	annotations := obj.GetAnnotations()
+	_, isHook := annotations[manifests.PackageHookAnnotation]
+
+	var errs []error
+	for _, annotation := range []string{
+		manifests.PackageHookAnnotation,
+		manifests.PackageHookRetentionPolicyAnnotation,
+		manifests.PackageHookFailurePolicyAnnotation,
+	} {
+		value, ok := annotations[annotation]
+		switch {
+		case !ok:
+			continue
+		case !isHook:
+			errs = append(errs, packagetypes.ViolationError{
+				Reason:  packagetypes.ViolationReasonInvalidHookAnnotation,
+				Details: fmt.Sprintf("%s requires %s to be set", annotation, manifests.PackageHookAnnotation),
+				Path:    path,
+				Index:   ptr.To(index),
+			})
+		case !slices.Contains(hookAnnotationValues[annotation], value):
+			errs = append(errs, packagetypes.ViolationError{
+				Reason: packagetypes.ViolationReasonInvalidHookAnnotation,
+				Details: fmt.Sprintf("%s: unsupported value %q, must be one of %s",
+					annotation, value, strings.Join(hookAnnotationValues[annotation], ", ")),
+				Path:  path,
+				Index: ptr.To(index),
+			})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validates the value of the PKO deletion-policy-annotation, if set.
+type ObjectDeletionPolicyAnnotationValidator struct{}
+
+var _ packagetypes.ObjectValidator = (*ObjectDeletionPolicyAnnotationValidator)(nil)
+
+func (v *ObjectDeletionPolicyAnnotationValidator) ValidateObjects(
+	ctx context.Context,
+	manifest *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) error {
+	return ValidateEachObject(ctx, manifest, objects, v.validate)
+}
+
+func (*ObjectDeletionPolicyAnnotationValidator) validate(
+	_ context.Context, path string, index int,
+	obj unstructured.Unstructured, _ *manifests.PackageManifest,
+) error {
+	policy, ok := obj.GetAnnotations()[manifests.PackageDeletionPolicyAnnotation]
+	if !ok {
+		return nil
+	}
+	switch corev1alpha1.DeletionPolicy(policy) {
+	case corev1alpha1.DeletionPolicyDelete,
+		corev1alpha1.DeletionPolicyOrphan,
+		corev1alpha1.DeletionPolicyRetain:
+		return nil
+	}
+	return packagetypes.ViolationError{
+		Reason:  packagetypes.ViolationReasonInvalidDeletionPolicy,
+		Details: fmt.Sprintf("unsupported value %q, must be one of Delete, Orphan, Retain", policy),
+		Path:    path,
+		Index:   ptr.To(index),
+	}
+}
+
 // Validates that Objects with the same name/namespace/kind/group must only exist once over all phases.
 // APIVersion does not matter for the check.
 type ObjectDuplicateValidator struct{}
@@ -26,6 +26,11 @@ func TestObjectPhaseAnnotationValidator(t *testing.T) {
 		manifests.PackagePhaseAnnotation: "deploy",
 	})
 
+	hookObj := unstructured.Unstructured{}
+	hookObj.SetAnnotations(map[string]string{
+		manifests.PackageHookAnnotation: "PreInstall",
+	})
+
 	ctx := context.Background()
 	manifest := &manifests.PackageManifest{
 		Spec: manifests.PackageManifestSpec{
@@ -35,13 +40,97 @@ func TestObjectPhaseAnnotationValidator(t *testing.T) {
 	err := opav.ValidateObjects(
 		ctx, manifest,
 		map[string][]unstructured.Unstructured{
-			"test.yaml": {{}, failObj, okObj},
+			"test.yaml": {{}, failObj, okObj, hookObj},
 		})
 
 	require.EqualError(t, err, `Missing package-operator.run/phase Annotation in test.yaml idx 0
 Phase name not found in manifest in test.yaml idx 1`)
 }
 
+func TestObjectWaveAnnotationValidator(t *testing.T) {
+	t.Parallel()
+
+	owav := &ObjectWaveAnnotationValidator{}
+
+	failObj := unstructured.Unstructured{}
+	failObj.SetAnnotations(map[string]string{
+		manifests.PackageWaveAnnotation: "first",
+	})
+
+	okObj := unstructured.Unstructured{}
+	okObj.SetAnnotations(map[string]string{
+		manifests.PackageWaveAnnotation: "-2",
+	})
+
+	err := owav.ValidateObjects(
+		context.Background(), &manifests.PackageManifest{},
+		map[string][]unstructured.Unstructured{
+			"test.yaml": {{}, failObj, okObj},
+		})
+
+	require.EqualError(t, err, `Invalid package-operator.run/wave Annotation in test.yaml idx 1: `+
+		`strconv.ParseInt: parsing "first": invalid syntax`)
+}
+
+func TestObjectHookAnnotationValidator(t *testing.T) {
+	t.Parallel()
+
+	ohav := &ObjectHookAnnotationValidator{}
+
+	okObj := unstructured.Unstructured{}
+	okObj.SetAnnotations(map[string]string{
+		manifests.PackageHookAnnotation:                "PreDelete",
+		manifests.PackageHookRetentionPolicyAnnotation: "Retain",
+		manifests.PackageHookFailurePolicyAnnotation:   "Ignore",
+	})
+
+	invalidObj := unstructured.Unstructured{}
+	invalidObj.SetAnnotations(map[string]string{
+		manifests.PackageHookAnnotation: "PostInstall",
+	})
+
+	noHookObj := unstructured.Unstructured{}
+	noHookObj.SetAnnotations(map[string]string{
+		manifests.PackageHookFailurePolicyAnnotation: "Abort",
+	})
+
+	err := ohav.ValidateObjects(
+		context.Background(), &manifests.PackageManifest{},
+		map[string][]unstructured.Unstructured{
+			"test.yaml": {{}, okObj, invalidObj, noHookObj},
+		})
+
+	require.EqualError(t, err, `Invalid hook annotation in test.yaml idx 2: `+
+		`package-operator.run/hook: unsupported value "PostInstall", must be one of PreInstall, PreUpgrade, PreDelete
+Invalid hook annotation in test.yaml idx 3: `+
+		`package-operator.run/hook-failure-policy requires package-operator.run/hook to be set`)
+}
+
+func TestObjectDeletionPolicyAnnotationValidator(t *testing.T) {
+	t.Parallel()
+
+	odpv := &ObjectDeletionPolicyAnnotationValidator{}
+
+	okObj := unstructured.Unstructured{}
+	okObj.SetAnnotations(map[string]string{
+		manifests.PackageDeletionPolicyAnnotation: "Retain",
+	})
+
+	failObj := unstructured.Unstructured{}
+	failObj.SetAnnotations(map[string]string{
+		manifests.PackageDeletionPolicyAnnotation: "Keep",
+	})
+
+	err := odpv.ValidateObjects(
+		context.Background(), &manifests.PackageManifest{},
+		map[string][]unstructured.Unstructured{
+			"test.yaml": {{}, okObj, failObj},
+		})
+
+	require.EqualError(t, err, `Invalid package-operator.run/deletion-policy Annotation in test.yaml idx 2: `+
+		`unsupported value "Keep", must be one of Delete, Orphan, Retain`)
+}
+
 func TestObjectDuplicateValidator(t *testing.T) {
 	t.Parallel()
 
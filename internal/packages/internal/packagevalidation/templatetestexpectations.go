@@ -0,0 +1,178 @@
+package packagevalidation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// Checks that every expected error of the test case matches at least one error in err.
+func checkExpectedErrors(testCase manifests.PackageManifestTestCaseTemplate, err error) error {
+	leafs := flattenErrors(err)
+
+	var violations []error
+	for _, expected := range testCase.ExpectErrors {
+		found := false
+		for _, leaf := range leafs {
+			if expectedErrorMatches(expected, leaf) {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		details := fmt.Sprintf("Testcase %q expected error %s", testCase.Name, describeExpectedError(expected))
+		if err != nil {
+			details += ", got:\n" + err.Error()
+		} else {
+			details += ", but rendering succeeded"
+		}
+		violations = append(violations, packagetypes.ViolationError{
+			Reason:  packagetypes.ViolationReasonExpectedErrorMissing,
+			Details: details,
+		})
+	}
+	return errors.Join(violations...)
+}
+
+func expectedErrorMatches(expected manifests.PackageManifestTestCaseExpectedError, err error) bool {
+	if len(expected.Reason) > 0 {
+		var verr packagetypes.ViolationError
+		if !errors.As(err, &verr) || string(verr.Reason) != expected.Reason {
+			return false
+		}
+	}
+	if len(expected.Field) > 0 {
+		var ferr *field.Error
+		if !errors.As(err, &ferr) || ferr.Field != expected.Field {
+			return false
+		}
+	}
+	if len(expected.Message) > 0 && !strings.Contains(err.Error(), expected.Message) {
+		return false
+	}
+	return true
+}
+
+func describeExpectedError(expected manifests.PackageManifestTestCaseExpectedError) string {
+	var parts []string
+	if len(expected.Reason) > 0 {
+		parts = append(parts, fmt.Sprintf("reason=%q", expected.Reason))
+	}
+	if len(expected.Field) > 0 {
+		parts = append(parts, fmt.Sprintf("field=%q", expected.Field))
+	}
+	if len(expected.Message) > 0 {
+		parts = append(parts, fmt.Sprintf("message=%q", expected.Message))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// Returns err and all errors joined or aggregated into it.
+func flattenErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	out := []error{err}
+	var children []error
+	switch e := err.(type) { //nolint:errorlint
+	case interface{ Unwrap() []error }:
+		children = e.Unwrap()
+	case utilerrors.Aggregate:
+		children = e.Errors()
+	default:
+		if unwrapped := errors.Unwrap(err); unwrapped != nil {
+			children = []error{unwrapped}
+		}
+	}
+	for _, child := range children {
+		out = append(out, flattenErrors(child)...)
+	}
+	return out
+}
+
+// Evaluates the CEL assertions of the test case against the rendered objects.
+// Failing assertions are returned as violations, the error is only set for invalid expressions.
+func runAssertions(
+	testCase manifests.PackageManifestTestCaseTemplate,
+	configuration map[string]any,
+	pathObjects map[string][]unstructured.Unstructured,
+) ([]error, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("objects", cel.ListType(cel.DynType)),
+		cel.Variable("config", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stable object order across runs.
+	paths := make([]string, 0, len(pathObjects))
+	for path := range pathObjects {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	objects := []any{}
+	for _, path := range paths {
+		for _, obj := range pathObjects[path] {
+			objects = append(objects, obj.Object)
+		}
+	}
+	vars := map[string]any{
+		"objects": objects,
+		"config":  configuration,
+	}
+
+	var violations []error
+	for _, assertion := range testCase.Assertions {
+		ok, err := evaluateAssertion(env, assertion.Expression, vars)
+		if err != nil {
+			return nil, packagetypes.ViolationError{
+				Reason:  packagetypes.ViolationReasonTestAssertionFailed,
+				Details: fmt.Sprintf("Testcase %q assertion %q: %s", testCase.Name, assertion.Name, err.Error()),
+			}
+		}
+		if !ok {
+			violations = append(violations, packagetypes.ViolationError{
+				Reason: packagetypes.ViolationReasonTestAssertionFailed,
+				Details: fmt.Sprintf("Testcase %q assertion %q evaluated to false: %s",
+					testCase.Name, assertion.Name, assertion.Expression),
+			})
+		}
+	}
+	return violations, nil
+}
+
+var errAssertionNotBool = errors.New("assertion must evaluate to a bool")
+
+func evaluateAssertion(env *cel.Env, expr string, vars map[string]any) (bool, error) {
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return false, issues.Err()
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%w, got %s", errAssertionNotBool, out.Type())
+	}
+	return result, nil
+}
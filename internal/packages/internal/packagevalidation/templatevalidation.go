@@ -9,8 +9,11 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/packages/internal/packageimport"
@@ -25,15 +28,138 @@ const testFixturesFolderName = ".test-fixtures"
 type TemplateTestValidator struct {
 	// Path to a folder containing the test fixtures for the package.
 	packageBaseFolderPath string
+	cfg                   TemplateTestValidatorConfig
+}
+
+// TemplateTestValidatorConfig holds settings for the TemplateTestValidator.
+type TemplateTestValidatorConfig struct {
+	// Regenerates the fixtures of all test cases instead of comparing against them.
+	UpdateFixtures bool
+}
+
+// Option applies the given options to the config.
+func (c *TemplateTestValidatorConfig) Option(opts ...TemplateTestValidatorOption) {
+	for _, opt := range opts {
+		opt.ConfigureTemplateTestValidator(c)
+	}
+}
+
+// TemplateTestValidatorOption configures a TemplateTestValidator.
+type TemplateTestValidatorOption interface {
+	ConfigureTemplateTestValidator(c *TemplateTestValidatorConfig)
+}
+
+// WithUpdateFixtures regenerates existing test fixtures from the rendered templates.
+type WithUpdateFixtures bool
+
+func (w WithUpdateFixtures) ConfigureTemplateTestValidator(c *TemplateTestValidatorConfig) {
+	c.UpdateFixtures = bool(w)
 }
 
 // Creates a new TemplateTestValidator instance.
 func NewTemplateTestValidator(
-	packageBaseFolderPath string,
+	packageBaseFolderPath string, opts ...TemplateTestValidatorOption,
 ) *TemplateTestValidator {
-	return &TemplateTestValidator{
+	v := &TemplateTestValidator{
 		packageBaseFolderPath: packageBaseFolderPath,
 	}
+	v.cfg.Option(opts...)
+	return v
+}
+
+// TemplateTestKind is the kind of check a TemplateTestResult was produced by.
+type TemplateTestKind string
+
+const (
+	// Test case from .test.template of the PackageManifest.
+	TemplateTestKindTemplate TemplateTestKind = "Template"
+	// Kubeconform check of a package file.
+	TemplateTestKindKubeconform TemplateTestKind = "Kubeconform"
+)
+
+// TemplateTestResult is the outcome of a single template test case or kubeconform check.
+type TemplateTestResult struct {
+	Kind TemplateTestKind
+	// Name of the test case or path of the checked file.
+	Name string
+	// Name of the component, empty for the root package.
+	Component string
+	Duration  time.Duration
+	// Failure reported by the check, nil if it passed.
+	Err error
+}
+
+// RunTests runs all template test cases and kubeconform checks of the package and its components.
+// In contrast to ValidatePackage, failing checks do not stop the run but are reported in the results.
+func (v TemplateTestValidator) RunTests(
+	ctx context.Context, pkg *packagetypes.Package,
+) ([]TemplateTestResult, error) {
+	var results []TemplateTestResult
+	err := packagetypes.ValidateEachComponent(ctx, pkg,
+		func(ctx context.Context, pkg *packagetypes.Package, isComponent bool) error {
+			componentResults, err := v.runTests(ctx, pkg, isComponent)
+			results = append(results, componentResults...)
+			return err
+		})
+	return results, err
+}
+
+func (v TemplateTestValidator) runTests(
+	ctx context.Context, pkg *packagetypes.Package, isComponent bool,
+) ([]TemplateTestResult, error) {
+	log := logr.FromContextOrDiscard(ctx).V(1)
+
+	kcV, err := kubeconformValidatorFromManifest(pkg.Manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var subDir, component string
+	if isComponent {
+		component = pkg.Manifest.Name
+		subDir = filepath.Join("components", component)
+	}
+
+	results := make([]TemplateTestResult, 0, len(pkg.Manifest.Test.Template))
+	for _, templateTestCase := range pkg.Manifest.Test.Template {
+		log.Info("running template test case", "name", templateTestCase.Name)
+		start := time.Now()
+		err := v.runTestCase(ctx, pkg, templateTestCase, kcV, subDir)
+		results = append(results, TemplateTestResult{
+			Kind:      TemplateTestKindTemplate,
+			Name:      templateTestCase.Name,
+			Component: component,
+			Duration:  time.Since(start),
+			Err:       err,
+		})
+	}
+
+	if pkg.Manifest.Test.Kubeconform == nil {
+		return results, nil
+	}
+
+	paths := make([]string, 0, len(pkg.Files))
+	for path := range pkg.Files {
+		if packagetypes.IsYAMLFile(path) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		start := time.Now()
+		verrs, err := runKubeconformForFile(path, pkg.Files[path], kcV)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, TemplateTestResult{
+			Kind:      TemplateTestKindKubeconform,
+			Name:      path,
+			Component: component,
+			Duration:  time.Since(start),
+			Err:       errors.Join(verrs...),
+		})
+	}
+	return results, nil
 }
 
 func (v TemplateTestValidator) ValidatePackage(
@@ -84,42 +210,45 @@ func (v TemplateTestValidator) runTestCase(
 	log := logr.FromContextOrDiscard(ctx)
 	pkg = pkg.DeepCopy()
 
-	configuration := map[string]any{}
-	if testCase.Context.Config != nil {
-		if err := json.Unmarshal(testCase.Context.Config.Raw, &configuration); err != nil {
-			return err
-		}
+	configuration, pathObjects, pathFilteredIndex, err := renderTestCase(ctx, pkg, testCase)
+	if len(testCase.ExpectErrors) > 0 {
+		return checkExpectedErrors(testCase, err)
 	}
-
-	if _, err := packagemanifestvalidation.AdmitPackageConfiguration(ctx, configuration, pkg.Manifest, nil); err != nil {
+	if err != nil {
 		return err
 	}
 
-	tmplCtx := packagetypes.PackageRenderContext{
-		Package:     testCase.Context.Package,
-		Config:      configuration,
-		Images:      generateStaticImages(pkg.Manifest),
-		Environment: testCase.Context.Environment,
-	}
-	if err := packagerender.RenderTemplates(ctx, pkg, tmplCtx); err != nil {
-		return err
-	}
-	pathObjects, pathFilteredIndex, err := packagerender.RenderObjectsWithFilterInfo(
-		ctx, pkg, tmplCtx, DefaultObjectValidators)
-	if err != nil {
-		return err
+	// Assertions complement fixtures, both are checked.
+	var assertionErrs []error
+	if len(testCase.Assertions) > 0 {
+		if assertionErrs, err = runAssertions(testCase, configuration, pathObjects); err != nil {
+			return err
+		}
 	}
 
 	// check if test figures exist
 	testFixturePath := filepath.Join(
 		v.packageBaseFolderPath, subDir,
 		testFixturesFolderName, testCase.Name)
+	if v.cfg.UpdateFixtures {
+		log.Info("updating fixtures for test case", "name", testCase.Name)
+		if err := os.RemoveAll(testFixturePath); err != nil {
+			return err
+		}
+		if err := renderTemplateFiles(testFixturePath, pkg.Files, pathFilteredIndex); err != nil {
+			return err
+		}
+		return errors.Join(assertionErrs...)
+	}
 	_, err = os.Stat(testFixturePath)
 	if errors.Is(err, os.ErrNotExist) {
 		// no fixtures generated
 		// generate fixtures now
 		log.Info("no fixture found for test case, generating...", "name", testCase.Name)
-		return renderTemplateFiles(testFixturePath, pkg.Files, pathFilteredIndex)
+		if err := renderTemplateFiles(testFixturePath, pkg.Files, pathFilteredIndex); err != nil {
+			return err
+		}
+		return errors.Join(assertionErrs...)
 	}
 
 	actualPath, err := os.MkdirTemp(os.TempDir(), "pko-test-"+testCase.Name+"-")
@@ -136,7 +265,8 @@ func (v TemplateTestValidator) runTestCase(
 		return err
 	}
 
-	violations := make([]error, 0, len(pkg.Files))
+	violations := make([]error, 0, len(pkg.Files)+len(assertionErrs))
+	violations = append(violations, assertionErrs...)
 
 	// check for unknown files
 	fixturesFiles, err := packageimport.Index(testFixturePath)
@@ -183,6 +313,49 @@ func (v TemplateTestValidator) runTestCase(
 	return errors.Join(violations...)
 }
 
+// Validates the configuration of the test case and renders the package with it.
+// Configuration validation errors are returned as error.
+func renderTestCase(
+	ctx context.Context, pkg *packagetypes.Package,
+	testCase manifests.PackageManifestTestCaseTemplate,
+) (
+	configuration map[string]any,
+	pathObjects map[string][]unstructured.Unstructured,
+	pathFilteredIndex map[string][]int,
+	err error,
+) {
+	configuration = map[string]any{}
+	if testCase.Context.Config != nil {
+		if err := json.Unmarshal(testCase.Context.Config.Raw, &configuration); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	ferrs, err := packagemanifestvalidation.AdmitPackageConfiguration(ctx, configuration, pkg.Manifest, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(ferrs) > 0 {
+		return nil, nil, nil, ferrs.ToAggregate()
+	}
+
+	tmplCtx := packagetypes.PackageRenderContext{
+		Package:     testCase.Context.Package,
+		Config:      configuration,
+		Images:      generateStaticImages(pkg.Manifest),
+		Environment: testCase.Context.Environment,
+	}
+	if err := packagerender.RenderTemplates(ctx, pkg, tmplCtx); err != nil {
+		return nil, nil, nil, err
+	}
+	pathObjects, pathFilteredIndex, err = packagerender.RenderObjectsWithFilterInfo(
+		ctx, pkg, tmplCtx, DefaultObjectValidators)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return configuration, pathObjects, pathFilteredIndex, nil
+}
+
 func renderTemplateFiles(
 	folder string,
 	fileMap packagetypes.Files,
@@ -10,7 +10,9 @@ import (
 	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/packages/internal/packagetypes"
@@ -236,3 +238,197 @@ func Test_renderTemplateFiles(t *testing.T) {
 	_, err = os.ReadFile(filepath.Join(path, "test3.yaml"))
 	require.True(t, os.IsNotExist(err), "test3.yaml does not exist")
 }
+
+func TestTemplateTestValidator_expectErrors(t *testing.T) {
+	t.Parallel()
+	validatorPath := t.TempDir()
+
+	packageManifest := &manifests.PackageManifest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-pkg",
+		},
+		Spec: manifests.PackageManifestSpec{
+			Phases: []manifests.PackageManifestPhase{
+				{Name: "tesxx"},
+			},
+			Config: manifests.PackageManifestSpecConfig{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type:     "object",
+					Required: []string{"replicas"},
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"replicas": {Type: "integer"},
+					},
+				},
+			},
+		},
+		Test: manifests.PackageManifestTest{
+			Template: []manifests.PackageManifestTestCaseTemplate{
+				{
+					Name: "missing-replicas",
+					ExpectErrors: []manifests.PackageManifestTestCaseExpectedError{
+						{Field: "replicas", Message: "Required value"},
+					},
+				},
+			},
+		},
+	}
+
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+	ttv := NewTemplateTestValidator(validatorPath)
+
+	pkg := &packagetypes.Package{
+		Manifest: packageManifest,
+		Files: packagetypes.Files{
+			"file.yaml.gotmpl": []byte(testFile1Content),
+		},
+	}
+	require.NoError(t, ttv.ValidatePackage(ctx, pkg))
+	_, err := os.Stat(filepath.Join(validatorPath, testFixturesFolderName, "missing-replicas"))
+	assert.True(t, os.IsNotExist(err), "no fixtures for test cases expecting errors")
+
+	pkg.Manifest.Test.Template[0].Context.Config = &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}
+	err = ttv.ValidatePackage(ctx, pkg)
+	require.EqualError(t, err, `Expected error not reported: Testcase "missing-replicas" expected error `+
+		`{field="replicas", message="Required value"}, but rendering succeeded`)
+}
+
+func TestTemplateTestValidator_assertions(t *testing.T) {
+	t.Parallel()
+	validatorPath := t.TempDir()
+
+	packageManifest := &manifests.PackageManifest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-pkg",
+		},
+		Spec: manifests.PackageManifestSpec{
+			Phases: []manifests.PackageManifestPhase{
+				{Name: "tesxx"},
+			},
+		},
+		Test: manifests.PackageManifestTest{
+			Template: []manifests.PackageManifestTestCaseTemplate{
+				{
+					Name: "t1",
+					Context: manifests.TemplateContext{
+						Package: manifests.TemplateContextPackage{
+							TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{
+								Name: "pkg-name",
+							},
+						},
+					},
+					Assertions: []manifests.PackageManifestTestCaseAssertion{
+						{
+							Name:       "renders",
+							Expression: `objects.exists(o, o.metadata.name == "testfile1" && o.property == "pkg-name")`,
+						},
+						{
+							Name:       "fails",
+							Expression: `size(objects) == 2`,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+	ttv := NewTemplateTestValidator(validatorPath)
+
+	pkg := &packagetypes.Package{
+		Manifest: packageManifest,
+		Files: packagetypes.Files{
+			"file.yaml.gotmpl": []byte(testFile1Content),
+		},
+	}
+	err := ttv.ValidatePackage(ctx, pkg)
+	require.EqualError(t, err,
+		`Test assertion failed: Testcase "t1" assertion "fails" evaluated to false: size(objects) == 2`)
+
+	// Fixtures are generated and compared next to assertions.
+	_, err = os.Stat(filepath.Join(validatorPath, testFixturesFolderName, "t1", "file.yaml"))
+	require.NoError(t, err)
+
+	pkg.Manifest.Test.Template[0].Assertions[1].Expression = `size(objects) == 1`
+	pkg.Files["file.yaml.gotmpl"] = []byte(testFile1UpdatedContent)
+	err = ttv.ValidatePackage(ctx, pkg)
+	require.ErrorContains(t, err, `assertion "renders" evaluated to false`)
+	require.ErrorContains(t, err, string(packagetypes.ViolationReasonFixtureMismatch))
+
+	pkg.Manifest.Test.Template[0].Assertions[1].Expression = `"not a bool"`
+	err = ttv.ValidatePackage(ctx, pkg)
+	require.ErrorContains(t, err, errAssertionNotBool.Error())
+}
+
+func TestTemplateTestValidator_RunTests(t *testing.T) {
+	t.Parallel()
+	validatorPath := t.TempDir()
+
+	templateContext := func(name string) manifests.TemplateContext {
+		return manifests.TemplateContext{
+			Package: manifests.TemplateContextPackage{
+				TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{
+					Name:      name,
+					Namespace: "pkg-namespace",
+				},
+			},
+		}
+	}
+	packageManifest := &manifests.PackageManifest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-pkg",
+		},
+		Spec: manifests.PackageManifestSpec{
+			Phases: []manifests.PackageManifestPhase{
+				{Name: "tesxx"},
+			},
+		},
+		Test: manifests.PackageManifestTest{
+			Template: []manifests.PackageManifestTestCaseTemplate{
+				{Name: "t1", Context: templateContext("pkg-name")},
+				{Name: "t2", Context: templateContext("other-name")},
+			},
+		},
+	}
+
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+	_, err := NewTemplateTestValidator(validatorPath).RunTests(ctx, &packagetypes.Package{
+		Manifest: packageManifest,
+		Files:    packagetypes.Files{"file.yaml.gotmpl": []byte(testFile1Content)},
+	})
+	require.NoError(t, err)
+
+	// Stale files are removed when updating fixtures.
+	staleFile := filepath.Join(validatorPath, testFixturesFolderName, "t1", "banana.yaml")
+	require.NoError(t, os.WriteFile(staleFile, []byte("xxx"), os.ModePerm))
+
+	newPkg := &packagetypes.Package{
+		Manifest: packageManifest,
+		Files:    packagetypes.Files{"file.yaml.gotmpl": []byte(testFile1UpdatedContent)},
+	}
+	results, err := NewTemplateTestValidator(validatorPath).RunTests(ctx, newPkg)
+	require.NoError(t, err)
+	// All test cases run, even though the first one fails.
+	require.Len(t, results, 2)
+	for i, name := range []string{"t1", "t2"} {
+		assert.Equal(t, TemplateTestKindTemplate, results[i].Kind)
+		assert.Equal(t, name, results[i].Name)
+		assert.Empty(t, results[i].Component)
+		assert.Error(t, results[i].Err)
+	}
+
+	results, err = NewTemplateTestValidator(validatorPath, WithUpdateFixtures(true)).RunTests(ctx, newPkg)
+	require.NoError(t, err)
+	for _, r := range results {
+		assert.NoError(t, r.Err)
+	}
+	assert.NoFileExists(t, staleFile)
+	fixture, err := os.ReadFile(filepath.Join(validatorPath, testFixturesFolderName, "t2", "file.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(fixture), "property: other-namexxx")
+
+	results, err = NewTemplateTestValidator(validatorPath).RunTests(ctx, newPkg)
+	require.NoError(t, err)
+	for _, r := range results {
+		assert.NoError(t, r.Err)
+	}
+}
@@ -3,24 +3,78 @@ package probing
 import (
 	"context"
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/pkg/probing"
 )
 
+// PollInterval is the interval at which objects with endpoint probes should be re-checked,
+// because endpoints becoming available don't cause any watch events.
+const PollInterval = 15 * time.Second
+
+// Results of HTTP and TCP probes are shared across reconciles,
+// to not send requests to the same endpoint on every reconcile.
+var endpointProbeCache = probing.NewResultCache(10 * time.Second)
+
+// RequiresPolling returns true if the given probes check endpoints, instead of object state.
+func RequiresPolling(packageProbes []corev1alpha1.ObjectSetProbe) bool {
+	for _, pkgProbe := range packageProbes {
+		for _, probeSpec := range pkgProbe.Probes {
+			if probeSpec.HTTP != nil || probeSpec.TCP != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParseConfig configures how probes are compiled.
+type ParseConfig struct {
+	// Replaces HTTP and TCP probes with a probe that always fails.
+	DisableEndpointProbes bool
+}
+
+// Option applies the given options to the config.
+func (c *ParseConfig) Option(opts ...ParseOption) {
+	for _, opt := range opts {
+		opt.ConfigureParse(c)
+	}
+}
+
+// ParseOption configures probe parsing.
+type ParseOption interface {
+	ConfigureParse(c *ParseConfig)
+}
+
+// WithDisableEndpointProbes disables HTTP and TCP probes.
+// Endpoints are addressed by their cluster DNS name, which only resolves
+// when objects live in the same cluster as Package Operator,
+// so remote phases can't use them.
+type WithDisableEndpointProbes bool
+
+func (w WithDisableEndpointProbes) ConfigureParse(c *ParseConfig) {
+	c.DisableEndpointProbes = bool(w)
+}
+
 // Parse takes a list of ObjectSetProbes (commonly defined within a ObjectSetPhaseSpec)
 // and compiles a single Prober to test objects with.
-func Parse(ctx context.Context, packageProbes []corev1alpha1.ObjectSetProbe) (probing.Prober, error) {
+// resolver is used to look up objects referenced by CEL probes.
+func Parse(
+	ctx context.Context, packageProbes []corev1alpha1.ObjectSetProbe, resolver probing.ObjectResolver,
+	opts ...ParseOption,
+) (probing.Prober, error) {
 	probeList := make(probing.And, len(packageProbes))
 	for i, pkgProbe := range packageProbes {
 		var (
 			probe probing.Prober
 			err   error
 		)
-		probe, err = ParseProbes(ctx, pkgProbe.Probes)
+		probe, err = ParseProbes(ctx, pkgProbe.Probes, resolver, opts...)
 		if err != nil {
 			return nil, fmt.Errorf("parsing probe #%d: %w", i, err)
 		}
@@ -61,7 +115,13 @@ func ParseSelector(
 }
 
 // ParseProbes takes a []corev1alpha1.Probe and compiles it into a Prober.
-func ParseProbes(_ context.Context, probeSpecs []corev1alpha1.Probe) (probing.Prober, error) {
+func ParseProbes(
+	_ context.Context, probeSpecs []corev1alpha1.Probe, resolver probing.ObjectResolver,
+	opts ...ParseOption,
+) (probing.Prober, error) {
+	var cfg ParseConfig
+	cfg.Option(opts...)
+
 	var probeList probing.And
 	for _, probeSpec := range probeSpecs {
 		var (
@@ -83,14 +143,42 @@ func ParseProbes(_ context.Context, probeSpecs []corev1alpha1.Probe) (probing.Pr
 			}
 
 		case probeSpec.CEL != nil:
-			probe, err = probing.NewCELProbe(
+			probe, err = probing.NewCELProbeWithReferences(
 				probeSpec.CEL.Rule,
 				probeSpec.CEL.Message,
+				celReferences(probeSpec.CEL.References),
+				resolver,
 			)
 			if err != nil {
 				return nil, err
 			}
 
+		case (probeSpec.HTTP != nil || probeSpec.TCP != nil) && cfg.DisableEndpointProbes:
+			probe = unsupportedProbe("HTTP and TCP probes are not supported in remote phases")
+
+		case probeSpec.HTTP != nil:
+			probe = &probing.HTTPProbe{
+				Port:           probeSpec.HTTP.Port,
+				Path:           probeSpec.HTTP.Path,
+				Scheme:         probeSpec.HTTP.Scheme,
+				ExpectedStatus: int(probeSpec.HTTP.ExpectedStatus),
+				Timeout:        time.Duration(probeSpec.HTTP.TimeoutSeconds) * time.Second,
+				Cache:          endpointProbeCache,
+			}
+
+		case probeSpec.TCP != nil:
+			probe = &probing.TCPProbe{
+				Port:    probeSpec.TCP.Port,
+				Timeout: time.Duration(probeSpec.TCP.TimeoutSeconds) * time.Second,
+				Cache:   endpointProbeCache,
+			}
+
+		case probeSpec.Count != nil:
+			probe, err = countProbe(probeSpec.Count, resolver)
+			if err != nil {
+				return nil, err
+			}
+
 		default:
 			// probe has no known config
 			continue
@@ -101,3 +189,39 @@ func ParseProbes(_ context.Context, probeSpecs []corev1alpha1.Probe) (probing.Pr
 	// Always check .status.observedCondition, if present.
 	return &probing.ObservedGenerationProbe{Prober: probeList}, nil
 }
+
+// unsupportedProbe always fails with the given message.
+type unsupportedProbe string
+
+func (p unsupportedProbe) Probe(_ *unstructured.Unstructured) (success bool, message string) {
+	return false, string(p)
+}
+
+func celReferences(refSpecs []corev1alpha1.ProbeCELReference) []probing.CELReference {
+	refs := make([]probing.CELReference, len(refSpecs))
+	for i, refSpec := range refSpecs {
+		refs[i] = probing.CELReference{
+			Variable:         refSpec.Variable,
+			GroupVersionKind: schema.FromAPIVersionAndKind(refSpec.APIVersion, refSpec.Kind),
+			Name:             refSpec.Name,
+			Namespace:        refSpec.Namespace,
+		}
+	}
+	return refs
+}
+
+// countProbe lists counted objects through the resolver, if it supports listing.
+func countProbe(spec *corev1alpha1.ProbeCountSpec, resolver probing.ObjectResolver) (*probing.CountProbe, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing count selector: %w", err)
+	}
+	lister, _ := resolver.(probing.ObjectLister)
+	return probing.NewCountProbe(
+		schema.FromAPIVersionAndKind(spec.APIVersion, spec.Kind),
+		selector,
+		spec.Namespace,
+		int(spec.Min),
+		lister,
+	)
+}
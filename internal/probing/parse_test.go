@@ -3,10 +3,13 @@ package probing
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/pkg/probing"
@@ -28,7 +31,7 @@ func TestParse(t *testing.T) {
 		},
 	}
 
-	p, err := Parse(ctx, osp)
+	p, err := Parse(ctx, osp, nil)
 	require.NoError(t, err)
 	require.IsType(t, probing.And{}, p)
 
@@ -82,11 +85,36 @@ func TestParseProbes(t *testing.T) {
 			Rule:    `self.metadata.name == "test"`,
 		},
 	}
+	http := corev1alpha1.Probe{
+		HTTP: &corev1alpha1.ProbeHTTPSpec{
+			Port:           8080,
+			Path:           "/healthz",
+			Scheme:         "HTTP",
+			ExpectedStatus: 200,
+			TimeoutSeconds: 2,
+		},
+	}
+	tcp := corev1alpha1.Probe{
+		TCP: &corev1alpha1.ProbeTCPSpec{
+			Port: 5432,
+		},
+	}
+	count := corev1alpha1.Probe{
+		Count: &corev1alpha1.ProbeCountSpec{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "test"},
+			},
+			Min: 2,
+		},
+	}
 	emptyConfigProbe := corev1alpha1.Probe{}
 
-	p, err := ParseProbes(context.Background(), []corev1alpha1.Probe{
-		fep, cp, cel, emptyConfigProbe,
-	})
+	ctx := context.Background()
+	p, err := ParseProbes(ctx, []corev1alpha1.Probe{
+		fep, cp, cel, http, tcp, count, emptyConfigProbe,
+	}, NewCacheObjectResolver(ctx, nil, nil))
 	require.NoError(t, err)
 	// everything should be wrapped
 	require.IsType(t, &probing.ObservedGenerationProbe{}, p)
@@ -95,7 +123,7 @@ func TestParseProbes(t *testing.T) {
 	nested := ogProbe.Prober
 	require.IsType(t, probing.And{}, nested)
 
-	if assert.Len(t, nested, 3) {
+	if assert.Len(t, nested, 6) {
 		nestedList := nested.(probing.And)
 		assert.Equal(t, &probing.FieldsEqualProbe{
 			FieldA: "asdf",
@@ -105,5 +133,99 @@ func TestParseProbes(t *testing.T) {
 			Type:   "asdf",
 			Status: "asdf",
 		}, nestedList[1])
+		assert.Equal(t, &probing.HTTPProbe{
+			Port:           8080,
+			Path:           "/healthz",
+			Scheme:         "HTTP",
+			ExpectedStatus: 200,
+			Timeout:        2 * time.Second,
+			Cache:          endpointProbeCache,
+		}, nestedList[3])
+		assert.Equal(t, &probing.TCPProbe{
+			Port:  5432,
+			Cache: endpointProbeCache,
+		}, nestedList[4])
+		require.IsType(t, &probing.CountProbe{}, nestedList[5])
+		countP := nestedList[5].(*probing.CountProbe)
+		assert.Equal(t, 2, countP.Min)
+		assert.Equal(t, "ConfigMap", countP.Kind)
+		assert.Equal(t, "app=test", countP.Selector.String())
 	}
 }
+
+func TestParseProbes_disableEndpointProbes(t *testing.T) {
+	t.Parallel()
+
+	p, err := ParseProbes(context.Background(), []corev1alpha1.Probe{
+		{HTTP: &corev1alpha1.ProbeHTTPSpec{Port: 8080}},
+		{TCP: &corev1alpha1.ProbeTCPSpec{Port: 5432}},
+	}, nil, WithDisableEndpointProbes(true))
+	require.NoError(t, err)
+
+	nested := p.(*probing.ObservedGenerationProbe).Prober.(probing.And)
+	require.Len(t, nested, 2)
+	for _, probe := range nested {
+		success, message := probe.Probe(&unstructured.Unstructured{})
+		assert.False(t, success)
+		assert.Equal(t, "HTTP and TCP probes are not supported in remote phases", message)
+	}
+}
+
+func TestParseProbes_celReferences(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewCacheObjectResolver(context.Background(), nil, nil)
+	p, err := ParseProbes(context.Background(), []corev1alpha1.Probe{{
+		CEL: &corev1alpha1.ProbeCELSpec{
+			Message: "test",
+			Rule:    `self.spec.replicas == hpa.spec.minReplicas`,
+			References: []corev1alpha1.ProbeCELReference{{
+				Variable:   "hpa",
+				APIVersion: "autoscaling/v2",
+				Kind:       "HorizontalPodAutoscaler",
+				Name:       "test",
+			}},
+		},
+	}}, resolver)
+	require.NoError(t, err)
+
+	nested := p.(*probing.ObservedGenerationProbe).Prober.(probing.And)
+	require.Len(t, nested, 1)
+	require.IsType(t, &probing.CELProbe{}, nested[0])
+	celProbe := nested[0].(*probing.CELProbe)
+	assert.Equal(t, []probing.CELReference{{
+		Variable: "hpa",
+		GroupVersionKind: schema.GroupVersionKind{
+			Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler",
+		},
+		Name: "test",
+	}}, celProbe.References)
+	assert.Same(t, resolver, celProbe.Resolver)
+
+	// References can't be resolved without a resolver.
+	_, err = ParseProbes(context.Background(), []corev1alpha1.Probe{{
+		CEL: &corev1alpha1.ProbeCELSpec{
+			Message: "test",
+			Rule:    `has(hpa.metadata)`,
+			References: []corev1alpha1.ProbeCELReference{{
+				Variable: "hpa", APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler", Name: "test",
+			}},
+		},
+	}}, nil)
+	require.ErrorIs(t, err, probing.ErrCELReferencesWithoutResolver)
+}
+
+func TestRequiresPolling(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, RequiresPolling([]corev1alpha1.ObjectSetProbe{{
+		Probes: []corev1alpha1.Probe{{
+			Condition: &corev1alpha1.ProbeConditionSpec{Type: "Available", Status: "True"},
+		}},
+	}}))
+	assert.True(t, RequiresPolling([]corev1alpha1.ObjectSetProbe{{
+		Probes: []corev1alpha1.Probe{{
+			TCP: &corev1alpha1.ProbeTCPSpec{Port: 5432},
+		}},
+	}}))
+}
@@ -0,0 +1,105 @@
+package probing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"package-operator.run/pkg/probing"
+)
+
+// ObjectCache provides objects referenced by probes, commonly the dynamic cache.
+type ObjectCache interface {
+	client.Reader
+	Watch(ctx context.Context, owner client.Object, obj runtime.Object) error
+}
+
+// ErrCrossNamespaceReference is returned when a probe of a namespaced owner
+// references an object in another namespace.
+var ErrCrossNamespaceReference = errors.New("referenced object must be in the namespace of the probe owner")
+
+// CacheObjectResolver resolves objects referenced by CEL probes
+// and lists objects counted by count probes from an ObjectCache.
+// The dynamic cache only holds objects labelled with the package-operator.run/cache label,
+// so only objects managed by Package Operator can be resolved.
+// Owners that are namespaced can only resolve objects within their own namespace.
+type CacheObjectResolver struct {
+	ctx   context.Context
+	cache ObjectCache
+	owner client.Object
+}
+
+var (
+	_ probing.ObjectResolver = (*CacheObjectResolver)(nil)
+	_ probing.ObjectLister   = (*CacheObjectResolver)(nil)
+)
+
+// NewCacheObjectResolver returns a CacheObjectResolver,
+// ensuring that referenced objects are watched on behalf of owner.
+func NewCacheObjectResolver(
+	ctx context.Context, cache ObjectCache, owner client.Object,
+) *CacheObjectResolver {
+	return &CacheObjectResolver{
+		ctx:   ctx,
+		cache: cache,
+		owner: owner,
+	}
+}
+
+// ResolveObject returns the requested object or nil, if it does not exist.
+func (r *CacheObjectResolver) ResolveObject(
+	gvk schema.GroupVersionKind, name, namespace string,
+) (*unstructured.Unstructured, error) {
+	if ownerNamespace := r.owner.GetNamespace(); len(ownerNamespace) > 0 && namespace != ownerNamespace {
+		return nil, fmt.Errorf("%w: %s %s/%s", ErrCrossNamespaceReference, gvk.Kind, namespace, name)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := r.cache.Watch(r.ctx, r.owner, obj); err != nil {
+		return nil, fmt.Errorf("watching %s: %w", gvk.Kind, err)
+	}
+
+	err := r.cache.Get(r.ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)
+	if apimachineryerrors.IsNotFound(err) {
+		// Rules are expected to handle missing objects.
+		return nil, nil //nolint:nilnil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting %s: %w", gvk.Kind, err)
+	}
+	return obj, nil
+}
+
+// ListObjects returns all objects of the given kind in namespace matching selector.
+func (r *CacheObjectResolver) ListObjects(
+	gvk schema.GroupVersionKind, namespace string, selector labels.Selector,
+) ([]unstructured.Unstructured, error) {
+	if ownerNamespace := r.owner.GetNamespace(); len(ownerNamespace) > 0 && namespace != ownerNamespace {
+		return nil, fmt.Errorf("%w: %s in %s", ErrCrossNamespaceReference, gvk.Kind, namespace)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := r.cache.Watch(r.ctx, r.owner, obj); err != nil {
+		return nil, fmt.Errorf("watching %s: %w", gvk.Kind, err)
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := r.cache.List(
+		r.ctx, list,
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, fmt.Errorf("listing %s: %w", gvk.Kind, err)
+	}
+	return list.Items, nil
+}
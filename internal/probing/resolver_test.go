@@ -0,0 +1,86 @@
+package probing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/testutil/dynamiccachemocks"
+)
+
+func TestCacheObjectResolver(t *testing.T) {
+	t.Parallel()
+
+	owner := &corev1alpha1.ObjectSet{}
+	owner.Namespace = "test-ns"
+	gvk := schema.GroupVersionKind{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"}
+
+	c := &dynamiccachemocks.DynamicCacheMock{}
+	c.On("Watch", mock.Anything, owner, mock.Anything).Return(nil)
+	c.On("Get", mock.Anything, client.ObjectKey{Name: "test", Namespace: "test-ns"}, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(2).(*unstructured.Unstructured)
+			obj.SetName("test")
+		}).
+		Return(nil)
+	c.On("Get", mock.Anything, client.ObjectKey{Name: "missing", Namespace: "test-ns"}, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+
+	r := NewCacheObjectResolver(context.Background(), c, owner)
+
+	obj, err := r.ResolveObject(gvk, "test", "test-ns")
+	require.NoError(t, err)
+	assert.Equal(t, "test", obj.GetName())
+	assert.Equal(t, gvk, obj.GroupVersionKind())
+
+	obj, err = r.ResolveObject(gvk, "missing", "test-ns")
+	require.NoError(t, err)
+	assert.Nil(t, obj)
+
+	_, err = r.ResolveObject(gvk, "test", "other-ns")
+	require.ErrorIs(t, err, ErrCrossNamespaceReference)
+
+	c.AssertCalled(t, "Watch", mock.Anything, owner, mock.Anything)
+}
+
+func TestCacheObjectResolver_ListObjects(t *testing.T) {
+	t.Parallel()
+
+	owner := &corev1alpha1.ObjectSet{}
+	owner.Namespace = "test-ns"
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	selector := labels.SelectorFromSet(labels.Set{"app": "test"})
+
+	c := &dynamiccachemocks.DynamicCacheMock{}
+	c.On("Watch", mock.Anything, owner, mock.Anything).Return(nil)
+	c.On("List", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*unstructured.UnstructuredList)
+			list.Items = []unstructured.Unstructured{{}, {}}
+		}).
+		Return(nil)
+
+	r := NewCacheObjectResolver(context.Background(), c, owner)
+
+	objs, err := r.ListObjects(gvk, "test-ns", selector)
+	require.NoError(t, err)
+	assert.Len(t, objs, 2)
+
+	_, err = r.ListObjects(gvk, "other-ns", selector)
+	require.ErrorIs(t, err, ErrCrossNamespaceReference)
+
+	c.AssertCalled(t, "Watch", mock.Anything, owner, mock.Anything)
+	c.AssertCalled(t, "List", mock.Anything, mock.Anything, []client.ListOption{
+		client.InNamespace("test-ns"),
+		client.MatchingLabelsSelector{Selector: selector},
+	})
+}
@@ -19,11 +19,18 @@ func (m *PhaseReconcilerMock) ReconcilePhase(
 	ctx context.Context, owner controllers.PhaseObjectOwner,
 	phase corev1alpha1.ObjectSetTemplatePhase,
 	probe probing.Prober, previous []controllers.PreviousObjectSet,
-) ([]client.Object, controllers.ProbingResult, error) {
+) (
+	[]client.Object, controllers.ProbingResult, []corev1alpha1.DriftedObject,
+	[]corev1alpha1.ControlledObjectReference, error,
+) {
 	args := m.Called(ctx, owner, phase, probe, previous)
+	drifted, _ := args.Get(2).([]corev1alpha1.DriftedObject)
+	wouldAdopt, _ := args.Get(3).([]corev1alpha1.ControlledObjectReference)
 	return args.Get(0).([]client.Object),
 		args.Get(1).(controllers.ProbingResult),
-		args.Error(2)
+		drifted,
+		wouldAdopt,
+		args.Error(4)
 }
 
 func (m *PhaseReconcilerMock) TeardownPhase(
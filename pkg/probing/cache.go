@@ -0,0 +1,64 @@
+package probing
+
+import (
+	"sync"
+	"time"
+)
+
+// ResultCache remembers probe results for a fixed duration,
+// so probes talking to remote endpoints are not executed on every reconcile.
+type ResultCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mux     sync.Mutex
+	entries map[string]cachedResult
+}
+
+type cachedResult struct {
+	success bool
+	message string
+	expires time.Time
+}
+
+// NewResultCache creates a ResultCache keeping results for the given duration.
+func NewResultCache(ttl time.Duration) *ResultCache {
+	return &ResultCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]cachedResult{},
+	}
+}
+
+// Do returns the cached result for key or executes probe and caches its result.
+// A nil ResultCache always executes probe.
+func (c *ResultCache) Do(key string, probe func() (success bool, message string)) (success bool, message string) {
+	if c == nil {
+		return probe()
+	}
+
+	c.mux.Lock()
+	now := c.now()
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	entry, ok := c.entries[key]
+	c.mux.Unlock()
+	if ok {
+		return entry.success, entry.message
+	}
+
+	// Probe without holding the lock, so slow endpoints don't block other probes.
+	success, message = probe()
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.entries[key] = cachedResult{
+		success: success,
+		message: message,
+		expires: c.now().Add(c.ttl),
+	}
+	return success, message
+}
@@ -0,0 +1,42 @@
+package probing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewResultCache(10 * time.Second)
+	c.now = func() time.Time { return now }
+
+	var calls int
+	probe := func() (bool, string) {
+		calls++
+		return false, "down"
+	}
+
+	s, m := c.Do("key", probe)
+	assert.False(t, s)
+	assert.Equal(t, "down", m)
+
+	// cached
+	s, m = c.Do("key", probe)
+	assert.False(t, s)
+	assert.Equal(t, "down", m)
+	assert.Equal(t, 1, calls)
+
+	// expired
+	now = now.Add(11 * time.Second)
+	c.Do("key", probe)
+	assert.Equal(t, 2, calls)
+
+	// nil cache always probes
+	var nilCache *ResultCache
+	nilCache.Do("key", probe)
+	assert.Equal(t, 3, calls)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/ext"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/cel/library"
 )
 
@@ -14,20 +15,71 @@ import (
 type CELProbe struct {
 	Program cel.Program
 	Message string
+
+	// Other objects made available to Program.
+	References []CELReference
+	Resolver   ObjectResolver
+}
+
+// CELReference binds another object to a variable of a CEL rule.
+type CELReference struct {
+	Variable string
+	schema.GroupVersionKind
+	Name string
+	// Namespace of the object, defaults to the namespace of the probed object.
+	Namespace string
+}
+
+// ObjectResolver looks up objects referenced by CEL rules.
+type ObjectResolver interface {
+	// ResolveObject returns the requested object or nil, if it does not exist.
+	ResolveObject(
+		gvk schema.GroupVersionKind, name, namespace string,
+	) (*unstructured.Unstructured, error)
 }
 
 var _ Prober = (*CELProbe)(nil)
 
+// Objects are bound to CEL variables as maps,
+// so rules are type-checked to access fields like metadata, spec and status by name.
+// Which fields exist is checked by validateCELFields.
+var celObjectType = cel.MapType(cel.StringType, cel.DynType)
+
 // ErrCELInvalidEvaluationType is raised when a CEL expression does not evaluate to a boolean.
 var ErrCELInvalidEvaluationType = errors.New("cel expression must evaluate to a bool")
 
+// ErrCELReferencesWithoutResolver is raised when a CEL probe has references, but no way to resolve them.
+var ErrCELReferencesWithoutResolver = errors.New("cel references require an object resolver")
+
 // NewCELProbe creates a new CEL (Common Expression Language) Probe.
 // A CEL probe runs a CEL expression against the target object that needs to evaluate to a bool.
 func NewCELProbe(rule, message string) (
 	*CELProbe, error,
 ) {
-	env, err := cel.NewEnv(
-		cel.Variable("self", cel.DynType),
+	return NewCELProbeWithReferences(rule, message, nil, nil)
+}
+
+// NewCELProbeWithReferences creates a new CEL Probe that may access other objects.
+// Every reference is available as a variable in the rule and is an empty map if the object does not exist.
+// Using undeclared variables fails compilation, as does accessing fields that objects of
+// the referenced kind don't have, if the kind is known to the client-go scheme.
+func NewCELProbeWithReferences(
+	rule, message string, refs []CELReference, resolver ObjectResolver,
+) (
+	*CELProbe, error,
+) {
+	if len(refs) > 0 && resolver == nil {
+		return nil, ErrCELReferencesWithoutResolver
+	}
+
+	vars := []cel.EnvOption{cel.Variable("self", celObjectType)}
+	fields := map[string]celFields{"self": celObjectVariable("self", schema.GroupVersionKind{})}
+	for _, ref := range refs {
+		vars = append(vars, cel.Variable(ref.Variable, celObjectType))
+		fields[ref.Variable] = celObjectVariable(ref.Variable, ref.GroupVersionKind)
+	}
+
+	env, err := cel.NewEnv(append(vars,
 		cel.HomogeneousAggregateLiterals(),
 		cel.EagerlyValidateDeclarations(true),
 		cel.DefaultUTCTimeZone(true),
@@ -36,13 +88,20 @@ func NewCELProbe(rule, message string) (
 		library.URLs(),
 		library.Regex(),
 		library.Lists(),
-	)
+	)...)
 	if err != nil {
 		return nil, fmt.Errorf("creating CEL env: %w", err)
 	}
 
-	ast, issues := env.Compile(rule)
-	if issues != nil {
+	parsed, issues := env.Parse(rule)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("compiling CEL: %w", issues.Err())
+	}
+	if err := validateCELFields(parsed.Expr(), fields); err != nil {
+		return nil, fmt.Errorf("compiling CEL: %w", err)
+	}
+	ast, issues := env.Check(parsed)
+	if issues.Err() != nil {
 		return nil, fmt.Errorf("compiling CEL: %w", issues.Err())
 	}
 	if ast.OutputType() != cel.BoolType {
@@ -55,16 +114,35 @@ func NewCELProbe(rule, message string) (
 	}
 
 	return &CELProbe{
-		Program: prgm,
-		Message: message,
+		Program:    prgm,
+		Message:    message,
+		References: refs,
+		Resolver:   resolver,
 	}, nil
 }
 
 // Probe executes the probe.
 func (p *CELProbe) Probe(obj *unstructured.Unstructured) (success bool, message string) {
-	val, _, err := p.Program.Eval(map[string]any{
+	vars := map[string]any{
 		"self": obj.Object,
-	})
+	}
+	for _, ref := range p.References {
+		namespace := ref.Namespace
+		if len(namespace) == 0 {
+			namespace = obj.GetNamespace()
+		}
+		refObj, err := p.Resolver.ResolveObject(ref.GroupVersionKind, ref.Name, namespace)
+		if err != nil {
+			return false, fmt.Sprintf("resolving reference %q: %v", ref.Variable, err)
+		}
+		if refObj == nil {
+			vars[ref.Variable] = map[string]any{}
+			continue
+		}
+		vars[ref.Variable] = refObj.Object
+	}
+
+	val, _, err := p.Program.Eval(vars)
 	if err != nil {
 		return false, fmt.Sprintf("CEL program failed: %v", err)
 	}
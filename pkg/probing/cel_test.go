@@ -1,11 +1,13 @@
 package probing
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func Test_NewCELProbe(t *testing.T) {
@@ -13,6 +15,10 @@ func Test_NewCELProbe(t *testing.T) {
 
 	_, err := NewCELProbe(`self.test`, "")
 	require.ErrorIs(t, err, ErrCELInvalidEvaluationType)
+
+	// objects are maps and type-checked as such.
+	_, err = NewCELProbe(`self == "test"`, "")
+	require.ErrorContains(t, err, "found no matching overload for '_==_'")
 }
 
 func Test_celProbe(t *testing.T) {
@@ -121,3 +127,182 @@ func Test_celProbe(t *testing.T) {
 		})
 	}
 }
+
+var errTest = errors.New("test error")
+
+type celObjectResolverStub map[string]*unstructured.Unstructured
+
+func (r celObjectResolverStub) ResolveObject(
+	gvk schema.GroupVersionKind, name, namespace string,
+) (*unstructured.Unstructured, error) {
+	key := gvk.Kind + "/" + namespace + "/" + name
+	if key == "Secret/test-ns/broken" {
+		return nil, errTest
+	}
+	return r[key], nil
+}
+
+func Test_NewCELProbeWithReferences(t *testing.T) {
+	t.Parallel()
+
+	refs := []CELReference{{
+		Variable:         "hpa",
+		GroupVersionKind: schema.GroupVersionKind{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
+		Name:             "test",
+	}}
+
+	_, err := NewCELProbeWithReferences(`self.spec.replicas == hpa.spec.minReplicas`, "", refs, nil)
+	require.ErrorIs(t, err, ErrCELReferencesWithoutResolver)
+
+	// undeclared variables are rejected.
+	_, err = NewCELProbeWithReferences(`self.spec.replicas == other.spec.minReplicas`, "", refs, celObjectResolverStub{})
+	require.ErrorIs(t, err, ErrCELUnknownReference)
+	require.ErrorContains(t, err, `"other", declared references are: hpa, self`)
+}
+
+func Test_NewCELProbeWithReferences_fields(t *testing.T) {
+	t.Parallel()
+
+	refs := []CELReference{
+		{
+			Variable:         "hpa",
+			GroupVersionKind: schema.GroupVersionKind{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
+			Name:             "test",
+		},
+		{
+			Variable:         "custom",
+			GroupVersionKind: schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Custom"},
+			Name:             "test",
+		},
+	}
+
+	tests := []struct {
+		name string
+		rule string
+		err  string
+	}{
+		{
+			name: "known fields",
+			rule: `hpa.spec.minReplicas <= hpa.status.currentReplicas && hpa.metadata.labels["app"] == "test"`,
+		},
+		{
+			name: "list items",
+			rule: `hpa.status.conditions.exists(c, c.type == "ScalingActive" && c.lastTransitionTime != "")`,
+		},
+		{
+			name: "presence test",
+			rule: `has(hpa.spec.behavior) && has(hpa.metadata.annotations.test)`,
+		},
+		{
+			name: "any field of unknown kinds",
+			rule: `custom.spec.size == 3 && has(self.status.ready)`,
+		},
+		{
+			name: "unknown field",
+			rule: `hpa.spec.replicas == 3`,
+			err:  `unknown field in cel expression: hpa.spec has no field "replicas"`,
+		},
+		{
+			name: "unknown field in list items",
+			rule: `hpa.status.conditions.all(c, c.state == "True")`,
+			err:  `unknown field in cel expression: hpa.status.conditions[*] has no field "state"`,
+		},
+		{
+			name: "unknown metadata field of unknown kinds",
+			rule: `custom.metadata.title == "test"`,
+			err:  `unknown field in cel expression: custom.metadata has no field "title"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewCELProbeWithReferences(test.rule, "", refs, celObjectResolverStub{})
+			if len(test.err) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrCELUnknownField)
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func Test_celProbe_references(t *testing.T) {
+	t.Parallel()
+
+	hpa := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"minReplicas": int64(3),
+			},
+		},
+	}
+	resolver := celObjectResolverStub{
+		"HorizontalPodAutoscaler/test-ns/test": hpa,
+	}
+	deploy := &unstructured.Unstructured{
+		Object: map[string]any{
+			"metadata": map[string]any{
+				"name":      "test",
+				"namespace": "test-ns",
+			},
+			"spec": map[string]any{
+				"replicas": int64(3),
+			},
+		},
+	}
+	hpaGVK := schema.GroupVersionKind{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"}
+	secretGVK := schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+
+	tests := []struct {
+		name    string
+		rule    string
+		refs    []CELReference
+		success bool
+		message string
+	}{
+		{
+			name:    "success",
+			rule:    `self.spec.replicas == hpa.spec.minReplicas`,
+			refs:    []CELReference{{Variable: "hpa", GroupVersionKind: hpaGVK, Name: "test"}},
+			success: true,
+			message: "replicas mismatch",
+		},
+		{
+			name: "explicit namespace",
+			rule: `size(hpa) == 0`,
+			refs: []CELReference{{
+				Variable: "hpa", GroupVersionKind: hpaGVK, Name: "test", Namespace: "other-ns",
+			}},
+			success: true,
+			message: "replicas mismatch",
+		},
+		{
+			name:    "missing object",
+			rule:    `has(secret.metadata)`,
+			refs:    []CELReference{{Variable: "secret", GroupVersionKind: secretGVK, Name: "test"}},
+			success: false,
+			message: "replicas mismatch",
+		},
+		{
+			name:    "resolve error",
+			rule:    `has(secret.metadata)`,
+			refs:    []CELReference{{Variable: "secret", GroupVersionKind: secretGVK, Name: "broken"}},
+			success: false,
+			message: `resolving reference "secret": test error`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := NewCELProbeWithReferences(test.rule, "replicas mismatch", test.refs, resolver)
+			require.NoError(t, err)
+
+			success, msg := p.Probe(deploy)
+			assert.Equal(t, test.success, success)
+			assert.Equal(t, test.message, msg)
+		})
+	}
+}
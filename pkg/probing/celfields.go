@@ -0,0 +1,256 @@
+package probing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/google/cel-go/common/operators"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// ErrCELUnknownReference is raised when a CEL rule uses a variable that is neither self nor a declared reference.
+var ErrCELUnknownReference = errors.New("unknown reference in cel expression")
+
+// ErrCELUnknownField is raised when a CEL rule accesses a field that the object does not have.
+var ErrCELUnknownField = errors.New("unknown field in cel expression")
+
+// Identifiers that CEL resolves to types instead of variables.
+var celTypeIdentifiers = []string{
+	"bool", "bytes", "double", "int", "list", "map", "null_type", "string", "type", "uint",
+}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// celObjectFields declares the fields that objects of every kind have.
+type celObjectFields struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      metav1.ObjectMeta `json:"metadata"`
+}
+
+// celFields describes the fields a CEL rule may access on a value.
+type celFields struct {
+	// Expression leading to the value, used in error messages.
+	path string
+	// Go type of the value, nil if the fields of the value are not known.
+	goType reflect.Type
+	// Allows access to fields that goType does not declare.
+	open bool
+}
+
+// celObjectVariable returns the fields of objects of the given kind.
+// Kinds known to the client-go scheme are checked against their Go types,
+// all other kinds only declare the fields common to all objects.
+func celObjectVariable(variable string, gvk schema.GroupVersionKind) celFields {
+	if obj, err := scheme.Scheme.New(gvk); err == nil {
+		return newCELFields(variable, reflect.TypeOf(obj))
+	}
+	return celFields{path: variable, goType: reflect.TypeOf(celObjectFields{}), open: true}
+}
+
+func newCELFields(path string, t reflect.Type) celFields {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Interface ||
+		t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		// The JSON representation of these types is not derived from their fields.
+		return celFields{path: path}
+	}
+	return celFields{path: path, goType: t}
+}
+
+// field returns the fields of the value of the given field.
+func (f celFields) field(name string) (celFields, error) {
+	path := f.path + "." + name
+	if f.goType == nil {
+		return celFields{path: path}, nil
+	}
+
+	switch f.goType.Kind() {
+	case reflect.Map:
+		return newCELFields(path, f.goType.Elem()), nil
+	case reflect.Struct:
+		if ft, ok := jsonField(f.goType, name); ok {
+			return newCELFields(path, ft), nil
+		}
+		if f.open {
+			return celFields{path: path}, nil
+		}
+	}
+	return celFields{}, fmt.Errorf("%w: %s has no field %q", ErrCELUnknownField, f.path, name)
+}
+
+// index returns the fields of the items of a list or the values of a map.
+func (f celFields) index() celFields {
+	path := f.path + "[*]"
+	if f.goType == nil {
+		return celFields{path: path}
+	}
+
+	switch f.goType.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return newCELFields(path, f.goType.Elem())
+	}
+	return celFields{path: path}
+}
+
+// iterate returns the fields of the items of a list or the keys of a map.
+func (f celFields) iterate() celFields {
+	if f.goType != nil && f.goType.Kind() == reflect.Map {
+		return newCELFields(f.path+"[*]", f.goType.Key())
+	}
+	return f.index()
+}
+
+// jsonField returns the type of the struct field serialized with the given JSON name.
+func jsonField(t reflect.Type, name string) (reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		switch {
+		case !sf.IsExported() || tag == "-":
+			continue
+
+		case sf.Anonymous && len(tag) == 0:
+			// Fields of embedded structs are inlined.
+			ft := sf.Type
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() != reflect.Struct {
+				continue
+			}
+			if embedded, ok := jsonField(ft, name); ok {
+				return embedded, true
+			}
+
+		case tag == name || (len(tag) == 0 && sf.Name == name):
+			return sf.Type, true
+		}
+	}
+	return nil, false
+}
+
+// validateCELFields checks that a parsed CEL rule only uses declared variables
+// and only accesses fields that exist on the objects bound to them.
+func validateCELFields(expr *exprpb.Expr, vars map[string]celFields) error {
+	_, err := checkCELFields(expr, vars)
+	return err
+}
+
+func checkCELFields(expr *exprpb.Expr, vars map[string]celFields) (celFields, error) {
+	switch e := expr.GetExprKind().(type) {
+	case *exprpb.Expr_IdentExpr:
+		name := e.IdentExpr.GetName()
+		if f, ok := vars[name]; ok {
+			return f, nil
+		}
+		if slices.Contains(celTypeIdentifiers, name) {
+			return celFields{path: name}, nil
+		}
+		declared := make([]string, 0, len(vars))
+		for v := range vars {
+			declared = append(declared, v)
+		}
+		slices.Sort(declared)
+		return celFields{}, fmt.Errorf("%w %q, declared references are: %s",
+			ErrCELUnknownReference, name, strings.Join(declared, ", "))
+
+	case *exprpb.Expr_SelectExpr:
+		operand, err := checkCELFields(e.SelectExpr.GetOperand(), vars)
+		if err != nil {
+			return celFields{}, err
+		}
+		return operand.field(e.SelectExpr.GetField())
+
+	case *exprpb.Expr_CallExpr:
+		return checkCELCall(e.CallExpr, vars)
+
+	case *exprpb.Expr_ListExpr:
+		for _, elem := range e.ListExpr.GetElements() {
+			if _, err := checkCELFields(elem, vars); err != nil {
+				return celFields{}, err
+			}
+		}
+
+	case *exprpb.Expr_StructExpr:
+		for _, entry := range e.StructExpr.GetEntries() {
+			if key := entry.GetMapKey(); key != nil {
+				if _, err := checkCELFields(key, vars); err != nil {
+					return celFields{}, err
+				}
+			}
+			if _, err := checkCELFields(entry.GetValue(), vars); err != nil {
+				return celFields{}, err
+			}
+		}
+
+	case *exprpb.Expr_ComprehensionExpr:
+		return checkCELComprehension(e.ComprehensionExpr, vars)
+	}
+	return celFields{}, nil
+}
+
+func checkCELCall(call *exprpb.Expr_Call, vars map[string]celFields) (celFields, error) {
+	if target := call.GetTarget(); target != nil {
+		if _, err := checkCELFields(target, vars); err != nil {
+			return celFields{}, err
+		}
+	}
+
+	args := make([]celFields, len(call.GetArgs()))
+	for i, arg := range call.GetArgs() {
+		f, err := checkCELFields(arg, vars)
+		if err != nil {
+			return celFields{}, err
+		}
+		args[i] = f
+	}
+
+	if call.GetFunction() != operators.Index || len(args) != 2 {
+		return celFields{}, nil
+	}
+	// Indexing structs with a string constant accesses a field, e.g. hpa["spec"].
+	key := call.GetArgs()[1].GetConstExpr()
+	if _, isString := key.GetConstantKind().(*exprpb.Constant_StringValue); isString &&
+		args[0].goType != nil && args[0].goType.Kind() == reflect.Struct {
+		return args[0].field(key.GetStringValue())
+	}
+	return args[0].index(), nil
+}
+
+// checkCELComprehension checks expanded macros like all, exists and map,
+// binding the iteration variable to the items of the iterated value.
+func checkCELComprehension(comp *exprpb.Expr_Comprehension, vars map[string]celFields) (celFields, error) {
+	iterRange, err := checkCELFields(comp.GetIterRange(), vars)
+	if err != nil {
+		return celFields{}, err
+	}
+	if _, err := checkCELFields(comp.GetAccuInit(), vars); err != nil {
+		return celFields{}, err
+	}
+
+	loopVars := maps.Clone(vars)
+	loopVars[comp.GetIterVar()] = iterRange.iterate()
+	loopVars[comp.GetAccuVar()] = celFields{path: comp.GetAccuVar()}
+	for _, loopExpr := range []*exprpb.Expr{comp.GetLoopCondition(), comp.GetLoopStep()} {
+		if _, err := checkCELFields(loopExpr, loopVars); err != nil {
+			return celFields{}, err
+		}
+	}
+
+	resultVars := maps.Clone(vars)
+	resultVars[comp.GetAccuVar()] = celFields{path: comp.GetAccuVar()}
+	if _, err := checkCELFields(comp.GetResult(), resultVars); err != nil {
+		return celFields{}, err
+	}
+	return celFields{}, nil
+}
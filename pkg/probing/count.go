@@ -0,0 +1,69 @@
+package probing
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CountProbe checks that at least Min objects of a kind match Selector.
+type CountProbe struct {
+	schema.GroupVersionKind
+	Selector labels.Selector
+	// Namespace to count objects in, defaults to the namespace of the probed object.
+	Namespace string
+	Min       int
+	Lister    ObjectLister
+}
+
+// ObjectLister lists objects counted by count probes.
+type ObjectLister interface {
+	// ListObjects returns all objects of the given kind in namespace matching selector.
+	ListObjects(
+		gvk schema.GroupVersionKind, namespace string, selector labels.Selector,
+	) ([]unstructured.Unstructured, error)
+}
+
+var _ Prober = (*CountProbe)(nil)
+
+// ErrCountWithoutLister is raised when a count probe has no way to list objects.
+var ErrCountWithoutLister = errors.New("count probes require an object lister")
+
+// NewCountProbe creates a new Count Probe.
+func NewCountProbe(
+	gvk schema.GroupVersionKind, selector labels.Selector,
+	namespace string, minCount int, lister ObjectLister,
+) (*CountProbe, error) {
+	if lister == nil {
+		return nil, ErrCountWithoutLister
+	}
+	return &CountProbe{
+		GroupVersionKind: gvk,
+		Selector:         selector,
+		Namespace:        namespace,
+		Min:              minCount,
+		Lister:           lister,
+	}, nil
+}
+
+// Probe executes the probe.
+func (cp *CountProbe) Probe(obj *unstructured.Unstructured) (success bool, message string) {
+	namespace := cp.Namespace
+	if len(namespace) == 0 {
+		namespace = obj.GetNamespace()
+	}
+
+	objs, err := cp.Lister.ListObjects(cp.GroupVersionKind, namespace, cp.Selector)
+	if err != nil {
+		return false, fmt.Sprintf("listing %s: %v", cp.Kind, err)
+	}
+	if len(objs) < cp.Min {
+		return false, fmt.Sprintf(
+			`found %d %s matching "%s", expected at least %d`,
+			len(objs), cp.Kind, cp.Selector, cp.Min)
+	}
+	return true, ""
+}
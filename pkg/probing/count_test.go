@@ -0,0 +1,137 @@
+package probing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type countObjectListerStub []unstructured.Unstructured
+
+func (l countObjectListerStub) ListObjects(
+	gvk schema.GroupVersionKind, namespace string, selector labels.Selector,
+) ([]unstructured.Unstructured, error) {
+	if namespace == "broken" {
+		return nil, errTest
+	}
+
+	var out []unstructured.Unstructured
+	for _, obj := range l {
+		if obj.GroupVersionKind() == gvk &&
+			obj.GetNamespace() == namespace &&
+			selector.Matches(labels.Set(obj.GetLabels())) {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+func newCountTestObject(kind, namespace string, lbls map[string]string) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(lbls)
+	return obj
+}
+
+func TestCount(t *testing.T) {
+	t.Parallel()
+
+	lister := countObjectListerStub{
+		newCountTestObject("ConfigMap", "test-ns", map[string]string{"app": "test"}),
+		newCountTestObject("ConfigMap", "test-ns", map[string]string{"app": "test"}),
+		newCountTestObject("ConfigMap", "test-ns", map[string]string{"app": "other"}),
+		newCountTestObject("ConfigMap", "other-ns", map[string]string{"app": "test"}),
+		newCountTestObject("Secret", "test-ns", map[string]string{"app": "test"}),
+	}
+	configMapGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+	probed := &unstructured.Unstructured{}
+	probed.SetNamespace("test-ns")
+
+	tests := []struct {
+		name      string
+		gvk       schema.GroupVersionKind
+		selector  labels.Selector
+		namespace string
+		min       int
+		succeeds  bool
+		message   string
+	}{
+		{
+			name:     "all in namespace",
+			gvk:      configMapGVK,
+			selector: labels.Everything(),
+			min:      3,
+			succeeds: true,
+		},
+		{
+			name:     "matching selector",
+			gvk:      configMapGVK,
+			selector: labels.SelectorFromSet(labels.Set{"app": "test"}),
+			min:      2,
+			succeeds: true,
+		},
+		{
+			name:      "other namespace",
+			gvk:       configMapGVK,
+			selector:  labels.SelectorFromSet(labels.Set{"app": "test"}),
+			namespace: "other-ns",
+			min:       1,
+			succeeds:  true,
+		},
+		{
+			name:     "not enough",
+			gvk:      configMapGVK,
+			selector: labels.SelectorFromSet(labels.Set{"app": "test"}),
+			min:      3,
+			succeeds: false,
+			message:  `found 2 ConfigMap matching "app=test", expected at least 3`,
+		},
+		{
+			name:     "other kind",
+			gvk:      schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+			selector: labels.SelectorFromSet(labels.Set{"app": "other"}),
+			min:      1,
+			succeeds: false,
+			message:  `found 0 Secret matching "app=other", expected at least 1`,
+		},
+		{
+			name:      "list error",
+			gvk:       configMapGVK,
+			selector:  labels.Everything(),
+			namespace: "broken",
+			min:       1,
+			succeeds:  false,
+			message:   "listing ConfigMap: test error",
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := NewCountProbe(test.gvk, test.selector, test.namespace, test.min, lister)
+			require.NoError(t, err)
+
+			s, m := p.Probe(probed)
+			assert.Equal(t, test.succeeds, s)
+			assert.Equal(t, test.message, m)
+		})
+	}
+}
+
+func TestNewCountProbe_withoutLister(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCountProbe(
+		schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+		labels.Everything(), "", 1, nil)
+	require.ErrorIs(t, err, ErrCountWithoutLister)
+}
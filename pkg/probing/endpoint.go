@@ -0,0 +1,147 @@
+package probing
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Defaults for HTTPProbe and TCPProbe.
+const (
+	defaultEndpointProbeTimeout = time.Second
+	defaultHTTPProbePath        = "/"
+	defaultHTTPProbeScheme      = "HTTP"
+	defaultHTTPProbeStatus      = http.StatusOK
+)
+
+// Endpoint probes only connect to Services,
+// other objects don't have a cluster DNS name.
+var serviceGroupKind = schema.GroupKind{Kind: "Service"}
+
+// HTTPProbe sends a HTTP GET request to a port of the probed Service
+// and checks the response status code.
+type HTTPProbe struct {
+	Port           int32
+	Path           string
+	Scheme         string
+	ExpectedStatus int
+	Timeout        time.Duration
+	// Optional cache to not request the same URL on every probe.
+	Cache *ResultCache
+
+	// Returns the host to connect to, defaults to the cluster DNS name of the Service.
+	host func(obj *unstructured.Unstructured) string
+}
+
+var _ Prober = (*HTTPProbe)(nil)
+
+// Probe executes the probe.
+func (hp *HTTPProbe) Probe(obj *unstructured.Unstructured) (success bool, message string) {
+	if gk := obj.GroupVersionKind().GroupKind(); gk != serviceGroupKind {
+		return false, fmt.Sprintf("HTTP probes only support Services, not %s", gk)
+	}
+
+	scheme, path, expectedStatus := defaultHTTPProbeScheme, defaultHTTPProbePath, defaultHTTPProbeStatus
+	if len(hp.Scheme) > 0 {
+		scheme = hp.Scheme
+	}
+	if len(hp.Path) > 0 {
+		path = hp.Path
+	}
+	if hp.ExpectedStatus != 0 {
+		expectedStatus = hp.ExpectedStatus
+	}
+
+	u := url.URL{
+		Scheme: strings.ToLower(scheme),
+		Host:   endpointAddress(hp.host, obj, hp.Port),
+		Path:   path,
+	}
+	return hp.Cache.Do(fmt.Sprintf("%s %d", u.String(), expectedStatus), func() (bool, string) {
+		ctx, cancel := context.WithTimeout(context.Background(), endpointProbeTimeout(hp.Timeout))
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return false, fmt.Sprintf("GET %s: %v", u.String(), err)
+		}
+		client := &http.Client{
+			Transport: &http.Transport{
+				// Like the kubelet, don't verify certificates of probed endpoints.
+				TLSClientConfig:   &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+				DisableKeepAlives: true,
+			},
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return false, fmt.Sprintf("GET %s: %v", u.String(), err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != expectedStatus {
+			return false, fmt.Sprintf("GET %s: status %d, expected %d", u.String(), resp.StatusCode, expectedStatus)
+		}
+		return true, ""
+	})
+}
+
+// TCPProbe opens a TCP connection to a port of the probed Service.
+type TCPProbe struct {
+	Port    int32
+	Timeout time.Duration
+	// Optional cache to not connect to the same address on every probe.
+	Cache *ResultCache
+
+	// Returns the host to connect to, defaults to the cluster DNS name of the Service.
+	host func(obj *unstructured.Unstructured) string
+}
+
+var _ Prober = (*TCPProbe)(nil)
+
+// Probe executes the probe.
+func (tp *TCPProbe) Probe(obj *unstructured.Unstructured) (success bool, message string) {
+	if gk := obj.GroupVersionKind().GroupKind(); gk != serviceGroupKind {
+		return false, fmt.Sprintf("TCP probes only support Services, not %s", gk)
+	}
+
+	addr := endpointAddress(tp.host, obj, tp.Port)
+	return tp.Cache.Do("tcp://"+addr, func() (bool, string) {
+		conn, err := net.DialTimeout("tcp", addr, endpointProbeTimeout(tp.Timeout))
+		if err != nil {
+			return false, fmt.Sprintf("tcp %s: %v", addr, err)
+		}
+		_ = conn.Close()
+		return true, ""
+	})
+}
+
+func endpointAddress(
+	host func(obj *unstructured.Unstructured) string,
+	obj *unstructured.Unstructured, port int32,
+) string {
+	if host == nil {
+		host = serviceHost
+	}
+	return net.JoinHostPort(host(obj), strconv.Itoa(int(port)))
+}
+
+// Cluster DNS name of a Service.
+func serviceHost(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s.%s.svc", obj.GetName(), obj.GetNamespace())
+}
+
+func endpointProbeTimeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		return defaultEndpointProbeTimeout
+	}
+	return timeout
+}
@@ -0,0 +1,95 @@
+package probing
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newServiceObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Service")
+	obj.SetName("svc")
+	obj.SetNamespace("test-ns")
+	return obj
+}
+
+// Splits the address of a test listener into a host function and port.
+func testEndpoint(t *testing.T, addr string) (func(*unstructured.Unstructured) string, int32) {
+	t.Helper()
+
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	require.NoError(t, err)
+	return func(*unstructured.Unstructured) string {
+		return tcpAddr.IP.String()
+	}, int32(tcpAddr.Port)
+}
+
+func TestHTTPProbe(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	host, port := testEndpoint(t, srv.Listener.Addr().String())
+
+	p := &HTTPProbe{Port: port, Path: "/healthz", host: host}
+	s, m := p.Probe(newServiceObject())
+	assert.True(t, s)
+	assert.Empty(t, m)
+
+	p = &HTTPProbe{Port: port, Path: "/ready", host: host}
+	s, m = p.Probe(newServiceObject())
+	assert.False(t, s)
+	assert.Contains(t, m, "status 503, expected 200")
+}
+
+func TestTCPProbe(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host, port := testEndpoint(t, l.Addr().String())
+
+	p := &TCPProbe{Port: port, host: host}
+	s, m := p.Probe(newServiceObject())
+	assert.True(t, s)
+	assert.Empty(t, m)
+
+	require.NoError(t, l.Close())
+	s, _ = p.Probe(newServiceObject())
+	assert.False(t, s)
+}
+
+func TestEndpointProbes_notAService(t *testing.T) {
+	t.Parallel()
+
+	obj := newServiceObject()
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind("Deployment")
+
+	s, m := (&HTTPProbe{Port: 8080}).Probe(obj)
+	assert.False(t, s)
+	assert.Equal(t, "HTTP probes only support Services, not Deployment.apps", m)
+
+	s, m = (&TCPProbe{Port: 5432}).Probe(obj)
+	assert.False(t, s)
+	assert.Equal(t, "TCP probes only support Services, not Deployment.apps", m)
+}
+
+func Test_serviceHost(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "svc.test-ns.svc", serviceHost(newServiceObject()))
+}